@@ -4,6 +4,8 @@
 
 package cmd
 
+import "time"
+
 var (
 	dryRun            bool
 	eventFilePath     string
@@ -13,4 +15,14 @@ var (
 	reviewpadFilePath string
 	safeModeRun       bool
 	logLevel          string
+	serveAddr         string
+	webhookSecret     string
+	githubURL         string
+	gitlabURL         string
+	gitlabToken       string
+	workers           int
+	queueSize         int
+	coalesceWindow    time.Duration
+	deliveryTTL       time.Duration
+	deliveryStorePath string
 )
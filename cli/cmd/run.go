@@ -11,16 +11,20 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/reviewpad/api/go/clients"
+	pbe "github.com/reviewpad/api/go/entities"
 	"github.com/reviewpad/go-lib/entities"
 	log "github.com/reviewpad/go-lib/logrus"
 	"github.com/reviewpad/reviewpad/v4"
 	"github.com/reviewpad/reviewpad/v4/codehost"
 	gh "github.com/reviewpad/reviewpad/v4/codehost/github"
+	"github.com/reviewpad/reviewpad/v4/codehost/gitlab"
 	"github.com/reviewpad/reviewpad/v4/collector"
 	"github.com/reviewpad/reviewpad/v4/handler"
+	plugins_aladino_services "github.com/reviewpad/reviewpad/v4/plugins/aladino/services"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/metadata"
@@ -44,7 +48,8 @@ func init() {
 	runCmd.Flags().BoolVarP(&safeModeRun, "safe-mode-run", "s", false, "Safe mode")
 	runCmd.Flags().StringVarP(&url, "url", "u", "", "Code host pull request or issue url")
 	runCmd.Flags().StringVarP(&token, "token", "t", "", "Code host token")
-	runCmd.Flags().StringVarP(&eventFilePath, "event-payload", "e", "", "File path to github event in JSON format")
+	runCmd.Flags().StringVarP(&eventFilePath, "event-payload", "e", "", "File path to github event, or to the gitlab webhook payload, in JSON format")
+	runCmd.Flags().StringVar(&gitlabURL, "gitlab-url", "", "GitLab API URL, e.g. https://gitlab.example.com/api/v4 for self-managed instances (required for GitLab URLs of other domains than gitlab.com)")
 	runCmd.Flags().StringVarP(&mixpanelToken, "mixpanel-token", "m", "", "Mixpanel token")
 	runCmd.Flags().StringVarP(&logLevel, "log-level", "l", "debug", "Log level")
 
@@ -64,11 +69,11 @@ func init() {
 		panic("INPUT_CODEHOST_SERVICE env var is required")
 	}
 
-	if endpoint := os.Getenv("INPUT_SEMANTIC_SERVICE"); endpoint == "" {
+	if endpoint := os.Getenv("INPUT_SEMANTIC_SERVICE"); endpoint == "" && plugins_aladino_services.IsRemoteSemanticBackend() {
 		panic("INPUT_SEMANTIC_SERVICE env var is required")
 	}
 
-	if endpoint := os.Getenv("INPUT_ROBIN_SERVICE"); endpoint == "" {
+	if endpoint := os.Getenv("INPUT_ROBIN_SERVICE"); endpoint == "" && plugins_aladino_services.IsRemoteRobinBackend() {
 		panic("INPUT_ROBIN_SERVICE env var is required")
 	}
 }
@@ -88,6 +93,10 @@ func run() error {
 		return err
 	}
 
+	if isGitlabURL(url) {
+		return runOnGitlab(log, eventFileContent)
+	}
+
 	event, err = parseEvent(string(eventFileContent))
 	if err != nil {
 		return err
@@ -124,7 +133,14 @@ func run() error {
 		return fmt.Errorf("error running reviewpad team edition. Details %v", err.Error())
 	}
 
-	targetEntities, eventDetails, err := handler.ProcessEvent(log, event)
+	scheduleScope, err := reviewpad.ScheduleScope(reviewpadFile)
+	if err != nil {
+		return fmt.Errorf("error reading schedule. Details %v", err.Error())
+	}
+
+	targetEntities, eventDetails, err := handler.ProcessEventWithOptions(log, event, &handler.ProcessEventOptions{
+		ScheduleScope: scheduleScope,
+	})
 	if err != nil {
 		return fmt.Errorf("error processing event. Details %v", err.Error())
 	}
@@ -161,6 +177,78 @@ func run() error {
 	return nil
 }
 
+// isGitlabURL reports whether the URL is the URL of a GitLab merge request or issue.
+// The URLs of self-managed instances are only told apart by the GitLab API URL.
+func isGitlabURL(url string) bool {
+	return gitlabURL != "" || strings.Contains(url, "gitlab.com/")
+}
+
+// runOnGitlab runs reviewpad on the targets of the GitLab webhook payload.
+// GitLab URLs have the path of the project before the /-/ separator, e.g. https://gitlab.com/group/project/-/merge_requests/1.
+func runOnGitlab(log *logrus.Entry, rawPayload []byte) error {
+	gitLabDetailsRegex := regexp.MustCompile(`^(https?://[^/]+)/(.+)/(.+)/-/(\w+)/(\d+)`)
+	gitLabEntityDetails := gitLabDetailsRegex.FindStringSubmatch(url)
+	if gitLabEntityDetails == nil {
+		return fmt.Errorf("invalid gitlab url %s", url)
+	}
+
+	repositoryOwner := gitLabEntityDetails[2]
+	entityKind, err := toTargetEntityKind(gitLabEntityDetails[4])
+	if err != nil {
+		log.Fatalf("Error converting entity kind. Details %+q", err.Error())
+	}
+
+	ctx := context.Background()
+	gitlabClient, err := gitlab.NewGitlabClient(gitlabURL, token, nil)
+	if err != nil {
+		return err
+	}
+
+	collectorClient, err := collector.NewCollector(mixpanelToken, repositoryOwner, string(entityKind), "local-cli", nil)
+	if err != nil {
+		log.Errorf("error creating new collector: %v", err)
+	}
+
+	rawReviewpadFile, err := os.ReadFile(reviewpadFilePath)
+	if err != nil {
+		return fmt.Errorf("error reading reviewpad file. Details: %v", err.Error())
+	}
+
+	reviewpadFile, err := reviewpad.Load(ctx, log, nil, bytes.NewBuffer(rawReviewpadFile))
+	if err != nil {
+		return fmt.Errorf("error running reviewpad team edition. Details %v", err.Error())
+	}
+
+	eventName, err := handler.GetGitlabEventName(rawPayload)
+	if err != nil {
+		return fmt.Errorf("error processing event. Details %v", err.Error())
+	}
+
+	targetEntities, eventDetails, err := handler.ProcessGitlabEvent(log, eventName, rawPayload)
+	if err != nil {
+		return fmt.Errorf("error processing event. Details %v", err.Error())
+	}
+
+	codeHostClient := &codehost.CodeHostClient{
+		Token: token,
+		HostInfo: &codehost.HostInfo{
+			Host:    pbe.Host_GITLAB,
+			HostUri: gitLabEntityDetails[1],
+		},
+		GitlabClient: gitlabClient,
+	}
+
+	for _, targetEntity := range targetEntities {
+		log.Infof("Processing entity %s/%s#%d", targetEntity.Owner, targetEntity.Repo, targetEntity.Number)
+		_, _, _, err = reviewpad.Run(ctx, log, nil, codeHostClient, collectorClient, targetEntity, eventDetails, reviewpadFile, nil, dryRun, safeModeRun)
+		if err != nil {
+			return fmt.Errorf("error running reviewpad team edition. Details %v", err.Error())
+		}
+	}
+
+	return nil
+}
+
 func parseEvent(rawEvent string) (*handler.ActionEvent, error) {
 	event := &handler.ActionEvent{}
 
@@ -176,7 +264,7 @@ func toTargetEntityKind(entityType string) (entities.TargetEntityKind, error) {
 	switch entityType {
 	case "issues":
 		return entities.Issue, nil
-	case "pull":
+	case "pull", "merge_requests":
 		return entities.PullRequest, nil
 	default:
 		return "", fmt.Errorf("unknown entity type %s", entityType)
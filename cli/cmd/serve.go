@@ -0,0 +1,150 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/reviewpad/api/go/clients"
+	log "github.com/reviewpad/go-lib/logrus"
+	"github.com/reviewpad/reviewpad/v4/coalescer"
+	"github.com/reviewpad/reviewpad/v4/handler"
+	"github.com/reviewpad/reviewpad/v4/server"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+const (
+	WEBHOOK_SECRET_ENV = "REVIEWPAD_WEBHOOK_SECRET"
+	GITLAB_TOKEN_ENV   = "REVIEWPAD_GITLAB_TOKEN"
+	SHUTDOWN_TIMEOUT   = 30 * time.Second
+
+	DEFAULT_COALESCE_WINDOW = 10 * time.Second
+)
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Runs reviewpad on GitHub and GitLab webhooks",
+	Long:  "Runs an HTTP server that receives GitHub and GitLab webhooks and runs the reviewpad file of the repository, given by --file, on the targets of every event. GitLab webhooks are only accepted with --gitlab-token.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return serve()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+	serveCmd.Flags().StringVarP(&serveAddr, "addr", "a", ":8080", "Address to listen on")
+	serveCmd.Flags().StringVarP(&webhookSecret, "webhook-secret", "w", os.Getenv(WEBHOOK_SECRET_ENV), fmt.Sprintf("Webhook secret (defaults to the %s env var)", WEBHOOK_SECRET_ENV))
+	serveCmd.Flags().StringVarP(&token, "token", "t", "", "GitHub token")
+	serveCmd.Flags().StringVarP(&githubURL, "github-url", "g", "", "GitHub API URL, e.g. https://github.example.com/api/v3 for GitHub Enterprise Server")
+	serveCmd.Flags().StringVar(&gitlabToken, "gitlab-token", os.Getenv(GITLAB_TOKEN_ENV), fmt.Sprintf("GitLab token (defaults to the %s env var)", GITLAB_TOKEN_ENV))
+	serveCmd.Flags().StringVar(&gitlabURL, "gitlab-url", "", "GitLab API URL, e.g. https://gitlab.example.com/api/v4 for self-managed instances")
+	serveCmd.Flags().IntVar(&workers, "workers", server.DEFAULT_WORKERS, "Number of events processed concurrently")
+	serveCmd.Flags().IntVar(&queueSize, "queue-size", server.DEFAULT_QUEUE_SIZE, "Number of events waiting to be processed")
+	serveCmd.Flags().DurationVar(&coalesceWindow, "coalesce-window", DEFAULT_COALESCE_WINDOW, "Time during which the events of a pull request or issue are merged into a single evaluation")
+	serveCmd.Flags().DurationVar(&deliveryTTL, "delivery-ttl", coalescer.DEFAULT_DELIVERY_TTL, "Time during which redeliveries of an event are ignored")
+	serveCmd.Flags().StringVar(&deliveryStorePath, "delivery-store", "", "File that keeps the handled deliveries across restarts (defaults to memory)")
+	serveCmd.Flags().BoolVarP(&dryRun, "dry-run", "d", false, "Dry run mode")
+	serveCmd.Flags().BoolVarP(&safeModeRun, "safe-mode-run", "s", false, "Safe mode")
+	serveCmd.Flags().StringVarP(&mixpanelToken, "mixpanel-token", "m", "", "Mixpanel token")
+	serveCmd.Flags().StringVarP(&logLevel, "log-level", "l", "info", "Log level")
+
+}
+
+func serve() error {
+	logLevel, err := logrus.ParseLevel(logLevel)
+	if err != nil {
+		return err
+	}
+
+	log := log.NewLogger(logLevel)
+
+	if token == "" && gitlabToken == "" {
+		return errors.New("a GitHub token, given by --token, or a GitLab token, given by --gitlab-token, is required")
+	}
+
+	codehostClient, codehostConnection, err := clients.NewCodeHostClient(os.Getenv(CODEHOST_SERVICE_ENDPOINT))
+	if err != nil {
+		return fmt.Errorf("error creating codehost client. Details %v", err.Error())
+	}
+	defer codehostConnection.Close()
+
+	var deliveryStore coalescer.Store = coalescer.NewMemoryStore()
+	if deliveryStorePath != "" {
+		fileStore, err := coalescer.NewFileStore(deliveryStorePath)
+		if err != nil {
+			return fmt.Errorf("error opening delivery store. Details %v", err.Error())
+		}
+		defer fileStore.Close()
+
+		deliveryStore = fileStore
+	}
+
+	eventCoalescer := coalescer.NewCoalescer(deliveryStore, &coalescer.Config{
+		Window:      coalesceWindow,
+		DeliveryTTL: deliveryTTL,
+	}, handler.ProcessWebhookEvent)
+
+	evaluator := server.NewReviewpadEvaluator(&server.EvaluatorConfig{
+		ReviewpadFilePath: reviewpadFilePath,
+		CodehostClient:    codehostClient,
+		MixpanelToken:     mixpanelToken,
+		DryRun:            dryRun,
+		SafeMode:          safeModeRun,
+	})
+
+	webhookServer, err := server.NewServer(log, &server.Config{
+		WebhookSecret: webhookSecret,
+		Token:         token,
+		GitHubAPIURL:  githubURL,
+		GitLabToken:   gitlabToken,
+		GitLabAPIURL:  gitlabURL,
+		Workers:       workers,
+		QueueSize:     queueSize,
+	}, eventCoalescer, evaluator)
+	if err != nil {
+		return err
+	}
+
+	httpServer := &http.Server{
+		Addr:              serveAddr,
+		Handler:           webhookServer,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		log.Infof("listening on %s", serveAddr)
+		serveErr <- httpServer.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serveErr:
+		if !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+	case <-ctx.Done():
+		log.Info("shutting down")
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), SHUTDOWN_TIMEOUT)
+	defer cancel()
+
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+
+	return webhookServer.Shutdown(shutdownCtx)
+}
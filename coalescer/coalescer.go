@@ -0,0 +1,231 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package coalescer
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/handler"
+	"github.com/reviewpad/reviewpad/v4/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// DEFAULT_DELIVERY_TTL is how long delivery IDs are remembered by default.
+const DEFAULT_DELIVERY_TTL = 24 * time.Hour
+
+var ErrClosed = errors.New("coalescer is closed")
+
+// Processor finds the targets of an event, e.g. handler.ProcessEvent.
+type Processor func(log *logrus.Entry, event *handler.ActionEvent) ([]*entities.TargetEntity, *entities.EventDetails, error)
+
+type Config struct {
+	// Window is how long the events of a target are merged before the target is evaluated.
+	// The window starts with the first event so that a steady stream of events does not delay the evaluation forever.
+	// When the window is zero, the target is evaluated once per event.
+	Window time.Duration
+	// DeliveryTTL is how long delivery IDs are remembered to discard redeliveries.
+	DeliveryTTL time.Duration
+}
+
+// Batch is the events of a target that are evaluated together.
+// The target is evaluated once with the latest event of the batch and the workflows triggered by any of its events are run.
+type Batch struct {
+	Target *entities.TargetEntity
+	// Event and EventDetails are the latest event of the batch.
+	Event        *handler.ActionEvent
+	EventDetails *entities.EventDetails
+	// AllEventDetails are the details of every event of the batch in the order they were received.
+	AllEventDetails []*entities.EventDetails
+	DeliveryIDs     []string
+
+	timer *time.Timer
+}
+
+// Coalescer deduplicates the deliveries of events and merges the events of a target received within a window
+// so that a burst of events, e.g. the pull_request, check_run and status events of a push, evaluates the target once.
+type Coalescer struct {
+	config  *Config
+	store   Store
+	process Processor
+	batches chan *Batch
+
+	mu      sync.Mutex
+	closed  bool
+	pending map[string]*Batch
+	sending sync.WaitGroup
+}
+
+func NewCoalescer(store Store, config *Config, process Processor) *Coalescer {
+	return &Coalescer{
+		config:  config,
+		store:   store,
+		process: process,
+		batches: make(chan *Batch),
+		pending: make(map[string]*Batch),
+	}
+}
+
+// Batches returns the channel of the batches whose window is over.
+// The channel is closed by Close.
+func (c *Coalescer) Batches() <-chan *Batch {
+	return c.batches
+}
+
+// Handle processes the event unless its delivery was already handled and adds the event to the batches of its targets.
+func (c *Coalescer) Handle(log *logrus.Entry, deliveryID string, event *handler.ActionEvent) error {
+	if deliveryID != "" {
+		ttl := c.config.DeliveryTTL
+		if ttl <= 0 {
+			ttl = DEFAULT_DELIVERY_TTL
+		}
+
+		added, err := c.store.Add(deliveryID, time.Now().Add(ttl))
+		if err != nil {
+			return fmt.Errorf("error recording delivery: %v", err)
+		}
+
+		if !added {
+			log.Info("skipping duplicate delivery")
+			return nil
+		}
+	}
+
+	targetEntities, eventDetails, err := c.process(log, event)
+	if err != nil {
+		// The delivery is forgotten so that its redelivery is handled.
+		if deliveryID != "" {
+			if removeErr := c.store.Remove(deliveryID); removeErr != nil {
+				log.Errorf("error removing delivery: %v", removeErr)
+			}
+		}
+
+		return fmt.Errorf("error processing event: %v", err)
+	}
+
+	for _, targetEntity := range targetEntities {
+		if err := c.add(log, deliveryID, event, targetEntity, eventDetails); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Coalescer) add(log *logrus.Entry, deliveryID string, event *handler.ActionEvent, targetEntity *entities.TargetEntity, eventDetails *entities.EventDetails) error {
+	key := targetKey(targetEntity)
+	if isEvaluatedAlone(eventDetails) {
+		key = fmt.Sprintf("%s %s delivery:%s", key, eventDetails.EventName, deliveryID)
+	}
+
+	c.mu.Lock()
+
+	if c.closed {
+		c.mu.Unlock()
+		return ErrClosed
+	}
+
+	if batch, ok := c.pending[key]; ok {
+		batch.Target = targetEntity
+		batch.Event = event
+		batch.EventDetails = eventDetails
+		batch.AllEventDetails = append(batch.AllEventDetails, eventDetails)
+		batch.DeliveryIDs = append(batch.DeliveryIDs, deliveryID)
+		c.mu.Unlock()
+
+		log.Infof("merged event into the pending evaluation of %s", key)
+		return nil
+	}
+
+	batch := &Batch{
+		Target:          targetEntity,
+		Event:           event,
+		EventDetails:    eventDetails,
+		AllEventDetails: []*entities.EventDetails{eventDetails},
+		DeliveryIDs:     []string{deliveryID},
+	}
+
+	// Every push to a branch has its own commits, so pushes are never merged.
+	if c.config.Window <= 0 || targetEntity.Kind == codehost.Branch {
+		c.sending.Add(1)
+		c.mu.Unlock()
+
+		c.send(batch)
+		return nil
+	}
+
+	c.pending[key] = batch
+	batch.timer = time.AfterFunc(c.config.Window, func() {
+		c.release(key, batch)
+	})
+	c.mu.Unlock()
+
+	return nil
+}
+
+func (c *Coalescer) release(key string, batch *Batch) {
+	c.mu.Lock()
+	// The batch was already released by Close.
+	if c.pending[key] != batch {
+		c.mu.Unlock()
+		return
+	}
+
+	delete(c.pending, key)
+	c.sending.Add(1)
+	c.mu.Unlock()
+
+	c.send(batch)
+}
+
+func (c *Coalescer) send(batch *Batch) {
+	defer c.sending.Done()
+	c.batches <- batch
+}
+
+// Close releases the pending batches without waiting for their window to be over and closes the batches channel.
+// Events handled after Close are rejected with ErrClosed.
+func (c *Coalescer) Close() {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return
+	}
+
+	c.closed = true
+	batches := make([]*Batch, 0, len(c.pending))
+	for key, batch := range c.pending {
+		batch.timer.Stop()
+		batches = append(batches, batch)
+		delete(c.pending, key)
+	}
+	c.sending.Add(len(batches))
+	c.mu.Unlock()
+
+	for _, batch := range batches {
+		c.send(batch)
+	}
+
+	c.sending.Wait()
+	close(c.batches)
+}
+
+// isEvaluatedAlone reports whether the event asks for its own evaluation and is never merged with other events.
+// This is the case of reviewpad commands and dispatched events.
+func isEvaluatedAlone(eventDetails *entities.EventDetails) bool {
+	if eventDetails == nil {
+		return false
+	}
+
+	return utils.IsReviewpadCommand(eventDetails) || eventDetails.EventName == "repository_dispatch" || eventDetails.EventName == "workflow_dispatch"
+}
+
+func targetKey(targetEntity *entities.TargetEntity) string {
+	return fmt.Sprintf("%s/%s/%s#%d", targetEntity.Kind, targetEntity.Owner, targetEntity.Repo, targetEntity.Number)
+}
@@ -0,0 +1,305 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package coalescer_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/coalescer"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/handler"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+var pullRequest = &entities.TargetEntity{
+	Kind:   entities.PullRequest,
+	Owner:  "reviewpad",
+	Repo:   "reviewpad",
+	Number: 1,
+}
+
+var issue = &entities.TargetEntity{
+	Kind:   entities.Issue,
+	Owner:  "reviewpad",
+	Repo:   "reviewpad",
+	Number: 2,
+}
+
+// processEvent returns the pull request for every event and the issue for issues events.
+func processEvent(log *logrus.Entry, event *handler.ActionEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+	eventDetails := &entities.EventDetails{EventName: *event.EventName}
+	if *event.EventName == "issues" {
+		return []*entities.TargetEntity{pullRequest, issue}, eventDetails, nil
+	}
+
+	return []*entities.TargetEntity{pullRequest}, eventDetails, nil
+}
+
+func newEvent(eventName string) *handler.ActionEvent {
+	return &handler.ActionEvent{EventName: github.String(eventName)}
+}
+
+// collectBatches closes the coalescer and returns all the batches it released.
+func collectBatches(c *coalescer.Coalescer) []*coalescer.Batch {
+	batches := make([]*coalescer.Batch, 0)
+	done := make(chan struct{})
+
+	go func() {
+		for batch := range c.Batches() {
+			batches = append(batches, batch)
+		}
+		close(done)
+	}()
+
+	c.Close()
+	<-done
+
+	return batches
+}
+
+func TestHandle_WhenWindowIsZero(t *testing.T) {
+	c := coalescer.NewCoalescer(coalescer.NewMemoryStore(), &coalescer.Config{}, processEvent)
+	log := logrus.NewEntry(logrus.New())
+
+	batches := make(chan *coalescer.Batch, 2)
+	go func() {
+		for batch := range c.Batches() {
+			batches <- batch
+		}
+		close(batches)
+	}()
+
+	assert.Nil(t, c.Handle(log, "1", newEvent("pull_request")))
+	assert.Nil(t, c.Handle(log, "2", newEvent("check_run")))
+	c.Close()
+
+	gotEvents := make([]string, 0)
+	for batch := range batches {
+		assert.Equal(t, pullRequest, batch.Target)
+		gotEvents = append(gotEvents, *batch.Event.EventName)
+	}
+
+	assert.Equal(t, []string{"pull_request", "check_run"}, gotEvents)
+}
+
+func TestHandle_MergesEventsOfTarget(t *testing.T) {
+	c := coalescer.NewCoalescer(coalescer.NewMemoryStore(), &coalescer.Config{Window: time.Hour}, processEvent)
+	log := logrus.NewEntry(logrus.New())
+
+	assert.Nil(t, c.Handle(log, "1", newEvent("status")))
+	assert.Nil(t, c.Handle(log, "2", newEvent("issues")))
+	assert.Nil(t, c.Handle(log, "3", newEvent("check_run")))
+
+	batches := collectBatches(c)
+
+	assert.Len(t, batches, 2)
+	for _, batch := range batches {
+		gotEventNames := make([]string, 0, len(batch.AllEventDetails))
+		for _, eventDetails := range batch.AllEventDetails {
+			gotEventNames = append(gotEventNames, eventDetails.EventName)
+		}
+
+		switch batch.Target {
+		case pullRequest:
+			assert.Equal(t, "check_run", *batch.Event.EventName)
+			assert.Equal(t, "check_run", batch.EventDetails.EventName)
+			assert.Equal(t, []string{"status", "issues", "check_run"}, gotEventNames)
+			assert.Equal(t, []string{"1", "2", "3"}, batch.DeliveryIDs)
+		case issue:
+			assert.Equal(t, "issues", *batch.Event.EventName)
+			assert.Equal(t, []string{"issues"}, gotEventNames)
+			assert.Equal(t, []string{"2"}, batch.DeliveryIDs)
+		default:
+			assert.FailNow(t, "unexpected batch", batch)
+		}
+	}
+}
+
+func TestHandle_DoesNotMergeEventsEvaluatedAlone(t *testing.T) {
+	// The payload of the events is the body of the comment.
+	process := func(log *logrus.Entry, event *handler.ActionEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+		eventDetails := &entities.EventDetails{EventName: *event.EventName}
+		value := string(*event.EventPayload)
+
+		switch *event.EventName {
+		case "issue_comment":
+			eventDetails.EventAction = "created"
+			eventDetails.Payload = &github.IssueCommentEvent{
+				Comment: &github.IssueComment{Body: github.String(value)},
+				Sender:  &github.User{Login: github.String("john")},
+			}
+		case "pull_request":
+			eventDetails.EventAction = "labeled"
+			eventDetails.Payload = &github.PullRequestEvent{
+				Label:  &github.Label{Name: github.String(value)},
+				Sender: &github.User{Login: github.String("john")},
+			}
+		case "check_suite":
+			eventDetails.EventAction = "completed"
+			eventDetails.Payload = &github.CheckSuiteEvent{Sender: &github.User{Login: github.String("github-actions")}}
+		}
+
+		return []*entities.TargetEntity{pullRequest}, eventDetails, nil
+	}
+
+	newEventWithPayload := func(eventName, value string) *handler.ActionEvent {
+		rawPayload := json.RawMessage(value)
+		return &handler.ActionEvent{EventName: github.String(eventName), EventPayload: &rawPayload}
+	}
+
+	c := coalescer.NewCoalescer(coalescer.NewMemoryStore(), &coalescer.Config{Window: time.Hour}, process)
+	log := logrus.NewEntry(logrus.New())
+
+	assert.Nil(t, c.Handle(log, "1", newEventWithPayload("issue_comment", "/reviewpad summarize")))
+	assert.Nil(t, c.Handle(log, "2", newEventWithPayload("check_suite", "")))
+	assert.Nil(t, c.Handle(log, "3", newEventWithPayload("issue_comment", "/reviewpad summarize")))
+	assert.Nil(t, c.Handle(log, "4", newEventWithPayload("pull_request", "bug")))
+	assert.Nil(t, c.Handle(log, "5", newEventWithPayload("repository_dispatch", "")))
+	assert.Nil(t, c.Handle(log, "6", newEventWithPayload("check_suite", "")))
+
+	batches := collectBatches(c)
+
+	gotDeliveryIDs := make([][]string, 0, len(batches))
+	for _, batch := range batches {
+		assert.Equal(t, *batch.Event.EventName, batch.EventDetails.EventName)
+		assert.Equal(t, batch.EventDetails, batch.AllEventDetails[len(batch.AllEventDetails)-1])
+		gotDeliveryIDs = append(gotDeliveryIDs, batch.DeliveryIDs)
+	}
+
+	// Each command and dispatch is evaluated on its own while the other events are merged.
+	assert.ElementsMatch(t, [][]string{{"1"}, {"2", "4", "6"}, {"3"}, {"5"}}, gotDeliveryIDs)
+}
+
+func TestHandle_ReleasesBatchAfterWindow(t *testing.T) {
+	c := coalescer.NewCoalescer(coalescer.NewMemoryStore(), &coalescer.Config{Window: 10 * time.Millisecond}, processEvent)
+	log := logrus.NewEntry(logrus.New())
+
+	assert.Nil(t, c.Handle(log, "1", newEvent("pull_request")))
+
+	select {
+	case batch := <-c.Batches():
+		assert.Equal(t, []string{"1"}, batch.DeliveryIDs)
+	case <-time.After(5 * time.Second):
+		assert.FailNow(t, "timed out waiting for the batch")
+	}
+
+	// The next event starts a new batch.
+	assert.Nil(t, c.Handle(log, "2", newEvent("check_run")))
+
+	batches := collectBatches(c)
+
+	assert.Len(t, batches, 1)
+	assert.Equal(t, []string{"2"}, batches[0].DeliveryIDs)
+}
+
+func TestHandle_DoesNotMergePushesToBranches(t *testing.T) {
+	branch := &entities.TargetEntity{
+		Kind:  codehost.Branch,
+		Owner: "reviewpad",
+		Repo:  "reviewpad",
+	}
+
+	process := func(log *logrus.Entry, event *handler.ActionEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+		return []*entities.TargetEntity{branch}, &entities.EventDetails{EventName: *event.EventName}, nil
+	}
+
+	c := coalescer.NewCoalescer(coalescer.NewMemoryStore(), &coalescer.Config{Window: time.Hour}, process)
+	log := logrus.NewEntry(logrus.New())
+
+	batches := make(chan *coalescer.Batch, 2)
+	go func() {
+		for batch := range c.Batches() {
+			batches <- batch
+		}
+		close(batches)
+	}()
+
+	assert.Nil(t, c.Handle(log, "1", newEvent("push")))
+	assert.Nil(t, c.Handle(log, "2", newEvent("push")))
+
+	// Pushes are released without waiting for the window to be over.
+	for _, wantDeliveryIDs := range [][]string{{"1"}, {"2"}} {
+		select {
+		case batch := <-batches:
+			assert.Equal(t, wantDeliveryIDs, batch.DeliveryIDs)
+		case <-time.After(5 * time.Second):
+			assert.FailNow(t, "timed out waiting for the batch")
+		}
+	}
+
+	c.Close()
+}
+
+func TestHandle_SkipsDuplicateDeliveries(t *testing.T) {
+	processed := 0
+	process := func(log *logrus.Entry, event *handler.ActionEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+		processed++
+		return processEvent(log, event)
+	}
+
+	c := coalescer.NewCoalescer(coalescer.NewMemoryStore(), &coalescer.Config{Window: time.Hour}, process)
+	log := logrus.NewEntry(logrus.New())
+
+	assert.Nil(t, c.Handle(log, "1", newEvent("pull_request")))
+	assert.Nil(t, c.Handle(log, "1", newEvent("pull_request")))
+
+	batches := collectBatches(c)
+
+	assert.Equal(t, 1, processed)
+	assert.Len(t, batches, 1)
+	assert.Equal(t, []string{"1"}, batches[0].DeliveryIDs)
+}
+
+func TestHandle_WhenProcessFails(t *testing.T) {
+	process := func(log *logrus.Entry, event *handler.ActionEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+		return nil, nil, errors.New("process failed")
+	}
+
+	c := coalescer.NewCoalescer(coalescer.NewMemoryStore(), &coalescer.Config{}, process)
+
+	err := c.Handle(logrus.NewEntry(logrus.New()), "1", newEvent("pull_request"))
+
+	assert.EqualError(t, err, "error processing event: process failed")
+	assert.Empty(t, collectBatches(c))
+}
+
+func TestHandle_HandlesRedeliveryOfFailedEvent(t *testing.T) {
+	processed := 0
+	process := func(log *logrus.Entry, event *handler.ActionEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+		processed++
+		if processed == 1 {
+			return nil, nil, errors.New("rate limit exceeded")
+		}
+
+		return processEvent(log, event)
+	}
+
+	c := coalescer.NewCoalescer(coalescer.NewMemoryStore(), &coalescer.Config{Window: time.Hour}, process)
+	log := logrus.NewEntry(logrus.New())
+
+	assert.EqualError(t, c.Handle(log, "1", newEvent("pull_request")), "error processing event: rate limit exceeded")
+	assert.Nil(t, c.Handle(log, "1", newEvent("pull_request")))
+
+	batches := collectBatches(c)
+
+	assert.Equal(t, 2, processed)
+	assert.Len(t, batches, 1)
+	assert.Equal(t, []string{"1"}, batches[0].DeliveryIDs)
+}
+
+func TestHandle_WhenClosed(t *testing.T) {
+	c := coalescer.NewCoalescer(coalescer.NewMemoryStore(), &coalescer.Config{}, processEvent)
+	assert.Empty(t, collectBatches(c))
+
+	err := c.Handle(logrus.NewEntry(logrus.New()), "1", newEvent("pull_request"))
+
+	assert.Equal(t, coalescer.ErrClosed, err)
+}
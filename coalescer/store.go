@@ -0,0 +1,246 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package coalescer
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// MIN_PURGE_SIZE is the number of keys a store holds before it starts removing the expired ones.
+const MIN_PURGE_SIZE = 64
+
+// Store records the delivery IDs of the handled events.
+type Store interface {
+	// Add records the key until expiresAt and reports whether it was added,
+	// i.e. the key was not recorded yet or it has expired.
+	Add(key string, expiresAt time.Time) (bool, error)
+	// Remove forgets the key so that it can be added again.
+	Remove(key string) error
+}
+
+// MemoryStore is a Store that keeps the keys in memory.
+type MemoryStore struct {
+	mu      sync.Mutex
+	entries map[string]time.Time
+	// purgeSize is the number of keys that triggers the next removal of the expired keys.
+	purgeSize int
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		entries:   make(map[string]time.Time),
+		purgeSize: MIN_PURGE_SIZE,
+	}
+}
+
+func (s *MemoryStore) Add(key string, expiresAt time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if keyExpiresAt, ok := s.entries[key]; ok && now.Before(keyExpiresAt) {
+		return false, nil
+	}
+
+	s.entries[key] = expiresAt
+
+	// The expired keys are removed every time the number of keys doubles so adding keys takes constant amortized time.
+	if len(s.entries) >= s.purgeSize {
+		s.removeExpired(now)
+		s.purgeSize = 2 * len(s.entries)
+		if s.purgeSize < MIN_PURGE_SIZE {
+			s.purgeSize = MIN_PURGE_SIZE
+		}
+	}
+
+	return true, nil
+}
+
+func (s *MemoryStore) Remove(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+	return nil
+}
+
+func (s *MemoryStore) removeExpired(now time.Time) {
+	for key, expiresAt := range s.entries {
+		if !now.Before(expiresAt) {
+			delete(s.entries, key)
+		}
+	}
+}
+
+// FileStore is a Store that keeps the keys in a file so that they survive restarts.
+// The keys are appended to the file as JSON lines and the file is rewritten without the expired keys
+// when it is opened and when most of its lines are expired keys.
+type FileStore struct {
+	mu     sync.Mutex
+	path   string
+	file   *os.File
+	memory *MemoryStore
+	// lines is the number of lines in the file.
+	lines int
+}
+
+type fileStoreEntry struct {
+	Key       string    `json:"key"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func NewFileStore(path string) (*FileStore, error) {
+	store := &FileStore{
+		path:   path,
+		memory: NewMemoryStore(),
+	}
+
+	file, err := os.Open(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	if file != nil {
+		defer file.Close()
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			entry := &fileStoreEntry{}
+			if err := json.Unmarshal(scanner.Bytes(), entry); err != nil {
+				return nil, fmt.Errorf("error parsing store file %s: %v", path, err)
+			}
+
+			// The latest line of a key wins, e.g. a removed key is written again as expired.
+			store.memory.entries[entry.Key] = entry.ExpiresAt
+		}
+
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := store.compact(); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func (s *FileStore) Add(key string, expiresAt time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	added, err := s.memory.Add(key, expiresAt)
+	if err != nil || !added {
+		return added, err
+	}
+
+	line, err := json.Marshal(&fileStoreEntry{Key: key, ExpiresAt: expiresAt})
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return false, err
+	}
+	s.lines++
+
+	if s.lines >= MIN_PURGE_SIZE && s.lines > 2*len(s.memory.entries) {
+		return true, s.compact()
+	}
+
+	return true, nil
+}
+
+// Remove forgets the key and writes it to the file as expired, since the file is only appended to.
+func (s *FileStore) Remove(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.memory.Remove(key); err != nil {
+		return err
+	}
+
+	line, err := json.Marshal(&fileStoreEntry{Key: key, ExpiresAt: time.Time{}})
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	s.lines++
+
+	return nil
+}
+
+// Close closes the file of the store.
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Close()
+}
+
+// compact rewrites the file with the keys that have not expired.
+func (s *FileStore) compact() error {
+	tmpPath := s.path + ".tmp"
+	tmpFile, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	s.memory.mu.Lock()
+	s.memory.removeExpired(time.Now())
+	writer := bufio.NewWriter(tmpFile)
+	lines := 0
+	for key, expiresAt := range s.memory.entries {
+		line, err := json.Marshal(&fileStoreEntry{Key: key, ExpiresAt: expiresAt})
+		if err == nil {
+			_, err = writer.Write(append(line, '\n'))
+		}
+
+		if err != nil {
+			s.memory.mu.Unlock()
+			tmpFile.Close()
+			return err
+		}
+		lines++
+	}
+	s.memory.mu.Unlock()
+
+	if err := writer.Flush(); err != nil {
+		tmpFile.Close()
+		return err
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		return err
+	}
+
+	if s.file != nil {
+		s.file.Close()
+	}
+
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	s.file = file
+	s.lines = lines
+
+	return nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package coalescer_test
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/reviewpad/reviewpad/v4/coalescer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryStore_Add(t *testing.T) {
+	store := coalescer.NewMemoryStore()
+	now := time.Now()
+
+	added, err := store.Add("delivery", now.Add(time.Hour))
+	assert.Nil(t, err)
+	assert.True(t, added)
+
+	added, err = store.Add("delivery", now.Add(time.Hour))
+	assert.Nil(t, err)
+	assert.False(t, added)
+
+	added, err = store.Add("expired", now.Add(-time.Hour))
+	assert.Nil(t, err)
+	assert.True(t, added)
+
+	added, err = store.Add("expired", now.Add(time.Hour))
+	assert.Nil(t, err)
+	assert.True(t, added)
+}
+
+func TestMemoryStore_Remove(t *testing.T) {
+	store := coalescer.NewMemoryStore()
+	now := time.Now()
+
+	added, err := store.Add("delivery", now.Add(time.Hour))
+	assert.Nil(t, err)
+	assert.True(t, added)
+
+	assert.Nil(t, store.Remove("delivery"))
+	assert.Nil(t, store.Remove("unknown"))
+
+	added, err = store.Add("delivery", now.Add(time.Hour))
+	assert.Nil(t, err)
+	assert.True(t, added)
+}
+
+func TestFileStore_KeepsKeysAcrossRestarts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "deliveries")
+	now := time.Now()
+
+	store, err := coalescer.NewFileStore(path)
+	assert.Nil(t, err)
+
+	added, err := store.Add("delivery", now.Add(time.Hour))
+	assert.Nil(t, err)
+	assert.True(t, added)
+
+	added, err = store.Add("expired", now.Add(-time.Hour))
+	assert.Nil(t, err)
+	assert.True(t, added)
+
+	assert.Nil(t, store.Close())
+
+	store, err = coalescer.NewFileStore(path)
+	assert.Nil(t, err)
+	defer store.Close()
+
+	added, err = store.Add("delivery", now.Add(time.Hour))
+	assert.Nil(t, err)
+	assert.False(t, added)
+
+	added, err = store.Add("expired", now.Add(time.Hour))
+	assert.Nil(t, err)
+	assert.True(t, added)
+}
+
+func TestFileStore_KeepsRemovedKeysAcrossRestarts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "deliveries")
+	now := time.Now()
+
+	store, err := coalescer.NewFileStore(path)
+	assert.Nil(t, err)
+
+	added, err := store.Add("delivery", now.Add(time.Hour))
+	assert.Nil(t, err)
+	assert.True(t, added)
+
+	assert.Nil(t, store.Remove("delivery"))
+	assert.Nil(t, store.Close())
+
+	store, err = coalescer.NewFileStore(path)
+	assert.Nil(t, err)
+	defer store.Close()
+
+	added, err = store.Add("delivery", now.Add(time.Hour))
+	assert.Nil(t, err)
+	assert.True(t, added)
+}
+
+func TestFileStore_RemovesExpiredKeysFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "deliveries")
+	now := time.Now()
+
+	store, err := coalescer.NewFileStore(path)
+	assert.Nil(t, err)
+	defer store.Close()
+
+	for i := 0; i < coalescer.MIN_PURGE_SIZE; i++ {
+		_, err := store.Add(fmt.Sprintf("expired-%d", i), now.Add(-time.Hour))
+		assert.Nil(t, err)
+	}
+
+	_, err = store.Add("delivery", now.Add(time.Hour))
+	assert.Nil(t, err)
+
+	assert.Equal(t, 1, countLines(t, path))
+}
+
+func TestNewFileStore_WhenFileIsInvalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "deliveries")
+	assert.Nil(t, os.WriteFile(path, []byte("not json\n"), 0o600))
+
+	store, err := coalescer.NewFileStore(path)
+
+	assert.Nil(t, store)
+	assert.ErrorContains(t, err, "error parsing store file")
+}
+
+func countLines(t *testing.T, path string) int {
+	file, err := os.Open(path)
+	assert.Nil(t, err)
+	defer file.Close()
+
+	lines := 0
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lines++
+	}
+
+	return lines
+}
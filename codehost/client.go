@@ -13,6 +13,7 @@ import (
 	api "github.com/reviewpad/api/go/services"
 	"github.com/reviewpad/go-lib/host"
 	"github.com/reviewpad/go-lib/uri"
+	"github.com/reviewpad/reviewpad/v4/codehost/gitlab"
 )
 
 const RequestIDKey = "request-id"
@@ -21,6 +22,9 @@ type CodeHostClient struct {
 	HostInfo       *HostInfo
 	CodehostClient api.HostClient
 	Token          string
+	// GitlabClient is the client of the GitLab API, which is only set when the host is GitLab.
+	// GitLab targets are fetched with it rather than with the codehost service.
+	GitlabClient *gitlab.GitlabClient
 }
 
 type HostInfo struct {
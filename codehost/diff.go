@@ -29,6 +29,22 @@ type diffBlock struct {
 	newLine   string
 }
 
+// OldLines returns the lines of the block in the old version of the file.
+func (b *diffBlock) OldLines() []string {
+	if b.Old == nil {
+		return nil
+	}
+	return strings.Split(b.oldLine, "\n")
+}
+
+// NewLines returns the lines of the block in the new version of the file.
+func (b *diffBlock) NewLines() []string {
+	if b.New == nil {
+		return nil
+	}
+	return strings.Split(b.newLine, "\n")
+}
+
 type chunkLinesInfo struct {
 	oldLine, newLine int32
 	numOld, numNew   int32
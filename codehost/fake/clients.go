@@ -0,0 +1,123 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package fake
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/hasura/go-graphql-client"
+	pbe "github.com/reviewpad/api/go/entities"
+	api "github.com/reviewpad/api/go/services"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	gh "github.com/reviewpad/reviewpad/v4/codehost/github"
+	"github.com/shurcooL/githubv4"
+	"google.golang.org/grpc"
+)
+
+// handlerTransport is an http.RoundTripper that serves the requests with the handler of the host
+// instead of going over an HTTP connection.
+type handlerTransport struct {
+	handler http.Handler
+}
+
+func (t handlerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	w := httptest.NewRecorder()
+	t.handler.ServeHTTP(w, req)
+
+	// The GitHub client reports the request of the failed responses.
+	resp := w.Result()
+	resp.Request = req
+
+	return resp, nil
+}
+
+// HTTPClient returns an HTTP client whose requests are served by the host.
+func (h *Host) HTTPClient() *http.Client {
+	return &http.Client{Transport: handlerTransport{handler: h.Handler()}}
+}
+
+// GithubClient returns a GitHub client backed by the host.
+func (h *Host) GithubClient() *gh.GithubClient {
+	httpClient := h.HTTPClient()
+
+	return gh.NewGithubClient(
+		github.NewClient(httpClient),
+		githubv4.NewClient(httpClient),
+		graphql.NewClient("https://api.github.com/graphql", httpClient),
+	)
+}
+
+// CodeHostClient returns a code host client backed by the host.
+func (h *Host) CodeHostClient() *codehost.CodeHostClient {
+	return &codehost.CodeHostClient{
+		HostInfo: &codehost.HostInfo{
+			Host:    pbe.Host_GITHUB,
+			HostUri: "https://github.com",
+		},
+		CodehostClient: &hostClient{host: h},
+	}
+}
+
+// hostClient serves the requests of the code host client with the state of the host.
+// Only the requests made by reviewpad are implemented.
+type hostClient struct {
+	api.HostClient
+	host *Host
+}
+
+func splitSlug(slug string) (string, string) {
+	owner, repo, _ := strings.Cut(slug, "/")
+	return owner, repo
+}
+
+func (c *hostClient) GetPullRequest(_ context.Context, in *api.GetPullRequestRequest, _ ...grpc.CallOption) (*api.GetPullRequestReply, error) {
+	c.host.mu.Lock()
+	defer c.host.mu.Unlock()
+
+	owner, repo := splitSlug(in.Slug)
+
+	issue, err := c.host.pullRequest(owner, repo, int(in.Number))
+	if err != nil {
+		return nil, err
+	}
+
+	repository, _ := c.host.repository(owner, repo)
+
+	return &api.GetPullRequestReply{PullRequest: toPbcPullRequest(repository, issue)}, nil
+}
+
+func (c *hostClient) GetPullRequestFiles(_ context.Context, in *api.GetPullRequestFilesRequest, _ ...grpc.CallOption) (*api.GetPullRequestFilesReply, error) {
+	c.host.mu.Lock()
+	defer c.host.mu.Unlock()
+
+	owner, repo := splitSlug(in.Slug)
+
+	issue, err := c.host.pullRequest(owner, repo, int(in.Number))
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GetPullRequestFilesReply{Files: issue.PullRequest.Files}, nil
+}
+
+func (c *hostClient) PostGeneralComment(_ context.Context, in *api.PostGeneralCommentRequest, _ ...grpc.CallOption) (*api.PostGeneralCommentReply, error) {
+	c.host.mu.Lock()
+	defer c.host.mu.Unlock()
+
+	owner, repo := splitSlug(in.Slug)
+
+	issue, err := c.host.issue(owner, repo, int(in.ReviewNumber))
+	if err != nil {
+		return nil, err
+	}
+
+	c.host.addComment(issue, REVIEWPAD_LOGIN, in.GetComment().GetBody())
+
+	return &api.PostGeneralCommentReply{}, nil
+}
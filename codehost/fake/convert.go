@@ -0,0 +1,368 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v52/github"
+	pbc "github.com/reviewpad/api/go/codehost"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func toGithubUser(login string) *github.User {
+	return &github.User{Login: github.String(login)}
+}
+
+func toGithubUsers(logins []string) []*github.User {
+	users := make([]*github.User, len(logins))
+	for i, login := range logins {
+		users[i] = toGithubUser(login)
+	}
+
+	return users
+}
+
+func toGithubTimestamp(t *time.Time) *github.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return &github.Timestamp{Time: *t}
+}
+
+func toGithubLabel(label *Label) *github.Label {
+	return &github.Label{
+		ID:          github.Int64(label.ID),
+		NodeID:      github.String(fmt.Sprintf("LA_%d", label.ID)),
+		Name:        github.String(label.Name),
+		Color:       github.String(label.Color),
+		Description: github.String(label.Description),
+	}
+}
+
+func toGithubLabels(repository *Repository, names []string) []*github.Label {
+	labels := make([]*github.Label, len(names))
+	for i, name := range names {
+		labels[i] = toGithubLabel(repository.Labels[name])
+	}
+
+	return labels
+}
+
+func toGithubRepository(repository *Repository) *github.Repository {
+	visibility := "public"
+	if repository.Private {
+		visibility = "private"
+	}
+
+	return &github.Repository{
+		Name:       github.String(repository.Name),
+		FullName:   github.String(repositoryKey(repository.Owner, repository.Name)),
+		Owner:      &github.User{Login: github.String(repository.Owner), Type: github.String("Organization")},
+		Private:    github.Bool(repository.Private),
+		Visibility: github.String(visibility),
+	}
+}
+
+func toGithubIssue(repository *Repository, issue *Issue) *github.Issue {
+	ghIssue := &github.Issue{
+		ID:          github.Int64(int64(issue.Number)),
+		NodeID:      github.String(issueNodeID(issue)),
+		Number:      github.Int(issue.Number),
+		Title:       github.String(issue.Title),
+		Body:        github.String(issue.Body),
+		State:       github.String(issue.State),
+		StateReason: github.String(issue.StateReason),
+		Locked:      github.Bool(issue.Locked),
+		User:        toGithubUser(issue.Author),
+		Labels:      toGithubLabels(repository, issue.Labels),
+		Assignees:   toGithubUsers(issue.Assignees),
+		Comments:    github.Int(len(issue.Comments)),
+		CreatedAt:   &github.Timestamp{Time: issue.CreatedAt},
+		UpdatedAt:   &github.Timestamp{Time: issue.UpdatedAt},
+		ClosedAt:    toGithubTimestamp(issue.ClosedAt),
+		Repository:  toGithubRepository(repository),
+	}
+
+	if issue.Milestone != "" {
+		ghIssue.Milestone = &github.Milestone{Title: github.String(issue.Milestone)}
+	}
+
+	if issue.PullRequest != nil {
+		ghIssue.PullRequestLinks = &github.PullRequestLinks{
+			URL: github.String(fmt.Sprintf("https://api.github.com/repos/%s/%s/pulls/%d", repository.Owner, repository.Name, issue.Number)),
+		}
+	}
+
+	return ghIssue
+}
+
+func toGithubPullRequest(repository *Repository, issue *Issue) *github.PullRequest {
+	pullRequest := issue.PullRequest
+	ghRepository := toGithubRepository(repository)
+
+	additions, deletions := 0, 0
+	for _, file := range pullRequest.Files {
+		additions += int(file.GetAdditionsCount())
+		deletions += int(file.GetDeletionsCount())
+	}
+
+	ghPullRequest := &github.PullRequest{
+		ID:                 github.Int64(int64(issue.Number)),
+		NodeID:             github.String(issueNodeID(issue)),
+		Number:             github.Int(issue.Number),
+		Title:              github.String(issue.Title),
+		Body:               github.String(issue.Body),
+		State:              github.String(issue.State),
+		Locked:             github.Bool(issue.Locked),
+		Draft:              github.Bool(pullRequest.Draft),
+		Merged:             github.Bool(pullRequest.Merged),
+		Mergeable:          github.Bool(pullRequest.Mergeable),
+		Rebaseable:         github.Bool(pullRequest.Rebaseable),
+		User:               toGithubUser(issue.Author),
+		Labels:             toGithubLabels(repository, issue.Labels),
+		Assignees:          toGithubUsers(issue.Assignees),
+		RequestedReviewers: toGithubUsers(pullRequest.RequestedReviewers),
+		Comments:           github.Int(len(issue.Comments)),
+		Commits:            github.Int(len(pullRequest.Commits)),
+		Additions:          github.Int(additions),
+		Deletions:          github.Int(deletions),
+		ChangedFiles:       github.Int(len(pullRequest.Files)),
+		CreatedAt:          &github.Timestamp{Time: issue.CreatedAt},
+		UpdatedAt:          &github.Timestamp{Time: issue.UpdatedAt},
+		ClosedAt:           toGithubTimestamp(issue.ClosedAt),
+		MergedAt:           toGithubTimestamp(pullRequest.MergedAt),
+		Head: &github.PullRequestBranch{
+			Ref:  github.String(pullRequest.Head),
+			SHA:  github.String(pullRequest.HeadSHA),
+			Repo: ghRepository,
+		},
+		Base: &github.PullRequestBranch{
+			Ref:  github.String(pullRequest.Base),
+			SHA:  github.String(pullRequest.BaseSHA),
+			Repo: ghRepository,
+		},
+	}
+
+	for _, team := range pullRequest.RequestedTeams {
+		ghPullRequest.RequestedTeams = append(ghPullRequest.RequestedTeams, &github.Team{Slug: github.String(team), Name: github.String(team)})
+	}
+
+	if issue.Milestone != "" {
+		ghPullRequest.Milestone = &github.Milestone{Title: github.String(issue.Milestone)}
+	}
+
+	return ghPullRequest
+}
+
+func toGithubComment(comment *Comment) *github.IssueComment {
+	return &github.IssueComment{
+		ID:        github.Int64(comment.ID),
+		Body:      github.String(comment.Body),
+		User:      toGithubUser(comment.Author),
+		CreatedAt: &github.Timestamp{Time: comment.CreatedAt},
+	}
+}
+
+func toGithubReview(review *Review) *github.PullRequestReview {
+	return &github.PullRequestReview{
+		ID:          github.Int64(review.ID),
+		User:        toGithubUser(review.Author),
+		State:       github.String(review.State),
+		Body:        github.String(review.Body),
+		SubmittedAt: &github.Timestamp{Time: review.SubmittedAt},
+	}
+}
+
+func toGithubCommit(commit *Commit) *github.RepositoryCommit {
+	parents := make([]*github.Commit, commit.Parents)
+	for i := range parents {
+		parents[i] = &github.Commit{}
+	}
+
+	return &github.RepositoryCommit{
+		SHA:     github.String(commit.SHA),
+		Author:  toGithubUser(commit.Author),
+		Parents: parents,
+		Commit: &github.Commit{
+			SHA:     github.String(commit.SHA),
+			Message: github.String(commit.Message),
+			Author:  &github.CommitAuthor{Login: github.String(commit.Author), Date: &github.Timestamp{Time: commit.PushedDate}},
+		},
+	}
+}
+
+func toGithubCheckRun(checkRun *CheckRun) *github.CheckRun {
+	ghCheckRun := &github.CheckRun{
+		ID:          github.Int64(checkRun.ID),
+		HeadSHA:     github.String(checkRun.HeadSHA),
+		Name:        github.String(checkRun.Name),
+		Status:      github.String(checkRun.Status),
+		CompletedAt: toGithubTimestamp(checkRun.CompletedAt),
+		Output: &github.CheckRunOutput{
+			Title:   github.String(checkRun.Title),
+			Summary: github.String(checkRun.Summary),
+		},
+	}
+
+	if checkRun.Conclusion != "" {
+		ghCheckRun.Conclusion = github.String(checkRun.Conclusion)
+	}
+
+	return ghCheckRun
+}
+
+func toGithubFile(file *pbc.File) *github.CommitFile {
+	return &github.CommitFile{
+		SHA:       github.String(file.GetSha()),
+		Filename:  github.String(file.GetFilename()),
+		Status:    github.String(strings.ToLower(file.GetStatus().String())),
+		Additions: github.Int(int(file.GetAdditionsCount())),
+		Deletions: github.Int(int(file.GetDeletionsCount())),
+		Changes:   github.Int(int(file.GetChangesCount())),
+		Patch:     github.String(file.GetPatch()),
+	}
+}
+
+func issueNodeID(issue *Issue) string {
+	if issue.PullRequest != nil {
+		return fmt.Sprintf("PR_%d", issue.Number)
+	}
+
+	return fmt.Sprintf("I_%d", issue.Number)
+}
+
+func toPbcUsers(logins []string) []*pbc.User {
+	users := make([]*pbc.User, len(logins))
+	for i, login := range logins {
+		users[i] = &pbc.User{Login: login}
+	}
+
+	return users
+}
+
+func toPbcLabels(repository *Repository, names []string) []*pbc.Label {
+	labels := make([]*pbc.Label, len(names))
+	for i, name := range names {
+		labels[i] = &pbc.Label{
+			Id:   fmt.Sprint(repository.Labels[name].ID),
+			Name: name,
+		}
+	}
+
+	return labels
+}
+
+// toPbcPullRequest converts the pull request to the representation of the codehost service.
+func toPbcPullRequest(repository *Repository, issue *Issue) *pbc.PullRequest {
+	pullRequest := issue.PullRequest
+
+	status := pbc.PullRequestStatus_OPEN
+	switch {
+	case pullRequest.Merged:
+		status = pbc.PullRequestStatus_MERGED
+	case issue.State == "closed":
+		status = pbc.PullRequestStatus_CLOSED
+	}
+
+	uri := fmt.Sprintf("https://github.com/%s/%s", repository.Owner, repository.Name)
+	ghPullRequest := toGithubPullRequest(repository, issue)
+	rawRestResponse, _ := json.Marshal(ghPullRequest)
+
+	pbcPullRequest := &pbc.PullRequest{
+		Id:                issueNodeID(issue),
+		Number:            int64(issue.Number),
+		Title:             issue.Title,
+		Description:       issue.Body,
+		Url:               fmt.Sprintf("%s/pull/%d", uri, issue.Number),
+		Status:            status,
+		Author:            &pbc.User{Login: issue.Author},
+		Assignees:         toPbcUsers(issue.Assignees),
+		Labels:            toPbcLabels(repository, issue.Labels),
+		CreatedAt:         timestamppb.New(issue.CreatedAt),
+		UpdatedAt:         timestamppb.New(issue.UpdatedAt),
+		CommentsCount:     int64(len(issue.Comments)),
+		CommitsCount:      int64(len(pullRequest.Commits)),
+		AdditionsCount:    int64(ghPullRequest.GetAdditions()),
+		DeletionsCount:    int64(ghPullRequest.GetDeletions()),
+		IsDraft:           pullRequest.Draft,
+		IsMerged:          pullRequest.Merged,
+		IsRebaseable:      pullRequest.Rebaseable,
+		IsClosed:          issue.State == "closed",
+		ChangedFilesCount: int64(len(pullRequest.Files)),
+		RawRestResponse:   string(rawRestResponse),
+		Head: &pbc.Branch{
+			Repo: &pbc.Repository{Owner: repository.Owner, Name: repository.Name, Uri: uri},
+			Name: pullRequest.Head,
+			Sha:  pullRequest.HeadSHA,
+		},
+		Base: &pbc.Branch{
+			Repo: &pbc.Repository{Owner: repository.Owner, Name: repository.Name, Uri: uri},
+			Name: pullRequest.Base,
+			Sha:  pullRequest.BaseSHA,
+		},
+		RequestedReviewers: &pbc.RequestedReviewers{
+			Users: toPbcUsers(pullRequest.RequestedReviewers),
+		},
+	}
+
+	for _, team := range pullRequest.RequestedTeams {
+		pbcPullRequest.RequestedReviewers.Teams = append(pbcPullRequest.RequestedReviewers.Teams, &pbc.Team{Name: team})
+	}
+
+	if issue.ClosedAt != nil {
+		pbcPullRequest.ClosedAt = timestamppb.New(*issue.ClosedAt)
+	}
+
+	if pullRequest.MergedAt != nil {
+		pbcPullRequest.MergedAt = timestamppb.New(*pullRequest.MergedAt)
+	}
+
+	if issue.Milestone != "" {
+		pbcPullRequest.Milestone = &pbc.Milestone{Title: issue.Milestone}
+	}
+
+	return pbcPullRequest
+}
+
+// parseLabels parses the labels of a request, which are either names or objects with a name.
+func parseLabels(raw []json.RawMessage) []string {
+	labels := make([]string, 0, len(raw))
+
+	for _, r := range raw {
+		var name string
+		if err := json.Unmarshal(r, &name); err == nil {
+			labels = append(labels, name)
+			continue
+		}
+
+		var label struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(r, &label); err == nil {
+			labels = append(labels, label.Name)
+		}
+	}
+
+	return labels
+}
+
+// reviewState converts the event of a review request to the state of the review.
+func reviewState(event string) string {
+	switch strings.ToUpper(event) {
+	case "APPROVE":
+		return "APPROVED"
+	case "REQUEST_CHANGES":
+		return "CHANGES_REQUESTED"
+	case "COMMENT":
+		return "COMMENTED"
+	default:
+		return "PENDING"
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package fake
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// handleGraphQL answers the GraphQL queries on pull requests and projects used by the GitHub client.
+// The queries are recognized by the connections they select rather than parsed.
+func (h *Host) handleGraphQL(w http.ResponseWriter, r *http.Request, _ routeParams) {
+	var request graphQLRequest
+	if err := decodeBody(r, &request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if h.handleProjectsGraphQL(w, request) {
+		return
+	}
+
+	owner, _ := request.Variables["repositoryOwner"].(string)
+	repo, _ := request.Variables["repositoryName"].(string)
+	number, _ := request.Variables["pullRequestNumber"].(float64)
+
+	// The repositories of the host have no merge queues.
+	if strings.Contains(request.Query, "mergeQueue(branch: $branchName)") {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"data": map[string]interface{}{
+				"repository": map[string]interface{}{
+					"mergeQueue": nil,
+				},
+			},
+		})
+		return
+	}
+
+	if !strings.Contains(request.Query, "pullRequest(number: $pullRequestNumber)") {
+		writeGraphQLError(w, "fake: unsupported query")
+		return
+	}
+
+	issue, err := h.pullRequest(owner, repo, int(number))
+	if err != nil {
+		writeGraphQLError(w, err.Error())
+		return
+	}
+
+	pullRequest := map[string]interface{}{}
+
+	switch {
+	case strings.Contains(request.Query, "reviewThreads"):
+		reviewThreads := make([]map[string]interface{}, len(issue.PullRequest.ReviewThreads))
+		for i, reviewThread := range issue.PullRequest.ReviewThreads {
+			reviewThreads[i] = map[string]interface{}{
+				"isResolved": reviewThread.IsResolved,
+				"isOutdated": reviewThread.IsOutdated,
+			}
+		}
+
+		pullRequest["reviewThreads"] = map[string]interface{}{
+			"nodes": reviewThreads,
+			"pageInfo": map[string]interface{}{
+				"endCursor":   "",
+				"hasNextPage": false,
+			},
+		}
+	case strings.Contains(request.Query, "reviews(first: 1, states: [APPROVED])"):
+		approvals := 0
+		for _, review := range issue.PullRequest.Reviews {
+			if review.State == "APPROVED" {
+				approvals++
+			}
+		}
+
+		pullRequest["reviews"] = map[string]interface{}{
+			"totalCount": approvals,
+		}
+	case strings.Contains(request.Query, "closingIssuesReferences"):
+		pullRequest["closingIssuesReferences"] = map[string]interface{}{
+			"totalCount": len(issue.PullRequest.LinkedIssues),
+		}
+	case strings.Contains(request.Query, "timelineItems"):
+		nodes := []map[string]interface{}{}
+		if commits := issue.PullRequest.Commits; len(commits) > 0 {
+			pushedDate := commits[len(commits)-1].PushedDate
+			if pushedDate.IsZero() {
+				pushedDate = issue.CreatedAt
+			}
+
+			nodes = append(nodes, map[string]interface{}{
+				"__typename": "PullRequestCommit",
+				"commit": map[string]interface{}{
+					"pushedDate":    pushedDate.Format(time.RFC3339),
+					"committedDate": pushedDate.Format(time.RFC3339),
+				},
+			})
+		}
+
+		pullRequest["timelineItems"] = map[string]interface{}{
+			"nodes": nodes,
+		}
+	default:
+		writeGraphQLError(w, "fake: unsupported query")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": map[string]interface{}{
+			"repository": map[string]interface{}{
+				"pullRequest": pullRequest,
+			},
+		},
+	})
+}
+
+func writeGraphQLError(w http.ResponseWriter, message string) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"errors": []map[string]interface{}{
+			{"message": message},
+		},
+	})
+}
@@ -0,0 +1,566 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+// Package fake provides an in-memory code host to run reviewpad files end-to-end in tests.
+// The state of the host is served through the GitHub REST and GraphQL endpoints used by the GitHub client,
+// so the GitHub client, the code host client and the targets of reviewpad can be backed by the host.
+package fake
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	pbc "github.com/reviewpad/api/go/codehost"
+	"github.com/reviewpad/reviewpad/v4/utils"
+)
+
+// REVIEWPAD_LOGIN is the author of the comments and reviews made through the host, i.e. by reviewpad.
+const REVIEWPAD_LOGIN = "reviewpad-bot"
+
+// Host is an in-memory code host.
+// It is safe for concurrent use.
+type Host struct {
+	mu           sync.Mutex
+	repositories map[string]*Repository
+	lastID       int64
+	now          func() time.Time
+}
+
+type Repository struct {
+	Owner     string
+	Name      string
+	Private   bool
+	Labels    map[string]*Label
+	Issues    map[int]*Issue
+	CheckRuns []*CheckRun
+	Projects  []*Project
+	// Members are the users that can be assigned and requested for review.
+	Members []string
+}
+
+type Label struct {
+	ID          int64
+	Name        string
+	Color       string
+	Description string
+}
+
+type Comment struct {
+	ID        int64
+	Author    string
+	Body      string
+	CreatedAt time.Time
+}
+
+type Review struct {
+	ID          int64
+	Author      string
+	State       string
+	Body        string
+	SubmittedAt time.Time
+}
+
+type ReviewThread struct {
+	IsResolved bool
+	IsOutdated bool
+}
+
+type Commit struct {
+	SHA        string
+	Message    string
+	Author     string
+	Parents    int
+	PushedDate time.Time
+}
+
+type CheckRun struct {
+	ID          int64
+	HeadSHA     string
+	Name        string
+	Status      string
+	Conclusion  string
+	Title       string
+	Summary     string
+	CompletedAt *time.Time
+}
+
+type Project struct {
+	Number uint64
+	Title  string
+	Fields []*ProjectField
+	// FieldValues are the values of the fields of the items of the project by issue or pull request number.
+	// The issues and pull requests in the project have the title of the project in their Projects.
+	FieldValues map[int]map[string]string
+}
+
+type ProjectField struct {
+	Name string
+	// DataType is TEXT, NUMBER or SINGLE_SELECT.
+	DataType string
+	// Options are the options of SINGLE_SELECT fields.
+	Options []string
+}
+
+// Issue is an issue or, when PullRequest is set, a pull request.
+// Like on GitHub, issues and pull requests share the numbers of the repository.
+type Issue struct {
+	Number      int
+	Title       string
+	Body        string
+	Author      string
+	State       string
+	StateReason string
+	Locked      bool
+	Labels      []string
+	Assignees   []string
+	Comments    []*Comment
+	Projects    []string
+	Milestone   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	ClosedAt    *time.Time
+	PullRequest *PullRequest
+}
+
+type PullRequest struct {
+	Base               string
+	BaseSHA            string
+	Head               string
+	HeadSHA            string
+	Draft              bool
+	Merged             bool
+	MergeMethod        string
+	MergedAt           *time.Time
+	Mergeable          bool
+	Rebaseable         bool
+	Files              []*pbc.File
+	Commits            []*Commit
+	Reviews            []*Review
+	ReviewThreads      []*ReviewThread
+	RequestedReviewers []string
+	RequestedTeams     []string
+	LinkedIssues       []int
+}
+
+// NewHost creates an empty code host.
+func NewHost() *Host {
+	return &Host{
+		repositories: make(map[string]*Repository),
+		now:          time.Now,
+	}
+}
+
+func repositoryKey(owner, name string) string {
+	return owner + "/" + name
+}
+
+// nextID returns a new ID for the resources of the host.
+// The host must be locked.
+func (h *Host) nextID() int64 {
+	h.lastID++
+	return h.lastID
+}
+
+// AddRepository adds an empty repository with the members.
+func (h *Host) AddRepository(owner, name string, members ...string) *Repository {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	repository := &Repository{
+		Owner:   owner,
+		Name:    name,
+		Labels:  make(map[string]*Label),
+		Issues:  make(map[int]*Issue),
+		Members: members,
+	}
+
+	h.repositories[repositoryKey(owner, name)] = repository
+
+	return repository
+}
+
+// AddIssue adds the issue to the repository and returns its number.
+// When the number of the issue is 0, the next number of the repository is used.
+func (h *Host) AddIssue(owner, repo string, issue *Issue) (int, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	repository, err := h.repository(owner, repo)
+	if err != nil {
+		return 0, err
+	}
+
+	if issue.Number == 0 {
+		issue.Number = repository.nextIssueNumber()
+	}
+
+	if _, ok := repository.Issues[issue.Number]; ok {
+		return 0, fmt.Errorf("fake: issue %v/%v#%d already exists", owner, repo, issue.Number)
+	}
+
+	if issue.State == "" {
+		issue.State = "open"
+	}
+
+	if issue.CreatedAt.IsZero() {
+		issue.CreatedAt = h.now()
+	}
+
+	if issue.UpdatedAt.IsZero() {
+		issue.UpdatedAt = issue.CreatedAt
+	}
+
+	for _, label := range issue.Labels {
+		h.ensureLabel(repository, label)
+	}
+
+	repository.Issues[issue.Number] = issue
+
+	return issue.Number, nil
+}
+
+// AddPullRequest adds the pull request to the repository and returns its number.
+func (h *Host) AddPullRequest(owner, repo string, issue *Issue, pullRequest *PullRequest) (int, error) {
+	issue.PullRequest = pullRequest
+
+	return h.AddIssue(owner, repo, issue)
+}
+
+// AddCheckRun adds the check run to the repository.
+func (h *Host) AddCheckRun(owner, repo string, checkRun *CheckRun) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	repository, err := h.repository(owner, repo)
+	if err != nil {
+		return err
+	}
+
+	checkRun.ID = h.nextID()
+	repository.CheckRuns = append(repository.CheckRuns, checkRun)
+
+	return nil
+}
+
+// AddProject adds the project to the repository.
+func (h *Host) AddProject(owner, repo string, project *Project) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	repository, err := h.repository(owner, repo)
+	if err != nil {
+		return err
+	}
+
+	if project.Number == 0 {
+		project.Number = uint64(len(repository.Projects) + 1)
+	}
+
+	if project.FieldValues == nil {
+		project.FieldValues = make(map[int]map[string]string)
+	}
+
+	repository.Projects = append(repository.Projects, project)
+
+	return nil
+}
+
+// Project returns a copy of the project with the number to assert on the state of the host.
+func (h *Host) Project(owner, repo string, number uint64) (*Project, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	repository, err := h.repository(owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, project := range repository.Projects {
+		if project.Number == number {
+			return project.copy(), nil
+		}
+	}
+
+	return nil, fmt.Errorf("fake: project %v/%v#%d not found", owner, repo, number)
+}
+
+// Issue returns a copy of the issue or pull request to assert on the state of the host.
+func (h *Host) Issue(owner, repo string, number int) (*Issue, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	issue, err := h.issue(owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	return issue.copy(), nil
+}
+
+// Labels returns the names of the labels of the repository, sorted by name.
+func (h *Host) Labels(owner, repo string) ([]string, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	repository, err := h.repository(owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := make([]string, 0, len(repository.Labels))
+	for name := range repository.Labels {
+		labels = append(labels, name)
+	}
+
+	sort.Strings(labels)
+
+	return labels, nil
+}
+
+// CheckRuns returns copies of the check runs of the commit.
+func (h *Host) CheckRuns(owner, repo, sha string) ([]*CheckRun, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	repository, err := h.repository(owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	checkRuns := make([]*CheckRun, 0)
+	for _, checkRun := range repository.CheckRuns {
+		if checkRun.HeadSHA == sha {
+			c := *checkRun
+			checkRuns = append(checkRuns, &c)
+		}
+	}
+
+	return checkRuns, nil
+}
+
+// nextIssueNumber returns the number after the highest number of the issues and pull requests of the repository.
+func (r *Repository) nextIssueNumber() int {
+	next := 1
+	for number := range r.Issues {
+		if number >= next {
+			next = number + 1
+		}
+	}
+
+	return next
+}
+
+// sortedIssues returns the issues and pull requests of the repository sorted by number.
+func (r *Repository) sortedIssues() []*Issue {
+	issues := make([]*Issue, 0, len(r.Issues))
+	for _, issue := range r.Issues {
+		issues = append(issues, issue)
+	}
+
+	sort.Slice(issues, func(i, j int) bool {
+		return issues[i].Number < issues[j].Number
+	})
+
+	return issues
+}
+
+// The following methods must be called with the host locked.
+
+func (h *Host) repository(owner, name string) (*Repository, error) {
+	repository, ok := h.repositories[repositoryKey(owner, name)]
+	if !ok {
+		return nil, fmt.Errorf("fake: repository %v/%v not found", owner, name)
+	}
+
+	return repository, nil
+}
+
+func (h *Host) issue(owner, repo string, number int) (*Issue, error) {
+	repository, err := h.repository(owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	issue, ok := repository.Issues[number]
+	if !ok {
+		return nil, fmt.Errorf("fake: issue %v/%v#%d not found", owner, repo, number)
+	}
+
+	return issue, nil
+}
+
+func (h *Host) pullRequest(owner, repo string, number int) (*Issue, error) {
+	issue, err := h.issue(owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	if issue.PullRequest == nil {
+		return nil, fmt.Errorf("fake: pull request %v/%v#%d not found", owner, repo, number)
+	}
+
+	return issue, nil
+}
+
+// ensureLabel creates the label in the repository when it doesn't exist, like GitHub does when a label is added to an issue.
+func (h *Host) ensureLabel(repository *Repository, name string) *Label {
+	label, ok := repository.Labels[name]
+	if !ok {
+		label = &Label{ID: h.nextID(), Name: name, Color: "ededed"}
+		repository.Labels[name] = label
+	}
+
+	return label
+}
+
+func (h *Host) addLabels(repository *Repository, issue *Issue, labels []string) {
+	for _, label := range labels {
+		h.ensureLabel(repository, label)
+
+		if !utils.ElementOf(issue.Labels, label) {
+			issue.Labels = append(issue.Labels, label)
+		}
+	}
+
+	issue.UpdatedAt = h.now()
+}
+
+func (h *Host) removeLabel(issue *Issue, label string) bool {
+	for i, l := range issue.Labels {
+		if l == label {
+			issue.Labels = append(issue.Labels[:i], issue.Labels[i+1:]...)
+			issue.UpdatedAt = h.now()
+			return true
+		}
+	}
+
+	return false
+}
+
+func (h *Host) addAssignees(issue *Issue, assignees []string) {
+	for _, assignee := range assignees {
+		if !utils.ElementOf(issue.Assignees, assignee) {
+			issue.Assignees = append(issue.Assignees, assignee)
+		}
+	}
+
+	issue.UpdatedAt = h.now()
+}
+
+func (h *Host) addComment(issue *Issue, author, body string) *Comment {
+	comment := &Comment{
+		ID:        h.nextID(),
+		Author:    author,
+		Body:      body,
+		CreatedAt: h.now(),
+	}
+
+	issue.Comments = append(issue.Comments, comment)
+	issue.UpdatedAt = comment.CreatedAt
+
+	return comment
+}
+
+func (h *Host) close(issue *Issue, stateReason string) {
+	if issue.State == "closed" {
+		return
+	}
+
+	now := h.now()
+	issue.State = "closed"
+	issue.StateReason = stateReason
+	issue.ClosedAt = &now
+	issue.UpdatedAt = now
+}
+
+func (h *Host) merge(issue *Issue, mergeMethod string) error {
+	pullRequest := issue.PullRequest
+	if pullRequest.Merged || issue.State != "open" {
+		return fmt.Errorf("fake: pull request #%d is not open", issue.Number)
+	}
+
+	if pullRequest.Draft {
+		return fmt.Errorf("fake: pull request #%d is a draft", issue.Number)
+	}
+
+	now := h.now()
+	pullRequest.Merged = true
+	pullRequest.MergeMethod = mergeMethod
+	pullRequest.MergedAt = &now
+	h.close(issue, "")
+
+	return nil
+}
+
+func (h *Host) addReview(issue *Issue, author, state, body string) *Review {
+	review := &Review{
+		ID:          h.nextID(),
+		Author:      author,
+		State:       state,
+		Body:        body,
+		SubmittedAt: h.now(),
+	}
+
+	pullRequest := issue.PullRequest
+	pullRequest.Reviews = append(pullRequest.Reviews, review)
+
+	// Reviewing removes the review request of the reviewer.
+	for i, reviewer := range pullRequest.RequestedReviewers {
+		if reviewer == author {
+			pullRequest.RequestedReviewers = append(pullRequest.RequestedReviewers[:i], pullRequest.RequestedReviewers[i+1:]...)
+			break
+		}
+	}
+
+	return review
+}
+
+func (i *Issue) copy() *Issue {
+	c := *i
+	c.Labels = append([]string{}, i.Labels...)
+	c.Assignees = append([]string{}, i.Assignees...)
+	c.Projects = append([]string{}, i.Projects...)
+	c.Comments = make([]*Comment, len(i.Comments))
+
+	for j, comment := range i.Comments {
+		commentCopy := *comment
+		c.Comments[j] = &commentCopy
+	}
+
+	if i.PullRequest != nil {
+		pullRequest := *i.PullRequest
+		pullRequest.RequestedReviewers = append([]string{}, i.PullRequest.RequestedReviewers...)
+		pullRequest.RequestedTeams = append([]string{}, i.PullRequest.RequestedTeams...)
+		pullRequest.Reviews = make([]*Review, len(i.PullRequest.Reviews))
+
+		for j, review := range i.PullRequest.Reviews {
+			reviewCopy := *review
+			pullRequest.Reviews[j] = &reviewCopy
+		}
+
+		c.PullRequest = &pullRequest
+	}
+
+	return &c
+}
+
+func (h *Host) requestReviewers(issue *Issue, reviewers, teams []string) {
+	pullRequest := issue.PullRequest
+
+	for _, reviewer := range reviewers {
+		if !utils.ElementOf(pullRequest.RequestedReviewers, reviewer) {
+			pullRequest.RequestedReviewers = append(pullRequest.RequestedReviewers, reviewer)
+		}
+	}
+
+	for _, team := range teams {
+		if !utils.ElementOf(pullRequest.RequestedTeams, team) {
+			pullRequest.RequestedTeams = append(pullRequest.RequestedTeams, team)
+		}
+	}
+
+	issue.UpdatedAt = h.now()
+}
@@ -0,0 +1,303 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package fake_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	pbc "github.com/reviewpad/api/go/codehost"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/codehost/fake"
+	"github.com/reviewpad/reviewpad/v4/collector"
+	"github.com/reviewpad/reviewpad/v4/engine"
+	plugins_aladino_services "github.com/reviewpad/reviewpad/v4/plugins/aladino/services"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newHostWithPullRequest(t *testing.T) (*fake.Host, *entities.TargetEntity) {
+	host := fake.NewHost()
+	host.AddRepository("foobar", "default-mock-repo", "john", "jane")
+
+	number, err := host.AddPullRequest("foobar", "default-mock-repo", &fake.Issue{
+		Title:  "Add feature",
+		Body:   "Please review",
+		Author: "john",
+	}, &fake.PullRequest{
+		Base:    "main",
+		BaseSHA: "abc",
+		Head:    "feature",
+		HeadSHA: "def",
+		Files: []*pbc.File{
+			{Filename: "main.go", Patch: "@@ -1,1 +1,2 @@\n-a\n+b\n+c", AdditionsCount: 2, DeletionsCount: 1, ChangesCount: 3},
+		},
+		Commits: []*fake.Commit{
+			{SHA: "def", Message: "feat: add feature", Author: "john", Parents: 1},
+		},
+		Mergeable: true,
+	})
+	require.Nil(t, err)
+
+	return host, &entities.TargetEntity{
+		Kind:   entities.PullRequest,
+		Owner:  "foobar",
+		Repo:   "default-mock-repo",
+		Number: number,
+	}
+}
+
+func runReviewpadFile(t *testing.T, host *fake.Host, targetEntity *entities.TargetEntity, reviewpadFile string) engine.ExitStatus {
+	t.Setenv(plugins_aladino_services.SEMANTIC_SERVICE_BACKEND, plugins_aladino_services.SEMANTIC_BACKEND_LOCAL)
+	t.Setenv(plugins_aladino_services.ROBIN_SERVICE_BACKEND, plugins_aladino_services.ROBIN_BACKEND_FAKE)
+
+	ctx := context.Background()
+	log := logrus.NewEntry(logrus.New())
+	githubClient := host.GithubClient()
+	collector, err := collector.NewCollector("", "distinctId", "pull_request", "runnerName", nil)
+	require.Nil(t, err)
+
+	file, err := reviewpad.Load(ctx, log, githubClient, bytes.NewBufferString(reviewpadFile))
+	require.Nil(t, err)
+
+	eventDetails := &entities.EventDetails{EventName: "pull_request", EventAction: "opened"}
+
+	exitStatus, _, _, err := reviewpad.Run(ctx, log, githubClient, host.CodeHostClient(), collector, targetEntity, eventDetails, file, nil, false, false)
+	require.Nil(t, err)
+
+	return exitStatus
+}
+
+func TestRun_WithFakeHost(t *testing.T) {
+	host, targetEntity := newHostWithPullRequest(t)
+
+	reviewpadFile := `
+mode: verbose
+
+labels:
+  small:
+    color: "294b69"
+    description: Small pull requests
+
+workflows:
+  - name: label-small-pull-requests
+    run:
+      - if: $size() < 10
+        then:
+          - $addLabel("small")
+          - $assignAssignees(["jane"])
+          - $comment($sprintf("Thanks @%s", [$author()]))
+`
+
+	exitStatus := runReviewpadFile(t, host, targetEntity, reviewpadFile)
+
+	assert.Equal(t, engine.ExitStatusSuccess, exitStatus)
+
+	pullRequest, err := host.Issue("foobar", "default-mock-repo", targetEntity.Number)
+	require.Nil(t, err)
+
+	assert.Equal(t, []string{"small"}, pullRequest.Labels)
+	assert.Equal(t, []string{"jane"}, pullRequest.Assignees)
+	require.NotEmpty(t, pullRequest.Comments)
+	assert.Equal(t, "Thanks @john", pullRequest.Comments[0].Body)
+	assert.Equal(t, fake.REVIEWPAD_LOGIN, pullRequest.Comments[0].Author)
+
+	labels, err := host.Labels("foobar", "default-mock-repo")
+	require.Nil(t, err)
+	assert.Equal(t, []string{"small"}, labels)
+}
+
+func TestRun_WithFakeHostWhenMerging(t *testing.T) {
+	host, targetEntity := newHostWithPullRequest(t)
+
+	reviewpadFile := `
+workflows:
+  - name: merge
+    run:
+      - if: $isDraft() == false
+        then: $merge("squash")
+`
+
+	exitStatus := runReviewpadFile(t, host, targetEntity, reviewpadFile)
+
+	assert.Equal(t, engine.ExitStatusSuccess, exitStatus)
+
+	pullRequest, err := host.Issue("foobar", "default-mock-repo", targetEntity.Number)
+	require.Nil(t, err)
+
+	assert.True(t, pullRequest.PullRequest.Merged)
+	assert.Equal(t, "squash", pullRequest.PullRequest.MergeMethod)
+	assert.Equal(t, "closed", pullRequest.State)
+}
+
+func TestRunBatch_WithFakeHost(t *testing.T) {
+	t.Setenv(plugins_aladino_services.SEMANTIC_SERVICE_BACKEND, plugins_aladino_services.SEMANTIC_BACKEND_LOCAL)
+	t.Setenv(plugins_aladino_services.ROBIN_SERVICE_BACKEND, plugins_aladino_services.ROBIN_BACKEND_FAKE)
+
+	host, targetEntity := newHostWithPullRequest(t)
+
+	reviewpadFile := `
+workflows:
+  - name: label-synchronized-pull-requests
+    on:
+      - pull_request.synchronize
+    run: $addLabel("synchronized")
+  - name: label-checked-pull-requests
+    always-run: true
+    on:
+      - check_run.completed
+    run: $addLabel("checked")
+  - name: label-opened-pull-requests
+    always-run: true
+    on:
+      - pull_request.opened
+    run: $addLabel("opened")
+`
+
+	ctx := context.Background()
+	log := logrus.NewEntry(logrus.New())
+	githubClient := host.GithubClient()
+	collector, err := collector.NewCollector("", "distinctId", "pull_request", "runnerName", nil)
+	require.Nil(t, err)
+
+	file, err := reviewpad.Load(ctx, log, githubClient, bytes.NewBufferString(reviewpadFile))
+	require.Nil(t, err)
+
+	allEventDetails := []*entities.EventDetails{
+		{EventName: "pull_request", EventAction: "synchronize"},
+		{EventName: "check_run", EventAction: "completed"},
+	}
+
+	exitStatus, _, _, err := reviewpad.RunBatch(ctx, log, githubClient, host.CodeHostClient(), collector, targetEntity, allEventDetails, file, nil, false, false)
+	require.Nil(t, err)
+	assert.Equal(t, engine.ExitStatusSuccess, exitStatus)
+
+	pullRequest, err := host.Issue("foobar", "default-mock-repo", targetEntity.Number)
+	require.Nil(t, err)
+
+	assert.ElementsMatch(t, []string{"synchronized", "checked"}, pullRequest.Labels)
+}
+
+func TestTarget(t *testing.T) {
+	host, targetEntity := newHostWithPullRequest(t)
+
+	target, err := host.Target(context.Background(), targetEntity)
+	require.Nil(t, err)
+
+	pullRequest, ok := target.(codehost.PullRequest)
+	require.True(t, ok)
+
+	require.Nil(t, pullRequest.AddLabels([]string{"bug"}))
+	require.Nil(t, pullRequest.Review("APPROVE", ""))
+
+	approvals, err := pullRequest.GetApprovalsCount()
+	require.Nil(t, err)
+	assert.Equal(t, 1, approvals)
+
+	issue, err := host.Issue("foobar", "default-mock-repo", targetEntity.Number)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"bug"}, issue.Labels)
+}
+
+func TestServer_WhenEndpointIsNotSupported(t *testing.T) {
+	host := fake.NewHost()
+	server := host.Server()
+	defer server.Close()
+
+	resp, err := server.Client().Get(server.URL + "/repos/foobar/default-mock-repo/deployments")
+	require.Nil(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 404, resp.StatusCode)
+}
+
+func newHostWithProject(t *testing.T) (*fake.Host, *entities.TargetEntity) {
+	host, targetEntity := newHostWithPullRequest(t)
+
+	err := host.AddProject("foobar", "default-mock-repo", &fake.Project{
+		Title: "Roadmap",
+		Fields: []*fake.ProjectField{
+			{Name: "Status", DataType: "SINGLE_SELECT", Options: []string{"Todo", "In Progress", "Done"}},
+			{Name: "Estimate", DataType: "NUMBER"},
+			{Name: "Notes", DataType: "TEXT"},
+		},
+	})
+	require.Nil(t, err)
+
+	return host, targetEntity
+}
+
+func TestRun_WithFakeHostWhenUsingProjects(t *testing.T) {
+	host, targetEntity := newHostWithProject(t)
+
+	reviewpadFile := `
+workflows:
+  - name: track
+    run:
+      - $addToProject("Roadmap", "in progress")
+      - $setProjectField("Roadmap", "Estimate", "3")
+      - $setProjectField("Roadmap", "Notes", "Needs docs")
+      - if: $isLinkedToProject("Roadmap")
+        then: $addLabel("tracked")
+`
+
+	exitStatus := runReviewpadFile(t, host, targetEntity, reviewpadFile)
+
+	assert.Equal(t, engine.ExitStatusSuccess, exitStatus)
+
+	pullRequest, err := host.Issue("foobar", "default-mock-repo", targetEntity.Number)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"Roadmap"}, pullRequest.Projects)
+	assert.Equal(t, []string{"tracked"}, pullRequest.Labels)
+
+	project, err := host.Project("foobar", "default-mock-repo", 1)
+	require.Nil(t, err)
+	assert.Equal(t, map[int]map[string]string{
+		targetEntity.Number: {
+			"Status":   "In Progress",
+			"Estimate": "3",
+			"Notes":    "Needs docs",
+		},
+	}, project.FieldValues)
+}
+
+func TestRun_WithFakeHostWhenRemovingFromProject(t *testing.T) {
+	host, targetEntity := newHostWithProject(t)
+
+	reviewpadFile := `
+workflows:
+  - name: untrack
+    run:
+      - $addToProject("Roadmap", "done")
+      - $removeFromProject("Roadmap")
+`
+
+	exitStatus := runReviewpadFile(t, host, targetEntity, reviewpadFile)
+
+	assert.Equal(t, engine.ExitStatusSuccess, exitStatus)
+
+	pullRequest, err := host.Issue("foobar", "default-mock-repo", targetEntity.Number)
+	require.Nil(t, err)
+	assert.Empty(t, pullRequest.Projects)
+
+	project, err := host.Project("foobar", "default-mock-repo", 1)
+	require.Nil(t, err)
+	assert.Empty(t, project.FieldValues)
+}
+
+func TestServer_ListProjects(t *testing.T) {
+	host, _ := newHostWithProject(t)
+
+	projects, _, err := host.GithubClient().GetClientREST().Repositories.ListProjects(context.Background(), "foobar", "default-mock-repo", nil)
+
+	require.Nil(t, err)
+	require.Len(t, projects, 1)
+	assert.Equal(t, "Roadmap", projects[0].GetName())
+	assert.Equal(t, 1, projects[0].GetNumber())
+}
@@ -0,0 +1,416 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/reviewpad/v4/utils"
+)
+
+func (p *Project) copy() *Project {
+	c := *p
+	c.Fields = make([]*ProjectField, len(p.Fields))
+	c.FieldValues = make(map[int]map[string]string, len(p.FieldValues))
+
+	for i, field := range p.Fields {
+		fieldCopy := *field
+		fieldCopy.Options = append([]string{}, field.Options...)
+		c.Fields[i] = &fieldCopy
+	}
+
+	for number, values := range p.FieldValues {
+		c.FieldValues[number] = make(map[string]string, len(values))
+		for name, value := range values {
+			c.FieldValues[number][name] = value
+		}
+	}
+
+	return &c
+}
+
+func projectNodeID(repository *Repository, project *Project) string {
+	return fmt.Sprintf("PVT_%s_%d", repositoryKey(repository.Owner, repository.Name), project.Number)
+}
+
+func projectFieldNodeID(repository *Repository, project *Project, field int) string {
+	return fmt.Sprintf("%s_F%d", projectNodeID(repository, project), field)
+}
+
+func projectFieldOptionNodeID(repository *Repository, project *Project, field, option int) string {
+	return fmt.Sprintf("%s_O%d", projectFieldNodeID(repository, project, field), option)
+}
+
+func projectItemNodeID(repository *Repository, project *Project, issue *Issue) string {
+	return fmt.Sprintf("%s_I%d", projectNodeID(repository, project), issue.Number)
+}
+
+// projectByNodeID returns the repository and project with the node ID.
+func (h *Host) projectByNodeID(id string) (*Repository, *Project, error) {
+	for _, repository := range h.repositories {
+		for _, project := range repository.Projects {
+			if projectNodeID(repository, project) == id {
+				return repository, project, nil
+			}
+		}
+	}
+
+	return nil, nil, fmt.Errorf("fake: project %v not found", id)
+}
+
+// projectItem returns the issue or pull request of the project item with the node ID.
+func projectItem(repository *Repository, project *Project, id string) (*Issue, error) {
+	for _, issue := range repository.Issues {
+		if projectItemNodeID(repository, project, issue) == id && utils.ElementOf(issue.Projects, project.Title) {
+			return issue, nil
+		}
+	}
+
+	return nil, fmt.Errorf("fake: project item %v not found", id)
+}
+
+func toGithubProject(repository *Repository, project *Project) *github.Project {
+	return &github.Project{
+		NodeID: github.String(projectNodeID(repository, project)),
+		Name:   github.String(project.Title),
+		Number: github.Int(int(project.Number)),
+		State:  github.String("open"),
+	}
+}
+
+func toGraphQLProject(repository *Repository, project *Project) map[string]interface{} {
+	return map[string]interface{}{
+		"id":     projectNodeID(repository, project),
+		"number": project.Number,
+		"title":  project.Title,
+	}
+}
+
+func (h *Host) handleListProjects(w http.ResponseWriter, r *http.Request, params routeParams) {
+	repository, err := h.repository(params["owner"], params["repo"])
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	projects := make([]*github.Project, len(repository.Projects))
+	for i, project := range repository.Projects {
+		projects[i] = toGithubProject(repository, project)
+	}
+
+	writeJSON(w, http.StatusOK, projects)
+}
+
+// handleProjectsGraphQL answers the GraphQL queries and mutations on projects used by the GitHub client.
+// It reports whether the request was about projects.
+func (h *Host) handleProjectsGraphQL(w http.ResponseWriter, request graphQLRequest) bool {
+	input, _ := request.Variables["input"].(map[string]interface{})
+
+	switch {
+	case strings.Contains(request.Query, "projectsV2(query: $name"):
+		h.handleGetProjectsByName(w, request)
+	case strings.Contains(request.Query, "projectV2(number: $projectNumber)"):
+		h.handleGetProjectFields(w, request)
+	case strings.Contains(request.Query, "projectItems("):
+		h.handleListProjectItems(w, request)
+	case strings.Contains(request.Query, "addProjectV2ItemById(input: $input)"):
+		h.handleAddProjectItem(w, input)
+	case strings.Contains(request.Query, "updateProjectV2ItemFieldValue(input: $input)"):
+		h.handleUpdateProjectItemField(w, input)
+	case strings.Contains(request.Query, "deleteProjectV2Item(input: $input)"):
+		h.handleDeleteProjectItem(w, input)
+	default:
+		return false
+	}
+
+	return true
+}
+
+func writeGraphQLData(w http.ResponseWriter, data map[string]interface{}) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": data,
+	})
+}
+
+func (h *Host) handleGetProjectsByName(w http.ResponseWriter, request graphQLRequest) {
+	owner, _ := request.Variables["repositoryOwner"].(string)
+	repo, _ := request.Variables["repositoryName"].(string)
+	name, _ := request.Variables["name"].(string)
+
+	repository, err := h.repository(owner, repo)
+	if err != nil {
+		writeGraphQLError(w, err.Error())
+		return
+	}
+
+	// Like on GitHub, the projects whose titles partially match the name are also returned.
+	nodes := make([]map[string]interface{}, 0)
+	for _, project := range repository.Projects {
+		if strings.Contains(strings.ToLower(project.Title), strings.ToLower(name)) {
+			nodes = append(nodes, toGraphQLProject(repository, project))
+		}
+	}
+
+	writeGraphQLData(w, map[string]interface{}{
+		"repository": map[string]interface{}{
+			"projectsV2": map[string]interface{}{
+				"nodes": nodes,
+			},
+		},
+	})
+}
+
+func (h *Host) handleGetProjectFields(w http.ResponseWriter, request graphQLRequest) {
+	owner, _ := request.Variables["repositoryOwner"].(string)
+	repo, _ := request.Variables["repositoryName"].(string)
+	number, _ := request.Variables["projectNumber"].(float64)
+
+	repository, err := h.repository(owner, repo)
+	if err != nil {
+		writeGraphQLError(w, err.Error())
+		return
+	}
+
+	var projectV2 map[string]interface{}
+	for _, project := range repository.Projects {
+		if project.Number != uint64(number) {
+			continue
+		}
+
+		nodes := make([]map[string]interface{}, len(project.Fields))
+		for i, field := range project.Fields {
+			node := map[string]interface{}{
+				"__typename": "ProjectV2Field",
+				"id":         projectFieldNodeID(repository, project, i),
+				"name":       field.Name,
+				"dataType":   field.DataType,
+			}
+
+			if field.DataType == "SINGLE_SELECT" {
+				options := make([]map[string]interface{}, len(field.Options))
+				for j, option := range field.Options {
+					options[j] = map[string]interface{}{
+						"id":   projectFieldOptionNodeID(repository, project, i, j),
+						"name": option,
+					}
+				}
+
+				node["__typename"] = "ProjectV2SingleSelectField"
+				node["options"] = options
+			}
+
+			nodes[i] = node
+		}
+
+		projectV2 = map[string]interface{}{
+			"fields": map[string]interface{}{
+				"nodes": nodes,
+				"pageInfo": map[string]interface{}{
+					"endCursor":   "",
+					"hasNextPage": false,
+				},
+			},
+		}
+	}
+
+	writeGraphQLData(w, map[string]interface{}{
+		"repository": map[string]interface{}{
+			"projectV2": projectV2,
+		},
+	})
+}
+
+// handleListProjectItems answers the queries of the project items of issues and pull requests.
+// The queries name the variables of the repository and number differently.
+func (h *Host) handleListProjectItems(w http.ResponseWriter, request graphQLRequest) {
+	owner, _ := request.Variables["repositoryOwner"].(string)
+	if owner == "" {
+		owner, _ = request.Variables["owner"].(string)
+	}
+
+	repo, _ := request.Variables["repositoryName"].(string)
+	if repo == "" {
+		repo, _ = request.Variables["name"].(string)
+	}
+
+	number, ok := request.Variables["issueNumber"].(float64)
+	if !ok {
+		number, _ = request.Variables["number"].(float64)
+	}
+
+	issueField := "issue"
+	getIssue := h.issue
+	if strings.Contains(request.Query, "pullRequest(number:") {
+		issueField = "pullRequest"
+		getIssue = h.pullRequest
+	}
+
+	issue, err := getIssue(owner, repo, int(number))
+	if err != nil {
+		writeGraphQLError(w, err.Error())
+		return
+	}
+
+	repository, _ := h.repository(owner, repo)
+
+	nodes := make([]map[string]interface{}, 0)
+	for _, project := range repository.Projects {
+		if !utils.ElementOf(issue.Projects, project.Title) {
+			continue
+		}
+
+		// The queries of the item IDs only select the ID of the projects.
+		graphQLProject := toGraphQLProject(repository, project)
+		if strings.Contains(request.Query, "projectItems(first: 100") {
+			graphQLProject = map[string]interface{}{"id": graphQLProject["id"]}
+		}
+
+		nodes = append(nodes, map[string]interface{}{
+			"id":      projectItemNodeID(repository, project, issue),
+			"project": graphQLProject,
+		})
+	}
+
+	writeGraphQLData(w, map[string]interface{}{
+		"repository": map[string]interface{}{
+			issueField: map[string]interface{}{
+				"projectItems": map[string]interface{}{
+					"nodes": nodes,
+					"pageInfo": map[string]interface{}{
+						"endCursor":   "",
+						"hasNextPage": false,
+					},
+				},
+			},
+		},
+	})
+}
+
+func (h *Host) handleAddProjectItem(w http.ResponseWriter, input map[string]interface{}) {
+	projectID, _ := input["projectId"].(string)
+	contentID, _ := input["contentId"].(string)
+
+	repository, project, err := h.projectByNodeID(projectID)
+	if err != nil {
+		writeGraphQLError(w, err.Error())
+		return
+	}
+
+	var issue *Issue
+	for _, repositoryIssue := range repository.Issues {
+		if issueNodeID(repositoryIssue) == contentID {
+			issue = repositoryIssue
+		}
+	}
+
+	if issue == nil {
+		writeGraphQLError(w, fmt.Sprintf("fake: content %v not found", contentID))
+		return
+	}
+
+	if !utils.ElementOf(issue.Projects, project.Title) {
+		issue.Projects = append(issue.Projects, project.Title)
+	}
+
+	writeGraphQLData(w, map[string]interface{}{
+		"addProjectV2ItemById": map[string]interface{}{
+			"item": map[string]interface{}{
+				"id": projectItemNodeID(repository, project, issue),
+			},
+		},
+	})
+}
+
+func (h *Host) handleUpdateProjectItemField(w http.ResponseWriter, input map[string]interface{}) {
+	projectID, _ := input["projectId"].(string)
+	itemID, _ := input["itemId"].(string)
+	fieldID, _ := input["fieldId"].(string)
+	value, _ := input["value"].(map[string]interface{})
+
+	repository, project, err := h.projectByNodeID(projectID)
+	if err != nil {
+		writeGraphQLError(w, err.Error())
+		return
+	}
+
+	issue, err := projectItem(repository, project, itemID)
+	if err != nil {
+		writeGraphQLError(w, err.Error())
+		return
+	}
+
+	for i, field := range project.Fields {
+		if projectFieldNodeID(repository, project, i) != fieldID {
+			continue
+		}
+
+		var fieldValue string
+		switch {
+		case value["singleSelectOptionId"] != nil:
+			for j, option := range field.Options {
+				if projectFieldOptionNodeID(repository, project, i, j) == value["singleSelectOptionId"] {
+					fieldValue = option
+				}
+			}
+		case value["text"] != nil:
+			fieldValue, _ = value["text"].(string)
+		case value["number"] != nil:
+			number, _ := value["number"].(float64)
+			fieldValue = strconv.FormatFloat(number, 'f', -1, 64)
+		}
+
+		if project.FieldValues[issue.Number] == nil {
+			project.FieldValues[issue.Number] = make(map[string]string)
+		}
+
+		project.FieldValues[issue.Number][field.Name] = fieldValue
+
+		writeGraphQLData(w, map[string]interface{}{
+			"updateProjectV2ItemFieldValue": map[string]interface{}{
+				"clientMutationId": "",
+			},
+		})
+		return
+	}
+
+	writeGraphQLError(w, fmt.Sprintf("fake: project field %v not found", fieldID))
+}
+
+func (h *Host) handleDeleteProjectItem(w http.ResponseWriter, input map[string]interface{}) {
+	projectID, _ := input["projectId"].(string)
+	itemID, _ := input["itemId"].(string)
+
+	repository, project, err := h.projectByNodeID(projectID)
+	if err != nil {
+		writeGraphQLError(w, err.Error())
+		return
+	}
+
+	issue, err := projectItem(repository, project, itemID)
+	if err != nil {
+		writeGraphQLError(w, err.Error())
+		return
+	}
+
+	projects := make([]string, 0, len(issue.Projects))
+	for _, title := range issue.Projects {
+		if title != project.Title {
+			projects = append(projects, title)
+		}
+	}
+
+	issue.Projects = projects
+	delete(project.FieldValues, issue.Number)
+
+	writeGraphQLData(w, map[string]interface{}{
+		"deleteProjectV2Item": map[string]interface{}{
+			"clientMutationId": "",
+		},
+	})
+}
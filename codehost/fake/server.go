@@ -0,0 +1,788 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/reviewpad/v4/utils"
+)
+
+// routeParams are the values of the path parameters of a route, e.g. owner and repo.
+type routeParams map[string]string
+
+func (p routeParams) number() int {
+	n, _ := strconv.Atoi(p["number"])
+	return n
+}
+
+func (p routeParams) id() int64 {
+	id, _ := strconv.ParseInt(p["id"], 10, 64)
+	return id
+}
+
+type route struct {
+	method  string
+	pattern *regexp.Regexp
+	handler func(w http.ResponseWriter, r *http.Request, params routeParams)
+}
+
+// newRoute builds a route from a path with parameters between braces, e.g. /repos/{owner}/{repo}.
+func newRoute(method, path string, handler func(w http.ResponseWriter, r *http.Request, params routeParams)) route {
+	pattern := regexp.MustCompile(`\{(\w+)\}`).ReplaceAllString(path, `(?P<$1>[^/]+)`)
+
+	return route{
+		method:  method,
+		pattern: regexp.MustCompile("^" + pattern + "$"),
+		handler: handler,
+	}
+}
+
+// Server serves the state of the host through the GitHub REST and GraphQL endpoints used by the GitHub client.
+// The requests to other endpoints fail with 404 Not Found so that missing endpoints are easy to spot.
+func (h *Host) Server() *httptest.Server {
+	return httptest.NewServer(h.Handler())
+}
+
+// Handler is the handler of the server of the host.
+func (h *Host) Handler() http.Handler {
+	routes := []route{
+		newRoute(http.MethodGet, "/repos/{owner}/{repo}/issues", h.handleListIssues),
+		newRoute(http.MethodPost, "/repos/{owner}/{repo}/issues", h.handleCreateIssue),
+		newRoute(http.MethodGet, "/repos/{owner}/{repo}/issues/{number}", h.handleGetIssue),
+		newRoute(http.MethodPatch, "/repos/{owner}/{repo}/issues/{number}", h.handleEditIssue),
+		newRoute(http.MethodGet, "/repos/{owner}/{repo}/issues/{number}/labels", h.handleListIssueLabels),
+		newRoute(http.MethodPost, "/repos/{owner}/{repo}/issues/{number}/labels", h.handleAddLabels),
+		newRoute(http.MethodDelete, "/repos/{owner}/{repo}/issues/{number}/labels/{name}", h.handleRemoveLabel),
+		newRoute(http.MethodPost, "/repos/{owner}/{repo}/issues/{number}/assignees", h.handleAddAssignees),
+		newRoute(http.MethodGet, "/repos/{owner}/{repo}/issues/{number}/comments", h.handleListComments),
+		newRoute(http.MethodPost, "/repos/{owner}/{repo}/issues/{number}/comments", h.handleCreateComment),
+		newRoute(http.MethodPatch, "/repos/{owner}/{repo}/issues/comments/{id}", h.handleEditComment),
+		newRoute(http.MethodDelete, "/repos/{owner}/{repo}/issues/comments/{id}", h.handleDeleteComment),
+		newRoute(http.MethodGet, "/repos/{owner}/{repo}/labels/{name}", h.handleGetLabel),
+		newRoute(http.MethodPost, "/repos/{owner}/{repo}/labels", h.handleCreateLabel),
+		newRoute(http.MethodPatch, "/repos/{owner}/{repo}/labels/{name}", h.handleEditLabel),
+		newRoute(http.MethodGet, "/repos/{owner}/{repo}/assignees", h.handleListAssignees),
+		newRoute(http.MethodGet, "/repos/{owner}/{repo}/projects", h.handleListProjects),
+		newRoute(http.MethodGet, "/repos/{owner}/{repo}/pulls/{number}", h.handleGetPullRequest),
+		newRoute(http.MethodGet, "/repos/{owner}/{repo}/pulls/{number}/commits", h.handleListCommits),
+		newRoute(http.MethodGet, "/repos/{owner}/{repo}/pulls/{number}/files", h.handleListFiles),
+		newRoute(http.MethodGet, "/repos/{owner}/{repo}/pulls/{number}/reviews", h.handleListReviews),
+		newRoute(http.MethodPost, "/repos/{owner}/{repo}/pulls/{number}/reviews", h.handleCreateReview),
+		newRoute(http.MethodGet, "/repos/{owner}/{repo}/pulls/{number}/requested_reviewers", h.handleListReviewers),
+		newRoute(http.MethodPost, "/repos/{owner}/{repo}/pulls/{number}/requested_reviewers", h.handleRequestReviewers),
+		newRoute(http.MethodPut, "/repos/{owner}/{repo}/pulls/{number}/merge", h.handleMerge),
+		newRoute(http.MethodGet, "/repos/{owner}/{repo}/commits/{ref}/check-runs", h.handleListCheckRuns),
+		newRoute(http.MethodPost, "/repos/{owner}/{repo}/check-runs", h.handleCreateCheckRun),
+		newRoute(http.MethodPatch, "/repos/{owner}/{repo}/check-runs/{id}", h.handleUpdateCheckRun),
+		newRoute(http.MethodPost, "/graphql", h.handleGraphQL),
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The GitHub Enterprise clients prefix the REST API with /api/v3.
+		path := strings.TrimPrefix(r.URL.EscapedPath(), "/api/v3")
+		path = strings.TrimPrefix(path, "/api")
+
+		for _, route := range routes {
+			if route.method != r.Method {
+				continue
+			}
+
+			matches := route.pattern.FindStringSubmatch(path)
+			if matches == nil {
+				continue
+			}
+
+			params := routeParams{}
+			for i, name := range route.pattern.SubexpNames() {
+				if name != "" {
+					params[name], _ = url.PathUnescape(matches[i])
+				}
+			}
+
+			h.mu.Lock()
+			defer h.mu.Unlock()
+
+			route.handler(w, r, params)
+
+			return
+		}
+
+		writeError(w, http.StatusNotFound, fmt.Sprintf("fake: unsupported endpoint %s %s", r.Method, r.URL.Path))
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	utils.MustWriteBytes(w, mustMarshal(v))
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"message": message})
+}
+
+// writeResult writes v or the error of the request.
+func writeResult(w http.ResponseWriter, status int, v interface{}, err error) {
+	if err != nil {
+		writeError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	writeJSON(w, status, v)
+}
+
+func mustMarshal(v interface{}) []byte {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return data
+}
+
+func decodeBody(r *http.Request, v interface{}) error {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+func (h *Host) handleListIssues(w http.ResponseWriter, r *http.Request, params routeParams) {
+	repository, err := h.repository(params["owner"], params["repo"])
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	state := r.URL.Query().Get("state")
+	if state == "" {
+		state = "open"
+	}
+
+	var labels []string
+	if rawLabels := r.URL.Query().Get("labels"); rawLabels != "" {
+		labels = strings.Split(rawLabels, ",")
+	}
+
+	issues := make([]*github.Issue, 0)
+
+	for _, issue := range repository.sortedIssues() {
+		if state != "all" && issue.State != state {
+			continue
+		}
+
+		hasLabels := true
+		for _, label := range labels {
+			hasLabels = hasLabels && utils.ElementOf(issue.Labels, label)
+		}
+
+		if hasLabels {
+			issues = append(issues, toGithubIssue(repository, issue))
+		}
+	}
+
+	writeJSON(w, http.StatusOK, issues)
+}
+
+func (h *Host) handleCreateIssue(w http.ResponseWriter, r *http.Request, params routeParams) {
+	repository, err := h.repository(params["owner"], params["repo"])
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	var request github.IssueRequest
+	if err := decodeBody(r, &request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	now := h.now()
+	issue := &Issue{
+		Number:    repository.nextIssueNumber(),
+		Title:     request.GetTitle(),
+		Body:      request.GetBody(),
+		State:     "open",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if request.Labels != nil {
+		h.addLabels(repository, issue, *request.Labels)
+	}
+
+	if request.Assignees != nil {
+		h.addAssignees(issue, *request.Assignees)
+	}
+
+	repository.Issues[issue.Number] = issue
+
+	writeJSON(w, http.StatusCreated, toGithubIssue(repository, issue))
+}
+
+func (h *Host) handleGetIssue(w http.ResponseWriter, r *http.Request, params routeParams) {
+	issue, err := h.issue(params["owner"], params["repo"], params.number())
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	repository, _ := h.repository(params["owner"], params["repo"])
+
+	writeJSON(w, http.StatusOK, toGithubIssue(repository, issue))
+}
+
+func (h *Host) handleEditIssue(w http.ResponseWriter, r *http.Request, params routeParams) {
+	issue, err := h.issue(params["owner"], params["repo"], params.number())
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	repository, _ := h.repository(params["owner"], params["repo"])
+
+	var request github.IssueRequest
+	if err := decodeBody(r, &request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if request.Title != nil {
+		issue.Title = request.GetTitle()
+	}
+
+	if request.Body != nil {
+		issue.Body = request.GetBody()
+	}
+
+	if request.Labels != nil {
+		issue.Labels = nil
+		h.addLabels(repository, issue, *request.Labels)
+	}
+
+	if request.Assignees != nil {
+		issue.Assignees = nil
+		h.addAssignees(issue, *request.Assignees)
+	}
+
+	switch request.GetState() {
+	case "closed":
+		h.close(issue, request.GetStateReason())
+	case "open":
+		issue.State = "open"
+		issue.StateReason = ""
+		issue.ClosedAt = nil
+	}
+
+	issue.UpdatedAt = h.now()
+
+	writeJSON(w, http.StatusOK, toGithubIssue(repository, issue))
+}
+
+func (h *Host) handleListIssueLabels(w http.ResponseWriter, r *http.Request, params routeParams) {
+	issue, err := h.issue(params["owner"], params["repo"], params.number())
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	repository, _ := h.repository(params["owner"], params["repo"])
+
+	writeJSON(w, http.StatusOK, toGithubLabels(repository, issue.Labels))
+}
+
+func (h *Host) handleAddLabels(w http.ResponseWriter, r *http.Request, params routeParams) {
+	issue, err := h.issue(params["owner"], params["repo"], params.number())
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	repository, _ := h.repository(params["owner"], params["repo"])
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// The labels are either a list or an object with the list.
+	var rawLabels []json.RawMessage
+	if err := json.Unmarshal(data, &rawLabels); err != nil {
+		var request struct {
+			Labels []json.RawMessage `json:"labels"`
+		}
+
+		if err := json.Unmarshal(data, &request); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		rawLabels = request.Labels
+	}
+
+	h.addLabels(repository, issue, parseLabels(rawLabels))
+
+	writeJSON(w, http.StatusOK, toGithubLabels(repository, issue.Labels))
+}
+
+func (h *Host) handleRemoveLabel(w http.ResponseWriter, r *http.Request, params routeParams) {
+	issue, err := h.issue(params["owner"], params["repo"], params.number())
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	if !h.removeLabel(issue, params["name"]) {
+		writeError(w, http.StatusNotFound, "Label does not exist")
+		return
+	}
+
+	repository, _ := h.repository(params["owner"], params["repo"])
+
+	writeJSON(w, http.StatusOK, toGithubLabels(repository, issue.Labels))
+}
+
+func (h *Host) handleAddAssignees(w http.ResponseWriter, r *http.Request, params routeParams) {
+	issue, err := h.issue(params["owner"], params["repo"], params.number())
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	var request struct {
+		Assignees []string `json:"assignees"`
+	}
+
+	if err := decodeBody(r, &request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	h.addAssignees(issue, request.Assignees)
+
+	repository, _ := h.repository(params["owner"], params["repo"])
+
+	writeJSON(w, http.StatusCreated, toGithubIssue(repository, issue))
+}
+
+func (h *Host) handleListComments(w http.ResponseWriter, r *http.Request, params routeParams) {
+	issue, err := h.issue(params["owner"], params["repo"], params.number())
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	comments := make([]*github.IssueComment, len(issue.Comments))
+	for i, comment := range issue.Comments {
+		comments[i] = toGithubComment(comment)
+	}
+
+	writeJSON(w, http.StatusOK, comments)
+}
+
+func (h *Host) handleCreateComment(w http.ResponseWriter, r *http.Request, params routeParams) {
+	issue, err := h.issue(params["owner"], params["repo"], params.number())
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	var request github.IssueComment
+	if err := decodeBody(r, &request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	comment := h.addComment(issue, REVIEWPAD_LOGIN, request.GetBody())
+
+	writeJSON(w, http.StatusCreated, toGithubComment(comment))
+}
+
+// findComment returns the issue with the comment and the index of the comment.
+func (h *Host) findComment(owner, repo string, id int64) (*Issue, int, error) {
+	repository, err := h.repository(owner, repo)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, issue := range repository.sortedIssues() {
+		for i, comment := range issue.Comments {
+			if comment.ID == id {
+				return issue, i, nil
+			}
+		}
+	}
+
+	return nil, 0, fmt.Errorf("fake: comment %d not found", id)
+}
+
+func (h *Host) handleEditComment(w http.ResponseWriter, r *http.Request, params routeParams) {
+	issue, i, err := h.findComment(params["owner"], params["repo"], params.id())
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	var request github.IssueComment
+	if err := decodeBody(r, &request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	issue.Comments[i].Body = request.GetBody()
+
+	writeJSON(w, http.StatusOK, toGithubComment(issue.Comments[i]))
+}
+
+func (h *Host) handleDeleteComment(w http.ResponseWriter, r *http.Request, params routeParams) {
+	issue, i, err := h.findComment(params["owner"], params["repo"], params.id())
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	issue.Comments = append(issue.Comments[:i], issue.Comments[i+1:]...)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Host) handleGetLabel(w http.ResponseWriter, r *http.Request, params routeParams) {
+	repository, err := h.repository(params["owner"], params["repo"])
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	label, ok := repository.Labels[params["name"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toGithubLabel(label))
+}
+
+func (h *Host) handleCreateLabel(w http.ResponseWriter, r *http.Request, params routeParams) {
+	repository, err := h.repository(params["owner"], params["repo"])
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	var request github.Label
+	if err := decodeBody(r, &request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if _, ok := repository.Labels[request.GetName()]; ok {
+		writeError(w, http.StatusUnprocessableEntity, "Validation Failed")
+		return
+	}
+
+	label := h.ensureLabel(repository, request.GetName())
+	label.Description = request.GetDescription()
+	if request.Color != nil {
+		label.Color = request.GetColor()
+	}
+
+	writeJSON(w, http.StatusCreated, toGithubLabel(label))
+}
+
+func (h *Host) handleEditLabel(w http.ResponseWriter, r *http.Request, params routeParams) {
+	repository, err := h.repository(params["owner"], params["repo"])
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	label, ok := repository.Labels[params["name"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	var request github.Label
+	if err := decodeBody(r, &request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if request.Description != nil {
+		label.Description = request.GetDescription()
+	}
+
+	if request.Color != nil {
+		label.Color = request.GetColor()
+	}
+
+	writeJSON(w, http.StatusOK, toGithubLabel(label))
+}
+
+func (h *Host) handleListAssignees(w http.ResponseWriter, r *http.Request, params routeParams) {
+	repository, err := h.repository(params["owner"], params["repo"])
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toGithubUsers(repository.Members))
+}
+
+func (h *Host) handleGetPullRequest(w http.ResponseWriter, r *http.Request, params routeParams) {
+	issue, err := h.pullRequest(params["owner"], params["repo"], params.number())
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	repository, _ := h.repository(params["owner"], params["repo"])
+
+	writeJSON(w, http.StatusOK, toGithubPullRequest(repository, issue))
+}
+
+func (h *Host) handleListCommits(w http.ResponseWriter, r *http.Request, params routeParams) {
+	issue, err := h.pullRequest(params["owner"], params["repo"], params.number())
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	commits := make([]*github.RepositoryCommit, len(issue.PullRequest.Commits))
+	for i, commit := range issue.PullRequest.Commits {
+		commits[i] = toGithubCommit(commit)
+	}
+
+	writeJSON(w, http.StatusOK, commits)
+}
+
+func (h *Host) handleListFiles(w http.ResponseWriter, r *http.Request, params routeParams) {
+	issue, err := h.pullRequest(params["owner"], params["repo"], params.number())
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	files := make([]*github.CommitFile, len(issue.PullRequest.Files))
+	for i, file := range issue.PullRequest.Files {
+		files[i] = toGithubFile(file)
+	}
+
+	writeJSON(w, http.StatusOK, files)
+}
+
+func (h *Host) handleListReviews(w http.ResponseWriter, r *http.Request, params routeParams) {
+	issue, err := h.pullRequest(params["owner"], params["repo"], params.number())
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	reviews := make([]*github.PullRequestReview, len(issue.PullRequest.Reviews))
+	for i, review := range issue.PullRequest.Reviews {
+		reviews[i] = toGithubReview(review)
+	}
+
+	writeJSON(w, http.StatusOK, reviews)
+}
+
+func (h *Host) handleCreateReview(w http.ResponseWriter, r *http.Request, params routeParams) {
+	issue, err := h.pullRequest(params["owner"], params["repo"], params.number())
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	var request github.PullRequestReviewRequest
+	if err := decodeBody(r, &request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	review := h.addReview(issue, REVIEWPAD_LOGIN, reviewState(request.GetEvent()), request.GetBody())
+
+	writeJSON(w, http.StatusOK, toGithubReview(review))
+}
+
+func (h *Host) handleListReviewers(w http.ResponseWriter, r *http.Request, params routeParams) {
+	issue, err := h.pullRequest(params["owner"], params["repo"], params.number())
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	repository, _ := h.repository(params["owner"], params["repo"])
+	pullRequest := toGithubPullRequest(repository, issue)
+
+	writeJSON(w, http.StatusOK, &github.Reviewers{
+		Users: pullRequest.RequestedReviewers,
+		Teams: pullRequest.RequestedTeams,
+	})
+}
+
+func (h *Host) handleRequestReviewers(w http.ResponseWriter, r *http.Request, params routeParams) {
+	issue, err := h.pullRequest(params["owner"], params["repo"], params.number())
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	var request github.ReviewersRequest
+	if err := decodeBody(r, &request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	h.requestReviewers(issue, request.Reviewers, request.TeamReviewers)
+
+	repository, _ := h.repository(params["owner"], params["repo"])
+
+	writeJSON(w, http.StatusCreated, toGithubPullRequest(repository, issue))
+}
+
+func (h *Host) handleMerge(w http.ResponseWriter, r *http.Request, params routeParams) {
+	issue, err := h.pullRequest(params["owner"], params["repo"], params.number())
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	var request struct {
+		MergeMethod string `json:"merge_method"`
+	}
+
+	if err := decodeBody(r, &request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if request.MergeMethod == "" {
+		request.MergeMethod = "merge"
+	}
+
+	if err := h.merge(issue, request.MergeMethod); err != nil {
+		writeError(w, http.StatusMethodNotAllowed, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &github.PullRequestMergeResult{
+		SHA:     github.String(issue.PullRequest.HeadSHA),
+		Merged:  github.Bool(true),
+		Message: github.String("Pull Request successfully merged"),
+	})
+}
+
+func (h *Host) handleListCheckRuns(w http.ResponseWriter, r *http.Request, params routeParams) {
+	repository, err := h.repository(params["owner"], params["repo"])
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	checkRuns := make([]*github.CheckRun, 0)
+	for _, checkRun := range repository.CheckRuns {
+		if checkRun.HeadSHA == params["ref"] {
+			checkRuns = append(checkRuns, toGithubCheckRun(checkRun))
+		}
+	}
+
+	writeJSON(w, http.StatusOK, &github.ListCheckRunsResults{
+		Total:     github.Int(len(checkRuns)),
+		CheckRuns: checkRuns,
+	})
+}
+
+func (h *Host) handleCreateCheckRun(w http.ResponseWriter, r *http.Request, params routeParams) {
+	repository, err := h.repository(params["owner"], params["repo"])
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	var request github.CreateCheckRunOptions
+	if err := decodeBody(r, &request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	checkRun := &CheckRun{
+		ID:      h.nextID(),
+		HeadSHA: request.HeadSHA,
+		Name:    request.Name,
+		Status:  "queued",
+	}
+
+	updateCheckRun(checkRun, request.Status, request.Conclusion, request.Output)
+	repository.CheckRuns = append(repository.CheckRuns, checkRun)
+
+	writeJSON(w, http.StatusCreated, toGithubCheckRun(checkRun))
+}
+
+func (h *Host) handleUpdateCheckRun(w http.ResponseWriter, r *http.Request, params routeParams) {
+	repository, err := h.repository(params["owner"], params["repo"])
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	var request github.UpdateCheckRunOptions
+	if err := decodeBody(r, &request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	for _, checkRun := range repository.CheckRuns {
+		if checkRun.ID == params.id() {
+			if request.Name != "" {
+				checkRun.Name = request.Name
+			}
+
+			updateCheckRun(checkRun, request.Status, request.Conclusion, request.Output)
+
+			if checkRun.Status == "completed" && checkRun.CompletedAt == nil {
+				now := h.now()
+				checkRun.CompletedAt = &now
+			}
+
+			writeJSON(w, http.StatusOK, toGithubCheckRun(checkRun))
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, fmt.Sprintf("fake: check run %d not found", params.id()))
+}
+
+func updateCheckRun(checkRun *CheckRun, status, conclusion *string, output *github.CheckRunOutput) {
+	if status != nil {
+		checkRun.Status = *status
+	}
+
+	if conclusion != nil {
+		checkRun.Conclusion = *conclusion
+		checkRun.Status = "completed"
+	}
+
+	if output != nil {
+		checkRun.Title = output.GetTitle()
+		checkRun.Summary = output.GetSummary()
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+)
+
+// Target returns the issue or pull request of the target entity backed by the host.
+// The target is the one used by reviewpad so the builtins can be exercised against the state of the host.
+func (h *Host) Target(ctx context.Context, targetEntity *entities.TargetEntity) (codehost.Target, error) {
+	githubClient := h.GithubClient()
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	switch targetEntity.Kind {
+	case entities.PullRequest:
+		codeHostClient := h.CodeHostClient()
+
+		pullRequest, err := codeHostClient.GetPullRequest(ctx, fmt.Sprintf("%s/%s", owner, repo), int64(number))
+		if err != nil {
+			return nil, err
+		}
+
+		return target.NewPullRequestTarget(ctx, targetEntity, githubClient, codeHostClient, pullRequest)
+	case entities.Issue:
+		issue, _, err := githubClient.GetIssue(ctx, owner, repo, number)
+		if err != nil {
+			return nil, err
+		}
+
+		return target.NewIssueTarget(ctx, targetEntity, githubClient, issue), nil
+	default:
+		return nil, fmt.Errorf("fake: unsupported target kind %v", targetEntity.Kind)
+	}
+}
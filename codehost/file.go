@@ -7,10 +7,28 @@ package codehost
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	pbc "github.com/reviewpad/api/go/codehost"
 )
 
+const (
+	DIFF_SIDE_ADDED   = "added"
+	DIFF_SIDE_REMOVED = "removed"
+	DIFF_SIDE_CONTEXT = "context"
+)
+
+// CodeMatch is a match of a pattern in the lines of the diff of a file.
+type CodeMatch struct {
+	Path string
+	// Line is the first line of the match in the old version of the file for removed lines
+	// and in the new version of the file otherwise.
+	Line int
+	// Text is the text of the lines of the match.
+	Text string
+	Side string
+}
+
 type File struct {
 	Repr *pbc.File
 	Diff []*diffBlock
@@ -63,3 +81,67 @@ func (f *File) Query(expr string) (bool, error) {
 	}
 	return false, nil
 }
+
+// IsCommentableLine reports whether the line of the new version of the file is part of the diff
+// and can therefore receive review comments.
+func (f *File) IsCommentableLine(line int) bool {
+	for _, block := range f.Diff {
+		if block.New != nil && int32(line) >= block.New.Start && int32(line) <= block.New.End {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches returns the matches of the pattern in the lines of the diff on the given sides.
+// The consecutive lines of the same side are matched as a whole, so patterns can span several lines.
+func (f *File) Matches(r *regexp.Regexp, sides []string) []*CodeMatch {
+	isSide := make(map[string]bool)
+	for _, side := range sides {
+		isSide[side] = true
+	}
+
+	matches := make([]*CodeMatch, 0)
+	for _, block := range f.Diff {
+		if block.IsContext {
+			if isSide[DIFF_SIDE_CONTEXT] {
+				matches = append(matches, f.matchLines(r, DIFF_SIDE_CONTEXT, block.New.Start, block.NewLines())...)
+			}
+			continue
+		}
+
+		if block.Old != nil && isSide[DIFF_SIDE_REMOVED] {
+			matches = append(matches, f.matchLines(r, DIFF_SIDE_REMOVED, block.Old.Start, block.OldLines())...)
+		}
+
+		if block.New != nil && isSide[DIFF_SIDE_ADDED] {
+			matches = append(matches, f.matchLines(r, DIFF_SIDE_ADDED, block.New.Start, block.NewLines())...)
+		}
+	}
+
+	return matches
+}
+
+func (f *File) matchLines(r *regexp.Regexp, side string, start int32, lines []string) []*CodeMatch {
+	text := strings.Join(lines, "\n")
+
+	matches := make([]*CodeMatch, 0)
+	for _, loc := range r.FindAllStringIndex(text, -1) {
+		// Empty matches, e.g. of patterns like "a*", don't point to any code.
+		if loc[0] == loc[1] {
+			continue
+		}
+
+		firstLine := strings.Count(text[:loc[0]], "\n")
+		lastLine := strings.Count(text[:loc[1]-1], "\n")
+
+		matches = append(matches, &CodeMatch{
+			Path: f.Repr.GetFilename(),
+			Line: int(start) + firstLine,
+			Text: strings.Join(lines[firstLine:lastLine+1], "\n"),
+			Side: side,
+		})
+	}
+
+	return matches
+}
@@ -6,6 +6,7 @@ package codehost
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	pbc "github.com/reviewpad/api/go/codehost"
@@ -148,3 +149,84 @@ func TestQuery_WhenNotFound(t *testing.T) {
 	assert.Nil(t, err)
 	assert.False(t, gotVal)
 }
+
+func TestIsCommentableLine(t *testing.T) {
+	file, err := NewFile(&pbc.File{
+		Filename: "main.go",
+		Patch:    "@@ -1,3 +1,3 @@\n package main\n-import \"os\"\n+import \"fmt\"\n func main() {}",
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"import \"os\""}, file.Diff[1].OldLines())
+	assert.Equal(t, []string{"import \"fmt\""}, file.Diff[1].NewLines())
+	assert.True(t, file.IsCommentableLine(1))
+	assert.True(t, file.IsCommentableLine(2))
+	assert.True(t, file.IsCommentableLine(3))
+	assert.False(t, file.IsCommentableLine(4))
+}
+
+func TestMatches(t *testing.T) {
+	file, err := NewFile(&pbc.File{
+		Filename: "main_test.go",
+		Patch:    "@@ -1,5 +1,6 @@\n func TestMain(t *testing.T) {\n-\tt.Skip()\n-\trun(t)\n+\trun(t) //nolint\n+\tcheck(t) //nolint\n+\tassert(t)\n }",
+	})
+	assert.Nil(t, err)
+
+	tests := map[string]struct {
+		pattern     string
+		sides       []string
+		wantMatches []*CodeMatch
+	}{
+		"removed lines": {
+			pattern: `t\.Skip`,
+			sides:   []string{DIFF_SIDE_REMOVED},
+			wantMatches: []*CodeMatch{
+				{Path: "main_test.go", Line: 2, Text: "\tt.Skip()", Side: DIFF_SIDE_REMOVED},
+			},
+		},
+		"added lines": {
+			pattern: `//nolint`,
+			sides:   []string{DIFF_SIDE_ADDED},
+			wantMatches: []*CodeMatch{
+				{Path: "main_test.go", Line: 2, Text: "\trun(t) //nolint", Side: DIFF_SIDE_ADDED},
+				{Path: "main_test.go", Line: 3, Text: "\tcheck(t) //nolint", Side: DIFF_SIDE_ADDED},
+			},
+		},
+		"context lines": {
+			pattern: `func TestMain|}`,
+			sides:   []string{DIFF_SIDE_CONTEXT},
+			wantMatches: []*CodeMatch{
+				{Path: "main_test.go", Line: 1, Text: "func TestMain(t *testing.T) {", Side: DIFF_SIDE_CONTEXT},
+				{Path: "main_test.go", Line: 5, Text: "}", Side: DIFF_SIDE_CONTEXT},
+			},
+		},
+		"all sides": {
+			pattern: `run\(t\)`,
+			sides:   []string{DIFF_SIDE_ADDED, DIFF_SIDE_REMOVED, DIFF_SIDE_CONTEXT},
+			wantMatches: []*CodeMatch{
+				{Path: "main_test.go", Line: 3, Text: "\trun(t)", Side: DIFF_SIDE_REMOVED},
+				{Path: "main_test.go", Line: 2, Text: "\trun(t) //nolint", Side: DIFF_SIDE_ADDED},
+			},
+		},
+		"multi-line pattern": {
+			pattern: `check\(t\) //nolint\n\tassert`,
+			sides:   []string{DIFF_SIDE_ADDED},
+			wantMatches: []*CodeMatch{
+				{Path: "main_test.go", Line: 3, Text: "\tcheck(t) //nolint\n\tassert(t)", Side: DIFF_SIDE_ADDED},
+			},
+		},
+		"no matches": {
+			pattern:     `t\.Skip`,
+			sides:       []string{DIFF_SIDE_ADDED},
+			wantMatches: []*CodeMatch{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotMatches := file.Matches(regexp.MustCompile(test.pattern), test.sides)
+
+			assert.Equal(t, test.wantMatches, gotMatches)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/bradleyfalzon/ghinstallation/v2"
 	"github.com/google/go-github/v52/github"
@@ -16,6 +17,9 @@ import (
 	"golang.org/x/oauth2"
 )
 
+// GITHUB_API_URL is the URL of the github.com API.
+const GITHUB_API_URL = "https://api.github.com"
+
 type GithubClient struct {
 	clientREST   *github.Client
 	clientGQL    *githubv4.Client
@@ -43,7 +47,7 @@ func NewGithubClientFromToken(ctx context.Context, token string) *GithubClient {
 
 	clientREST := github.NewClient(tc)
 	clientGQL := githubv4.NewClient(tc)
-	rawClientGQL := graphql.NewClient("https://api.github.com/graphql", tc)
+	rawClientGQL := graphql.NewClient(GITHUB_API_URL+"/graphql", tc)
 
 	return &GithubClient{
 		clientREST:   clientREST,
@@ -53,6 +57,35 @@ func NewGithubClientFromToken(ctx context.Context, token string) *GithubClient {
 	}
 }
 
+// NewGithubClientFromTokenWithBaseURL creates a client for the GitHub API at baseURL, e.g. https://github.example.com/api/v3 for GitHub Enterprise Server.
+// When baseURL is empty or the github.com API, the client is the same as NewGithubClientFromToken.
+func NewGithubClientFromTokenWithBaseURL(ctx context.Context, token, baseURL string) (*GithubClient, error) {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	if baseURL == "" || baseURL == GITHUB_API_URL {
+		return NewGithubClientFromToken(ctx, token), nil
+	}
+
+	ts := oauth2.StaticTokenSource(
+		&oauth2.Token{AccessToken: token},
+	)
+	tc := oauth2.NewClient(ctx, ts)
+
+	clientREST, err := github.NewEnterpriseClient(baseURL, baseURL, tc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GitHub Enterprise client: %v", err)
+	}
+
+	// The GraphQL API of GitHub Enterprise Server is at /api/graphql while the REST API is at /api/v3.
+	graphQLURL := strings.TrimSuffix(baseURL, "/v3") + "/graphql"
+
+	return &GithubClient{
+		clientREST:   clientREST,
+		clientGQL:    githubv4.NewEnterpriseClient(graphQLURL, tc),
+		rawClientGQL: graphql.NewClient(graphQLURL, tc),
+		token:        token,
+	}, nil
+}
+
 // FIXME: Remove these to hide the implementation details.
 func (c *GithubClient) GetClientREST() *github.Client {
 	return c.clientREST
@@ -66,6 +99,11 @@ func (c *GithubClient) GetToken() string {
 	return c.token
 }
 
+// GetBaseURL returns the URL of the GitHub REST API used by the client, without the trailing slash.
+func (c *GithubClient) GetBaseURL() string {
+	return strings.TrimSuffix(c.clientREST.BaseURL.String(), "/")
+}
+
 func (c *GithubClient) GetRawClientGraphQL() *graphql.Client {
 	return c.rawClientGQL
 }
@@ -5,6 +5,7 @@
 package github_test
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
@@ -134,3 +135,33 @@ func TestGetAuthenticatedUserLogin(t *testing.T) {
 		})
 	}
 }
+
+func TestNewGithubClientFromTokenWithBaseURL(t *testing.T) {
+	tests := map[string]struct {
+		baseURL     string
+		wantBaseURL string
+	}{
+		"when base url is empty": {
+			baseURL:     "",
+			wantBaseURL: "https://api.github.com/",
+		},
+		"when base url is the github.com api": {
+			baseURL:     "https://api.github.com/",
+			wantBaseURL: "https://api.github.com/",
+		},
+		"when base url is a github enterprise server": {
+			baseURL:     "https://github.example.com/api/v3",
+			wantBaseURL: "https://github.example.com/api/v3/",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotClient, err := host.NewGithubClientFromTokenWithBaseURL(context.Background(), "token", test.baseURL)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantBaseURL, gotClient.GetClientREST().BaseURL.String())
+			assert.Equal(t, "token", gotClient.GetToken())
+		})
+	}
+}
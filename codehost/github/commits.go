@@ -7,6 +7,8 @@ package github
 import (
 	"context"
 	"fmt"
+
+	"github.com/google/go-github/v52/github"
 )
 
 type CreateCommitStatusOptions struct {
@@ -36,3 +38,91 @@ func (c *GithubClient) CreateCommitStatus(ctx context.Context, owner string, rep
 
 	return commitStatus, nil
 }
+
+// CompareCommits returns the comparison between the base and head commits with all of its commits.
+// GitHub only returns the files of the comparison on the first page.
+func (c *GithubClient) CompareCommits(ctx context.Context, owner string, repo string, base string, head string) (*github.CommitsComparison, error) {
+	var comparison *github.CommitsComparison
+
+	commits, err := PaginatedRequest(
+		func() interface{} {
+			return []*github.RepositoryCommit{}
+		},
+		func(i interface{}, page int) (interface{}, *github.Response, error) {
+			currentCommits := i.([]*github.RepositoryCommit)
+			pageComparison, resp, err := c.clientREST.Repositories.CompareCommits(ctx, owner, repo, base, head, &github.ListOptions{
+				Page:    page,
+				PerPage: maxPerPage,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if comparison == nil {
+				comparison = pageComparison
+			}
+
+			currentCommits = append(currentCommits, pageComparison.Commits...)
+			return currentCommits, resp, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	comparison.Commits = commits.([]*github.RepositoryCommit)
+
+	return comparison, nil
+}
+
+// GetCommitComments returns every comment of the commit.
+func (c *GithubClient) GetCommitComments(ctx context.Context, owner string, repo string, sha string) ([]*github.RepositoryComment, error) {
+	comments, err := PaginatedRequest(
+		func() interface{} {
+			return []*github.RepositoryComment{}
+		},
+		func(i interface{}, page int) (interface{}, *github.Response, error) {
+			currentComments := i.([]*github.RepositoryComment)
+			pageComments, resp, err := c.clientREST.Repositories.ListCommitComments(ctx, owner, repo, sha, &github.ListOptions{
+				Page:    page,
+				PerPage: maxPerPage,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			currentComments = append(currentComments, pageComments...)
+			return currentComments, resp, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return comments.([]*github.RepositoryComment), nil
+}
+
+// GetCommitStatuses returns the latest status of each context of the commit.
+func (c *GithubClient) GetCommitStatuses(ctx context.Context, owner string, repo string, ref string) ([]*github.RepoStatus, error) {
+	statuses, err := PaginatedRequest(
+		func() interface{} {
+			return []*github.RepoStatus{}
+		},
+		func(i interface{}, page int) (interface{}, *github.Response, error) {
+			currentStatuses := i.([]*github.RepoStatus)
+			combinedStatus, resp, err := c.clientREST.Repositories.GetCombinedStatus(ctx, owner, repo, ref, &github.ListOptions{
+				Page:    page,
+				PerPage: maxPerPage,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			currentStatuses = append(currentStatuses, combinedStatus.Statuses...)
+			return currentStatuses, resp, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return statuses.([]*github.RepoStatus), nil
+}
@@ -0,0 +1,152 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package github_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareCommits(t *testing.T) {
+	owner := "reviewpad"
+	repo := "reviewpad"
+
+	mockedGithubClient := aladino.MockDefaultGithubClient(
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.GetReposCompareByOwnerByRepoByBasehead,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, fmt.Sprintf("/repos/%v/%v/compare/before...after", owner, repo), r.URL.Path)
+
+					page := r.URL.Query().Get("page")
+					comparison := &github.CommitsComparison{
+						Commits: []*github.RepositoryCommit{
+							{SHA: github.String(fmt.Sprintf("commit on page %v", page))},
+						},
+					}
+
+					if page == "1" {
+						w.Header().Add("Link", fmt.Sprintf("<https://api.github.com/repos/%v/%v/compare/before...after?page=2>; rel=\"next\", <https://api.github.com/repos/%v/%v/compare/before...after?page=2>; rel=\"last\"", owner, repo, owner, repo))
+						comparison.Files = []*github.CommitFile{
+							{Filename: github.String("CHANGELOG.md")},
+						}
+					}
+
+					w.Write(mock.MustMarshal(comparison))
+				}),
+			),
+		},
+		nil,
+	)
+
+	gotComparison, err := mockedGithubClient.CompareCommits(context.Background(), owner, repo, "before", "after")
+
+	assert.Nil(t, err)
+	assert.Equal(t, &github.CommitsComparison{
+		Commits: []*github.RepositoryCommit{
+			{SHA: github.String("commit on page 1")},
+			{SHA: github.String("commit on page 2")},
+		},
+		Files: []*github.CommitFile{
+			{Filename: github.String("CHANGELOG.md")},
+		},
+	}, gotComparison)
+}
+
+func TestCompareCommits_WhenRequestFails(t *testing.T) {
+	mockedGithubClient := aladino.MockDefaultGithubClient(
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.GetReposCompareByOwnerByRepoByBasehead,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					mock.WriteError(w, http.StatusNotFound, "Not Found")
+				}),
+			),
+		},
+		nil,
+	)
+
+	gotComparison, err := mockedGithubClient.CompareCommits(context.Background(), "reviewpad", "reviewpad", "before", "after")
+
+	assert.Nil(t, gotComparison)
+	assert.NotNil(t, err)
+}
+
+func TestGetCommitComments(t *testing.T) {
+	owner := "reviewpad"
+	repo := "reviewpad"
+
+	mockedGithubClient := aladino.MockDefaultGithubClient(
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.GetReposCommitsCommentsByOwnerByRepoByCommitSha,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, fmt.Sprintf("/repos/%v/%v/commits/abc/comments", owner, repo), r.URL.Path)
+
+					page := r.URL.Query().Get("page")
+					if page == "1" {
+						w.Header().Add("Link", fmt.Sprintf("<https://api.github.com/repos/%v/%v/commits/abc/comments?page=2>; rel=\"next\", <https://api.github.com/repos/%v/%v/commits/abc/comments?page=2>; rel=\"last\"", owner, repo, owner, repo))
+					}
+
+					w.Write(mock.MustMarshal([]*github.RepositoryComment{
+						{Body: github.String(fmt.Sprintf("comment on page %v", page))},
+					}))
+				}),
+			),
+		},
+		nil,
+	)
+
+	gotComments, err := mockedGithubClient.GetCommitComments(context.Background(), owner, repo, "abc")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*github.RepositoryComment{
+		{Body: github.String("comment on page 1")},
+		{Body: github.String("comment on page 2")},
+	}, gotComments)
+}
+
+func TestGetCommitStatuses(t *testing.T) {
+	owner := "reviewpad"
+	repo := "reviewpad"
+
+	mockedGithubClient := aladino.MockDefaultGithubClient(
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.GetReposCommitsStatusByOwnerByRepoByRef,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, fmt.Sprintf("/repos/%v/%v/commits/abc/status", owner, repo), r.URL.Path)
+
+					page := r.URL.Query().Get("page")
+					if page == "1" {
+						w.Header().Add("Link", fmt.Sprintf("<https://api.github.com/repos/%v/%v/commits/abc/status?page=2>; rel=\"next\", <https://api.github.com/repos/%v/%v/commits/abc/status?page=2>; rel=\"last\"", owner, repo, owner, repo))
+					}
+
+					w.Write(mock.MustMarshal(&github.CombinedStatus{
+						Statuses: []*github.RepoStatus{
+							{Context: github.String(fmt.Sprintf("status on page %v", page))},
+						},
+					}))
+				}),
+			),
+		},
+		nil,
+	)
+
+	gotStatuses, err := mockedGithubClient.GetCommitStatuses(context.Background(), owner, repo, "abc")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*github.RepoStatus{
+		{Context: github.String("status on page 1")},
+		{Context: github.String("status on page 2")},
+	}, gotStatuses)
+}
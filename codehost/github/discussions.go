@@ -7,6 +7,7 @@ package github
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/shurcooL/githubv4"
 )
@@ -33,6 +34,70 @@ type RemoveReactionMutation struct {
 	} `graphql:"removeReaction(input: $input)"`
 }
 
+type UpdateDiscussionCommentMutation struct {
+	UpdateDiscussionComment struct {
+		ClientMutationID string
+	} `graphql:"updateDiscussionComment(input: $input)"`
+}
+
+type DeleteDiscussionCommentMutation struct {
+	DeleteDiscussionComment struct {
+		ClientMutationID string
+	} `graphql:"deleteDiscussionComment(input: $input)"`
+}
+
+type MarkDiscussionCommentAsAnswerMutation struct {
+	MarkDiscussionCommentAsAnswer struct {
+		ClientMutationID string
+	} `graphql:"markDiscussionCommentAsAnswer(input: $input)"`
+}
+
+type CloseDiscussionMutation struct {
+	CloseDiscussion struct {
+		ClientMutationID string
+	} `graphql:"closeDiscussion(input: $input)"`
+}
+
+type LockLockableMutation struct {
+	LockLockable struct {
+		ClientMutationID string
+	} `graphql:"lockLockable(input: $input)"`
+}
+
+type AddLabelsToLabelableMutation struct {
+	AddLabelsToLabelable struct {
+		ClientMutationID string
+	} `graphql:"addLabelsToLabelable(input: $input)"`
+}
+
+type RemoveLabelsFromLabelableMutation struct {
+	RemoveLabelsFromLabelable struct {
+		ClientMutationID string
+	} `graphql:"removeLabelsFromLabelable(input: $input)"`
+}
+
+type Discussion struct {
+	ID           string
+	Number       int
+	Title        string
+	Body         string
+	URL          string
+	AuthorLogin  string
+	Category     string
+	IsAnswered   bool
+	Closed       bool
+	Locked       bool
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	Labels       []DiscussionLabel
+	CommentCount int
+}
+
+type DiscussionLabel struct {
+	ID   string
+	Name string
+}
+
 type DiscussionComment struct {
 	ID          string
 	Body        string
@@ -47,6 +112,35 @@ type GetDiscussionIDQuery struct {
 	} `graphql:"repository(owner: $owner, name: $name)"`
 }
 
+type GetDiscussionQuery struct {
+	Repository struct {
+		Discussion struct {
+			ID         string
+			Number     int
+			Title      string
+			Body       string
+			URL        string
+			IsAnswered bool
+			Closed     bool
+			Locked     bool
+			CreatedAt  time.Time
+			UpdatedAt  time.Time
+			Author     struct {
+				Login string
+			}
+			Category struct {
+				Name string
+			}
+			Labels struct {
+				Nodes []DiscussionLabel
+			} `graphql:"labels(first: 50)"`
+			Comments struct {
+				TotalCount int
+			}
+		} `graphql:"discussion(number: $number)"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
 type GetDiscussionCommentsQuery struct {
 	Repository struct {
 		Discussion struct {
@@ -84,6 +178,41 @@ func (c *GithubClient) GetDiscussionID(ctx context.Context, owner, repo string,
 	return getDiscussionIDQuery.Repository.Discussion.ID, ErrDiscussionNotFound
 }
 
+func (c *GithubClient) GetDiscussion(ctx context.Context, owner, repo string, discussionNum int) (*Discussion, error) {
+	var getDiscussionQuery GetDiscussionQuery
+	varGQLGetDiscussionQuery := map[string]interface{}{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(repo),
+		"number": githubv4.Int(discussionNum),
+	}
+
+	if err := c.clientGQL.Query(ctx, &getDiscussionQuery, varGQLGetDiscussionQuery); err != nil {
+		return nil, err
+	}
+
+	discussion := getDiscussionQuery.Repository.Discussion
+	if discussion.ID == "" {
+		return nil, ErrDiscussionNotFound
+	}
+
+	return &Discussion{
+		ID:           discussion.ID,
+		Number:       discussion.Number,
+		Title:        discussion.Title,
+		Body:         discussion.Body,
+		URL:          discussion.URL,
+		AuthorLogin:  discussion.Author.Login,
+		Category:     discussion.Category.Name,
+		IsAnswered:   discussion.IsAnswered,
+		Closed:       discussion.Closed,
+		Locked:       discussion.Locked,
+		CreatedAt:    discussion.CreatedAt,
+		UpdatedAt:    discussion.UpdatedAt,
+		Labels:       discussion.Labels.Nodes,
+		CommentCount: discussion.Comments.TotalCount,
+	}, nil
+}
+
 // GetDiscussionComments returns the discussion comments for a given discussion number.
 // The first element of the list is the body of the discussion.
 func (c *GithubClient) GetDiscussionComments(ctx context.Context, owner, repo string, discussionNum int) ([]DiscussionComment, error) {
@@ -159,3 +288,82 @@ func (c *GithubClient) RemoveReactionToDiscussionComment(ctx context.Context, su
 
 	return c.clientGQL.Mutate(ctx, &removeReactionMutation, removeReactionMutationInput, nil)
 }
+
+func (c *GithubClient) UpdateDiscussionComment(ctx context.Context, commentID, body string) error {
+	var updateDiscussionCommentMutation UpdateDiscussionCommentMutation
+	updateDiscussionCommentInput := githubv4.UpdateDiscussionCommentInput{
+		CommentID: commentID,
+		Body:      githubv4.String(body),
+	}
+
+	return c.clientGQL.Mutate(ctx, &updateDiscussionCommentMutation, updateDiscussionCommentInput, nil)
+}
+
+func (c *GithubClient) DeleteDiscussionComment(ctx context.Context, commentID string) error {
+	var deleteDiscussionCommentMutation DeleteDiscussionCommentMutation
+	deleteDiscussionCommentInput := githubv4.DeleteDiscussionCommentInput{
+		ID: commentID,
+	}
+
+	return c.clientGQL.Mutate(ctx, &deleteDiscussionCommentMutation, deleteDiscussionCommentInput, nil)
+}
+
+func (c *GithubClient) MarkDiscussionCommentAsAnswer(ctx context.Context, commentID string) error {
+	var markDiscussionCommentAsAnswerMutation MarkDiscussionCommentAsAnswerMutation
+	markDiscussionCommentAsAnswerInput := githubv4.MarkDiscussionCommentAsAnswerInput{
+		ID: commentID,
+	}
+
+	return c.clientGQL.Mutate(ctx, &markDiscussionCommentAsAnswerMutation, markDiscussionCommentAsAnswerInput, nil)
+}
+
+func (c *GithubClient) CloseDiscussion(ctx context.Context, discussionID string, reason githubv4.DiscussionCloseReason) error {
+	var closeDiscussionMutation CloseDiscussionMutation
+	closeDiscussionInput := githubv4.CloseDiscussionInput{
+		DiscussionID: discussionID,
+		Reason:       &reason,
+	}
+
+	return c.clientGQL.Mutate(ctx, &closeDiscussionMutation, closeDiscussionInput, nil)
+}
+
+// LockLockable locks the conversation of a discussion, issue or pull request.
+// The reason is optional.
+func (c *GithubClient) LockLockable(ctx context.Context, lockableID string, reason *githubv4.LockReason) error {
+	var lockLockableMutation LockLockableMutation
+	lockLockableInput := githubv4.LockLockableInput{
+		LockableID: lockableID,
+		LockReason: reason,
+	}
+
+	return c.clientGQL.Mutate(ctx, &lockLockableMutation, lockLockableInput, nil)
+}
+
+func (c *GithubClient) AddLabelsToLabelable(ctx context.Context, labelableID string, labelIDs []string) error {
+	var addLabelsToLabelableMutation AddLabelsToLabelableMutation
+	addLabelsToLabelableInput := githubv4.AddLabelsToLabelableInput{
+		LabelableID: labelableID,
+		LabelIDs:    toGQLIDs(labelIDs),
+	}
+
+	return c.clientGQL.Mutate(ctx, &addLabelsToLabelableMutation, addLabelsToLabelableInput, nil)
+}
+
+func (c *GithubClient) RemoveLabelsFromLabelable(ctx context.Context, labelableID string, labelIDs []string) error {
+	var removeLabelsFromLabelableMutation RemoveLabelsFromLabelableMutation
+	removeLabelsFromLabelableInput := githubv4.RemoveLabelsFromLabelableInput{
+		LabelableID: labelableID,
+		LabelIDs:    toGQLIDs(labelIDs),
+	}
+
+	return c.clientGQL.Mutate(ctx, &removeLabelsFromLabelableMutation, removeLabelsFromLabelableInput, nil)
+}
+
+func toGQLIDs(ids []string) []githubv4.ID {
+	gqlIDs := make([]githubv4.ID, len(ids))
+	for i, id := range ids {
+		gqlIDs[i] = githubv4.ID(id)
+	}
+
+	return gqlIDs
+}
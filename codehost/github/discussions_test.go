@@ -8,6 +8,7 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	host "github.com/reviewpad/reviewpad/v4/codehost/github"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
@@ -105,3 +106,43 @@ func TestGetDiscussionComments(t *testing.T) {
 
 	assert.NotNil(t, project)
 }
+
+func TestGetDiscussion(t *testing.T) {
+	mockedGithubClient := aladino.MockDefaultGithubClient(
+		nil,
+		aladino.MockDiscussionGraphQLHandler("[]", func(w http.ResponseWriter, query string) {
+			assert.FailNow(t, "unexpected request", query)
+		}),
+	)
+
+	gotDiscussion, err := mockedGithubClient.GetDiscussion(context.Background(), aladino.DefaultMockPrOwner, aladino.DefaultMockPrRepoName, aladino.DefaultMockDiscussionNum)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &host.Discussion{
+		ID:           aladino.DefaultMockDiscussionNodeID,
+		Number:       aladino.DefaultMockDiscussionNum,
+		Title:        "Linting pushes",
+		Body:         "How can I lint the commits of a push?",
+		URL:          "https://github.com/foobar/default-mock-repo/discussions/82",
+		AuthorLogin:  "john",
+		Category:     "Q&A",
+		CreatedAt:    time.Date(2009, 11, 17, 20, 34, 58, 0, time.UTC),
+		UpdatedAt:    time.Date(2009, 11, 17, 20, 34, 58, 0, time.UTC),
+		Labels:       []host.DiscussionLabel{{ID: "LA_question", Name: "question"}},
+		CommentCount: 1,
+	}, gotDiscussion)
+}
+
+func TestGetDiscussion_WhenDiscussionIsNotFound(t *testing.T) {
+	mockedGithubClient := aladino.MockDefaultGithubClient(
+		nil,
+		func(w http.ResponseWriter, req *http.Request) {
+			utils.MustWrite(w, `{"data": {"repository": {"discussion": null}}}`)
+		},
+	)
+
+	gotDiscussion, err := mockedGithubClient.GetDiscussion(context.Background(), aladino.DefaultMockPrOwner, aladino.DefaultMockPrRepoName, aladino.DefaultMockDiscussionNum)
+
+	assert.Nil(t, gotDiscussion)
+	assert.Equal(t, host.ErrDiscussionNotFound, err)
+}
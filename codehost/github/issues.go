@@ -34,6 +34,10 @@ func (c *GithubClient) GetIssue(ctx context.Context, owner, repo string, number
 	return c.clientREST.Issues.Get(ctx, owner, repo, number)
 }
 
+func (c *GithubClient) CreateIssue(ctx context.Context, owner string, repo string, issue *github.IssueRequest) (*github.Issue, *github.Response, error) {
+	return c.clientREST.Issues.Create(ctx, owner, repo, issue)
+}
+
 func (c *GithubClient) EditIssue(ctx context.Context, owner string, repo string, number int, issue *github.IssueRequest) (*github.Issue, *github.Response, error) {
 	return c.clientREST.Issues.Edit(ctx, owner, repo, number, issue)
 }
@@ -81,7 +85,13 @@ func (c *GithubClient) ListIssuesByRepo(ctx context.Context, owner string, repo
 		},
 		func(i interface{}, page int) (interface{}, *github.Response, error) {
 			issues := i.([]*github.Issue)
-			is, resp, err := c.clientREST.Issues.ListByRepo(ctx, owner, repo, opts)
+			pageOpts := github.IssueListByRepoOptions{}
+			if opts != nil {
+				pageOpts = *opts
+			}
+			pageOpts.Page = page
+			pageOpts.PerPage = maxPerPage
+			is, resp, err := c.clientREST.Issues.ListByRepo(ctx, owner, repo, &pageOpts)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -96,6 +106,58 @@ func (c *GithubClient) ListIssuesByRepo(ctx context.Context, owner string, repo
 	return is.([]*github.Issue), nil, nil
 }
 
+// ListIssuesByRepoPages lists the issues of the repository one page at a time.
+// The pages are passed to fn, which returns whether the next page should be fetched.
+func (c *GithubClient) ListIssuesByRepoPages(ctx context.Context, owner string, repo string, opts *github.IssueListByRepoOptions, fn func(issues []*github.Issue) bool) error {
+	pageOpts := github.IssueListByRepoOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+	pageOpts.Page = 1
+	pageOpts.PerPage = maxPerPage
+
+	for {
+		issues, resp, err := c.clientREST.Issues.ListByRepo(ctx, owner, repo, &pageOpts)
+		if err != nil {
+			return err
+		}
+
+		if !fn(issues) || resp.NextPage <= pageOpts.Page {
+			return nil
+		}
+
+		pageOpts.Page = resp.NextPage
+	}
+}
+
+// SearchIssues returns every issue and pull request matching the search query.
+func (c *GithubClient) SearchIssues(ctx context.Context, query string) ([]*github.Issue, error) {
+	is, err := PaginatedRequest(
+		func() interface{} {
+			return []*github.Issue{}
+		},
+		func(i interface{}, page int) (interface{}, *github.Response, error) {
+			issues := i.([]*github.Issue)
+			result, resp, err := c.clientREST.Search.Issues(ctx, query, &github.SearchOptions{
+				ListOptions: github.ListOptions{
+					Page:    page,
+					PerPage: maxPerPage,
+				},
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			issues = append(issues, result.Issues...)
+			return issues, resp, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return is.([]*github.Issue), nil
+}
+
 func (c *GithubClient) GetComments(ctx context.Context, owner string, repo string, number int, opts *github.IssueListCommentsOptions) ([]*github.IssueComment, error) {
 	fs, err := PaginatedRequest(
 		func() interface{} {
@@ -6,9 +6,12 @@ package github_test
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"testing"
 
+	"github.com/google/go-github/v52/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
 	host "github.com/reviewpad/reviewpad/v4/codehost/github"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 	"github.com/reviewpad/reviewpad/v4/utils"
@@ -173,3 +176,98 @@ func TestGetLinkedProjects_WhenProjectFound(t *testing.T) {
 	assert.NotNil(t, gotProjects)
 	assert.Equal(t, wantProjects, gotProjects)
 }
+
+func TestListIssuesByRepo(t *testing.T) {
+	owner := "reviewpad"
+	repo := "reviewpad"
+
+	mockedGithubClient := aladino.MockDefaultGithubClient(
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.GetReposIssuesByOwnerByRepo,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					query := r.URL.Query()
+					assert.Equal(t, "bug", query.Get("labels"))
+					assert.Equal(t, "100", query.Get("per_page"))
+
+					page := query.Get("page")
+					if page == "1" {
+						w.Header().Add("Link", fmt.Sprintf("<https://api.github.com/repos/%v/%v/issues?page=2>; rel=\"next\", <https://api.github.com/repos/%v/%v/issues?page=2>; rel=\"last\"", owner, repo, owner, repo))
+					}
+
+					w.Write(mock.MustMarshal([]*github.Issue{
+						{Title: github.String(fmt.Sprintf("issue on page %v", page))},
+					}))
+				}),
+			),
+		},
+		nil,
+	)
+
+	gotIssues, _, err := mockedGithubClient.ListIssuesByRepo(context.Background(), owner, repo, &github.IssueListByRepoOptions{
+		Labels: []string{"bug"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*github.Issue{
+		{Title: github.String("issue on page 1")},
+		{Title: github.String("issue on page 2")},
+	}, gotIssues)
+}
+
+func TestListIssuesByRepoPages(t *testing.T) {
+	owner := "reviewpad"
+	repo := "reviewpad"
+	nextPages := map[string]string{"1": "2", "2": "3"}
+
+	tests := map[string]struct {
+		stopAtPage int
+		wantPages  []string
+	}{
+		"when every page is requested": {
+			wantPages: []string{"1", "2", "3"},
+		},
+		"when pages are no longer requested": {
+			stopAtPage: 2,
+			wantPages:  []string{"1", "2"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockedGithubClient := aladino.MockDefaultGithubClient(
+				[]mock.MockBackendOption{
+					mock.WithRequestMatchHandler(
+						mock.GetReposIssuesByOwnerByRepo,
+						http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+							query := r.URL.Query()
+							assert.Equal(t, "bug", query.Get("labels"))
+							assert.Equal(t, "100", query.Get("per_page"))
+
+							page := query.Get("page")
+							if nextPage, ok := nextPages[page]; ok {
+								w.Header().Add("Link", fmt.Sprintf("<https://api.github.com/repos/%v/%v/issues?page=%v>; rel=\"next\", <https://api.github.com/repos/%v/%v/issues?page=3>; rel=\"last\"", owner, repo, nextPage, owner, repo))
+							}
+
+							w.Write(mock.MustMarshal([]*github.Issue{
+								{Title: github.String(page)},
+							}))
+						}),
+					),
+				},
+				nil,
+			)
+
+			gotPages := []string{}
+			err := mockedGithubClient.ListIssuesByRepoPages(context.Background(), owner, repo, &github.IssueListByRepoOptions{
+				Labels: []string{"bug"},
+			}, func(issues []*github.Issue) bool {
+				gotPages = append(gotPages, issues[0].GetTitle())
+				return len(gotPages) != test.stopAtPage
+			})
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantPages, gotPages)
+		})
+	}
+}
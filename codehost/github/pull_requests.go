@@ -385,6 +385,33 @@ func (c *GithubClient) GetPullRequestReviews(ctx context.Context, owner string,
 	return reviews.([]*github.PullRequestReview), nil
 }
 
+func (c *GithubClient) GetPullRequestReviewComments(ctx context.Context, owner string, repo string, number int) ([]*github.PullRequestComment, error) {
+	comments, err := PaginatedRequest(
+		func() interface{} {
+			return []*github.PullRequestComment{}
+		},
+		func(i interface{}, page int) (interface{}, *github.Response, error) {
+			currentComments := i.([]*github.PullRequestComment)
+			comments, resp, err := c.clientREST.PullRequests.ListComments(ctx, owner, repo, number, &github.PullRequestListCommentsOptions{
+				ListOptions: github.ListOptions{
+					Page:    page,
+					PerPage: maxPerPage,
+				},
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			currentComments = append(currentComments, comments...)
+			return currentComments, resp, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return comments.([]*github.PullRequestComment), nil
+}
+
 func (c *GithubClient) GetPullRequests(ctx context.Context, owner string, repo string) ([]*github.PullRequest, error) {
 	prs, err := PaginatedRequest(
 		func() interface{} {
@@ -488,6 +515,25 @@ func (c *GithubClient) Merge(ctx context.Context, owner string, repo string, num
 	return c.clientREST.PullRequests.Merge(ctx, owner, repo, number, commitMessage, options)
 }
 
+// UpdatePullRequestBranch merges the base branch into the head branch of the pull request.
+// When the expected head SHA is not empty, the update fails if the head branch moved.
+// GitHub updates the branch asynchronously, so the update is only scheduled when no error is returned.
+func (c *GithubClient) UpdatePullRequestBranch(ctx context.Context, owner string, repo string, number int, expectedHeadSHA string) error {
+	options := &github.PullRequestBranchUpdateOptions{}
+	if expectedHeadSHA != "" {
+		options.ExpectedHeadSHA = github.String(expectedHeadSHA)
+	}
+
+	_, _, err := c.clientREST.PullRequests.UpdateBranch(ctx, owner, repo, number, options)
+
+	var acceptedError *github.AcceptedError
+	if errors.As(err, &acceptedError) {
+		return nil
+	}
+
+	return err
+}
+
 func (c *GithubClient) Review(ctx context.Context, owner string, repo string, number int, review *github.PullRequestReviewRequest) (*github.PullRequestReview, *github.Response, error) {
 	return c.clientREST.PullRequests.CreateReview(ctx, owner, repo, number, review)
 }
@@ -784,6 +830,29 @@ func (c *GithubClient) GetPullRequestUpToDate(ctx context.Context, owner, repo s
 	return compareBaseAndHeadQuery.Repository.PullRequest.BaseRefOID == compareBaseAndHeadQuery.Repository.PullRequest.BaseRef.Target.Commit.History.Nodes[0].OID, nil
 }
 
+// HasGitConflicts reports whether the pull request can't be merged because of conflicts with the base branch.
+func (c *GithubClient) HasGitConflicts(ctx context.Context, owner, repo string, number int) (bool, error) {
+	var pullRequestQuery struct {
+		Repository struct {
+			PullRequest struct {
+				Mergeable githubv4.String
+			} `graphql:"pullRequest(number: $pullRequestNumber)"`
+		} `graphql:"repository(owner: $repositoryOwner, name: $repositoryName)"`
+	}
+
+	varGQLPullRequestQuery := map[string]interface{}{
+		"pullRequestNumber": githubv4.Int(number),
+		"repositoryOwner":   githubv4.String(owner),
+		"repositoryName":    githubv4.String(repo),
+	}
+
+	if err := c.GetClientGraphQL().Query(ctx, &pullRequestQuery, varGQLPullRequestQuery); err != nil {
+		return false, err
+	}
+
+	return string(pullRequestQuery.Repository.PullRequest.Mergeable) == "CONFLICTING", nil
+}
+
 func isPullRequestReviewer(pullRequest PullRequestsQuery, username string) bool {
 	for _, reviewRequest := range pullRequest.ReviewRequests.Nodes {
 		if string(reviewRequest.RequestedReviewer.AsUser.Login) == username {
@@ -1122,3 +1122,42 @@ func buildGitHubResponse(resp *http.Response, link string, nextPage int) *github
 
 	return ghResp
 }
+
+func TestUpdatePullRequestBranch(t *testing.T) {
+	tests := map[string]struct {
+		expectedHeadSHA string
+		wantBody        string
+	}{
+		"when expected head sha is set": {
+			expectedHeadSHA: "abc",
+			wantBody:        `{"expected_head_sha":"abc"}` + "\n",
+		},
+		"when expected head sha is empty": {
+			wantBody: "{}\n",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotBody string
+			mockedGithubClient := aladino.MockDefaultGithubClient(
+				[]mock.MockBackendOption{
+					mock.WithRequestMatchHandler(
+						mock.PutReposPullsUpdateBranchByOwnerByRepoByPullNumber,
+						http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+							gotBody = utils.MustRead(r.Body)
+							w.WriteHeader(http.StatusAccepted)
+							w.Write(mock.MustMarshal(&github.PullRequestBranchUpdateResponse{}))
+						}),
+					),
+				},
+				nil,
+			)
+
+			err := mockedGithubClient.UpdatePullRequestBranch(context.Background(), "foobar", "default-mock-repo", 6, test.expectedHeadSHA)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantBody, gotBody)
+		})
+	}
+}
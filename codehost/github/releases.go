@@ -0,0 +1,73 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v52/github"
+)
+
+func (c *GithubClient) GetRelease(ctx context.Context, owner string, repo string, id int64) (*github.RepositoryRelease, *github.Response, error) {
+	return c.clientREST.Repositories.GetRelease(ctx, owner, repo, id)
+}
+
+func (c *GithubClient) EditRelease(ctx context.Context, owner string, repo string, id int64, release *github.RepositoryRelease) (*github.RepositoryRelease, *github.Response, error) {
+	return c.clientREST.Repositories.EditRelease(ctx, owner, repo, id, release)
+}
+
+// ListReleases returns all releases of the repository, the most recent first.
+func (c *GithubClient) ListReleases(ctx context.Context, owner string, repo string) ([]*github.RepositoryRelease, error) {
+	releases, err := PaginatedRequest(
+		func() interface{} {
+			return []*github.RepositoryRelease{}
+		},
+		func(i interface{}, page int) (interface{}, *github.Response, error) {
+			currentReleases := i.([]*github.RepositoryRelease)
+			pageReleases, resp, err := c.clientREST.Repositories.ListReleases(ctx, owner, repo, &github.ListOptions{
+				Page:    page,
+				PerPage: maxPerPage,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			currentReleases = append(currentReleases, pageReleases...)
+			return currentReleases, resp, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return releases.([]*github.RepositoryRelease), nil
+}
+
+// ListPullRequestsWithCommit returns the pull requests that contain the commit.
+func (c *GithubClient) ListPullRequestsWithCommit(ctx context.Context, owner string, repo string, sha string) ([]*github.PullRequest, error) {
+	pullRequests, err := PaginatedRequest(
+		func() interface{} {
+			return []*github.PullRequest{}
+		},
+		func(i interface{}, page int) (interface{}, *github.Response, error) {
+			currentPullRequests := i.([]*github.PullRequest)
+			pagePullRequests, resp, err := c.clientREST.PullRequests.ListPullRequestsWithCommit(ctx, owner, repo, sha, &github.PullRequestListOptions{
+				ListOptions: github.ListOptions{
+					Page:    page,
+					PerPage: maxPerPage,
+				},
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			currentPullRequests = append(currentPullRequests, pagePullRequests...)
+			return currentPullRequests, resp, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return pullRequests.([]*github.PullRequest), nil
+}
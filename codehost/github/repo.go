@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	git "github.com/libgit2/git2go/v31"
@@ -212,6 +213,158 @@ func RebaseOnto(log *logrus.Entry, repo *git.Repository, branchName string, reba
 	return nil
 }
 
+// GetMergeConflicts returns the paths of the files that conflict when merging the given branch into the current repository Head.
+// The repository is left untouched.
+func GetMergeConflicts(log *logrus.Entry, repo *git.Repository, branchName string) ([]string, error) {
+	merge, err := mergeIntoHead(log, repo, branchName)
+	if err != nil {
+		return nil, err
+	}
+	defer merge.Free()
+
+	return conflictingFiles(merge.index)
+}
+
+// MergeBranch merges the given branch into the current repository Head with a merge commit.
+// It fails when the merge has conflicts.
+func MergeBranch(log *logrus.Entry, repo *git.Repository, branchName string, signature *git.Signature) error {
+	merge, err := mergeIntoHead(log, repo, branchName)
+	if err != nil {
+		return err
+	}
+	defer merge.Free()
+
+	if merge.index.HasConflicts() {
+		return fmt.Errorf("merge of branch %v has conflicts", branchName)
+	}
+
+	treeId, err := merge.index.WriteTreeTo(repo)
+	if err != nil {
+		log.Error("failed to write merge tree")
+		return err
+	}
+
+	tree, err := repo.LookupTree(treeId)
+	if err != nil {
+		log.Error("failed to lookup for merge tree")
+		return err
+	}
+	defer tree.Free()
+
+	message := fmt.Sprintf("Merge branch '%s' into %s", branchName, merge.head.Shorthand())
+
+	_, err = repo.CreateCommit("HEAD", signature, signature, message, tree, merge.headCommit, merge.branchCommit)
+	if err != nil {
+		log.Error("failed to create merge commit")
+		return err
+	}
+
+	// Keep the working tree in sync with the merge commit
+	return repo.CheckoutHead(&git.CheckoutOptions{Strategy: git.CheckoutForce})
+}
+
+// merge is the result of merging a branch into the repository Head.
+type merge struct {
+	head         *git.Reference
+	headCommit   *git.Commit
+	branchCommit *git.Commit
+	index        *git.Index
+}
+
+func (m *merge) Free() {
+	m.index.Free()
+	m.branchCommit.Free()
+	m.headCommit.Free()
+	m.head.Free()
+}
+
+// mergeIntoHead merges the given branch into the current repository Head in memory.
+func mergeIntoHead(log *logrus.Entry, repo *git.Repository, branchName string) (*merge, error) {
+	head, err := repo.Head()
+	if err != nil {
+		log.Error("failed to lookup for head")
+		return nil, err
+	}
+
+	headCommit, err := repo.LookupCommit(head.Target())
+	if err != nil {
+		head.Free()
+		log.Error("failed to lookup for head commit")
+		return nil, err
+	}
+
+	branch, err := repo.LookupBranch(branchName, git.BranchLocal)
+	if err != nil {
+		headCommit.Free()
+		head.Free()
+		log.Errorf("failed to lookup for branch: %v", branchName)
+		return nil, err
+	}
+	defer branch.Free()
+
+	branchCommit, err := repo.LookupCommit(branch.Target())
+	if err != nil {
+		headCommit.Free()
+		head.Free()
+		log.Errorf("failed to lookup for branch commit: %v", branchName)
+		return nil, err
+	}
+
+	index, err := repo.MergeCommits(headCommit, branchCommit, nil)
+	if err != nil {
+		branchCommit.Free()
+		headCommit.Free()
+		head.Free()
+		log.Errorf("failed to merge branch: %v", branchName)
+		return nil, err
+	}
+
+	return &merge{
+		head:         head,
+		headCommit:   headCommit,
+		branchCommit: branchCommit,
+		index:        index,
+	}, nil
+}
+
+// conflictingFiles returns the sorted paths of the conflicting files of the index.
+func conflictingFiles(index *git.Index) ([]string, error) {
+	if !index.HasConflicts() {
+		return []string{}, nil
+	}
+
+	iterator, err := index.ConflictIterator()
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Free()
+
+	files := make([]string, 0)
+
+	for {
+		conflict, err := iterator.Next()
+		if git.IsErrorCode(err, git.ErrorCodeIterOver) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		// A side of the conflict is missing when the file was added or deleted.
+		for _, entry := range []*git.IndexEntry{conflict.Our, conflict.Their, conflict.Ancestor} {
+			if entry != nil {
+				files = append(files, entry.Path)
+				break
+			}
+		}
+	}
+
+	sort.Strings(files)
+
+	return files, nil
+}
+
 // Push performs a push of the provided remote/branch.
 func Push(log *logrus.Entry, repo *git.Repository, remoteName string, branchName string, token string, force bool) error {
 	remote, err := repo.Remotes.Lookup(remoteName)
@@ -182,6 +182,35 @@ func TestRebaseOnto(t *testing.T) {
 	assert.Nil(t, gotErr)
 }
 
+func TestGetMergeConflicts_WhenBranchDoesNotExist(t *testing.T) {
+	log := log.NewLogger(logrus.DebugLevel)
+
+	repo := createTestRepo(t, false)
+	defer cleanupTestRepo(t, repo)
+
+	seedTestRepo(t, repo, "main")
+
+	_, gotErr := gh.GetMergeConflicts(log, repo, "test")
+
+	assert.EqualError(t, gotErr, "cannot locate local branch 'test'")
+}
+
+func TestGetMergeConflicts(t *testing.T) {
+	log := log.NewLogger(logrus.DebugLevel)
+
+	repo := createTestRepo(t, false)
+	defer cleanupTestRepo(t, repo)
+
+	branchName := "main"
+
+	seedTestRepo(t, repo, branchName)
+
+	gotConflicts, gotErr := gh.GetMergeConflicts(log, repo, branchName)
+
+	assert.Nil(t, gotErr)
+	assert.Empty(t, gotConflicts)
+}
+
 func createTestRepo(t *testing.T, isBare bool) *git.Repository {
 	path, err := os.MkdirTemp("", TestRepo)
 	checkFatal(t, err)
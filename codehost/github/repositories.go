@@ -6,8 +6,11 @@ package github
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
 
 	"github.com/google/go-github/v52/github"
 	pbc "github.com/reviewpad/api/go/codehost"
@@ -28,6 +31,30 @@ func (c *GithubClient) GetRepositoryBranch(ctx context.Context, owner string, re
 	return c.clientREST.Repositories.GetBranch(ctx, owner, repo, branch, followRedirects)
 }
 
+// GetRequiredStatusChecks returns the names of the checks and statuses required by the protection of the branch.
+// Branches that are not protected or that require no status checks have none.
+func (c *GithubClient) GetRequiredStatusChecks(ctx context.Context, owner string, repo string, branch string) ([]string, error) {
+	requiredStatusChecks, _, err := c.clientREST.Repositories.GetRequiredStatusChecks(ctx, owner, repo, branch)
+	if err != nil {
+		var errResponse *github.ErrorResponse
+		if errors.As(err, &errResponse) && errResponse.Response != nil && errResponse.Response.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	contexts := requiredStatusChecks.Contexts
+	if len(requiredStatusChecks.Checks) > 0 {
+		contexts = make([]string, len(requiredStatusChecks.Checks))
+		for i, check := range requiredStatusChecks.Checks {
+			contexts[i] = check.Context
+		}
+	}
+
+	return contexts, nil
+}
+
 func (c *GithubClient) GetDefaultRepositoryBranch(ctx context.Context, owner string, repo string) (string, error) {
 	repository, _, err := c.clientREST.Repositories.Get(ctx, owner, repo)
 	if err != nil {
@@ -37,6 +64,23 @@ func (c *GithubClient) GetDefaultRepositoryBranch(ctx context.Context, owner str
 	return repository.GetDefaultBranch(), nil
 }
 
+// CreateRepositoryDispatch triggers a repository_dispatch event of the event type with the client payload.
+func (c *GithubClient) CreateRepositoryDispatch(ctx context.Context, owner string, repo string, eventType string, clientPayload map[string]interface{}) error {
+	rawClientPayload, err := json.Marshal(clientPayload)
+	if err != nil {
+		return err
+	}
+
+	payload := json.RawMessage(rawClientPayload)
+
+	_, _, err = c.clientREST.Repositories.Dispatch(ctx, owner, repo, github.DispatchRequestOptions{
+		EventType:     eventType,
+		ClientPayload: &payload,
+	})
+
+	return err
+}
+
 func (c *GithubClient) DownloadContents(ctx context.Context, filePath string, branch *pbc.Branch, options *DownloadContentsOptions) ([]byte, error) {
 	branchRepoOwner := branch.Repo.Owner
 	branchRepoName := branch.Repo.Name
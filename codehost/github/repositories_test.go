@@ -236,3 +236,61 @@ func TestDownloadContents(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, expectedContents, contents)
 }
+
+func TestGetRequiredStatusChecks(t *testing.T) {
+	tests := map[string]struct {
+		statusCode           int
+		requiredStatusChecks *github.RequiredStatusChecks
+		wantChecks           []string
+		wantErr              bool
+	}{
+		"when branch is not protected": {
+			statusCode: http.StatusNotFound,
+		},
+		"when checks are required": {
+			requiredStatusChecks: &github.RequiredStatusChecks{
+				Contexts: []string{"build"},
+				Checks:   []*github.RequiredStatusCheck{{Context: "build"}, {Context: "test"}},
+			},
+			wantChecks: []string{"build", "test"},
+		},
+		"when only contexts are required": {
+			requiredStatusChecks: &github.RequiredStatusChecks{
+				Contexts: []string{"ci/jenkins"},
+			},
+			wantChecks: []string{"ci/jenkins"},
+		},
+		"when request is forbidden": {
+			statusCode: http.StatusForbidden,
+			wantErr:    true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockedGithubClient := aladino.MockDefaultGithubClient(
+				[]mock.MockBackendOption{
+					mock.WithRequestMatchHandler(
+						mock.GetReposBranchesProtectionRequiredStatusChecksByOwnerByRepoByBranch,
+						http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+							assert.Equal(t, "/repos/foobar/default-mock-repo/branches/main/protection/required_status_checks", r.URL.Path)
+
+							if test.statusCode != 0 {
+								mock.WriteError(w, test.statusCode, "request failed")
+								return
+							}
+
+							w.Write(mock.MustMarshal(test.requiredStatusChecks))
+						}),
+					),
+				},
+				nil,
+			)
+
+			gotChecks, err := mockedGithubClient.GetRequiredStatusChecks(context.Background(), "foobar", "default-mock-repo", "main")
+
+			assert.Equal(t, test.wantErr, err != nil)
+			assert.Equal(t, test.wantChecks, gotChecks)
+		})
+	}
+}
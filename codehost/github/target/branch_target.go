@@ -0,0 +1,260 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package target
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/google/go-github/v52/github"
+	pbc "github.com/reviewpad/api/go/codehost"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	gh "github.com/reviewpad/reviewpad/v4/codehost/github"
+)
+
+// emptyCommitSHA is the commit before the push when the push creates the branch.
+const emptyCommitSHA = "0000000000000000000000000000000000000000"
+
+// BranchTarget is a push to a branch.
+// Comments are made on the head commit of the push since branches have no conversation.
+type BranchTarget struct {
+	ctx          context.Context
+	targetEntity *entities.TargetEntity
+	githubClient *gh.GithubClient
+	push         *github.PushEvent
+	comparison   *github.CommitsComparison
+}
+
+// ensure BranchTarget conforms to Target interface
+var _ codehost.Target = (*BranchTarget)(nil)
+
+func NewBranchTarget(ctx context.Context, targetEntity *entities.TargetEntity, githubClient *gh.GithubClient, push *github.PushEvent) *BranchTarget {
+	return &BranchTarget{
+		ctx:          ctx,
+		targetEntity: targetEntity,
+		githubClient: githubClient,
+		push:         push,
+	}
+}
+
+// GetBranch returns the name of the pushed branch.
+func (t *BranchTarget) GetBranch() string {
+	return strings.TrimPrefix(t.push.GetRef(), "refs/heads/")
+}
+
+// GetHeadSHA returns the SHA of the head commit of the branch after the push.
+func (t *BranchTarget) GetHeadSHA() string {
+	return t.push.GetAfter()
+}
+
+// GetPusher returns the user that pushed to the branch.
+func (t *BranchTarget) GetPusher() *codehost.User {
+	// The pusher of webhook events has the login as name.
+	login := t.push.GetPusher().GetName()
+	if login == "" {
+		login = t.push.GetSender().GetLogin()
+	}
+
+	return &codehost.User{Login: login}
+}
+
+// getComparison returns the comparison between the branch before and after the push.
+// When the push creates the branch, the branch is compared with the default branch.
+func (t *BranchTarget) getComparison() (*github.CommitsComparison, error) {
+	if t.comparison != nil {
+		return t.comparison, nil
+	}
+
+	base := t.push.GetBefore()
+	if base == "" || base == emptyCommitSHA {
+		base = t.push.GetRepo().GetDefaultBranch()
+	}
+
+	comparison, err := t.githubClient.CompareCommits(t.ctx, t.targetEntity.Owner, t.targetEntity.Repo, base, t.GetHeadSHA())
+	if err != nil {
+		return nil, err
+	}
+
+	t.comparison = comparison
+
+	return comparison, nil
+}
+
+// GetPushedCommits returns the commits added to the branch by the push.
+func (t *BranchTarget) GetPushedCommits() ([]*codehost.Commit, error) {
+	comparison, err := t.getComparison()
+	if err != nil {
+		return nil, err
+	}
+
+	commits := make([]*codehost.Commit, len(comparison.Commits))
+
+	for i, ghCommit := range comparison.Commits {
+		commits[i] = &codehost.Commit{
+			SHA:          ghCommit.GetSHA(),
+			Message:      ghCommit.GetCommit().GetMessage(),
+			ParentsCount: len(ghCommit.Parents),
+		}
+	}
+
+	return commits, nil
+}
+
+// GetPushedFiles returns the paths of the files changed by the push.
+func (t *BranchTarget) GetPushedFiles() ([]string, error) {
+	comparison, err := t.getComparison()
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]string, len(comparison.Files))
+
+	for i, file := range comparison.Files {
+		files[i] = file.GetFilename()
+	}
+
+	return files, nil
+}
+
+func (t *BranchTarget) AddAssignees(_ []string) error {
+	return codehost.ErrNotSupported
+}
+
+func (t *BranchTarget) AddLabels(_ []string) error {
+	return codehost.ErrNotSupported
+}
+
+func (t *BranchTarget) AddToProject(_ string) (string, error) {
+	return "", codehost.ErrNotSupported
+}
+
+func (t *BranchTarget) Close(_ string, _ string) error {
+	return codehost.ErrNotSupported
+}
+
+func (t *BranchTarget) Comment(comment string) error {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+
+	_, _, err := t.githubClient.GetClientREST().Repositories.CreateComment(ctx, owner, repo, t.GetHeadSHA(), &github.RepositoryComment{Body: github.String(comment)})
+
+	return err
+}
+
+func (t *BranchTarget) GetAssignees() []*pbc.User {
+	return []*pbc.User{}
+}
+
+func (t *BranchTarget) GetAuthor() (*codehost.User, error) {
+	return t.GetPusher(), nil
+}
+
+func (t *BranchTarget) GetAvailableAssignees() ([]*codehost.User, error) {
+	return nil, codehost.ErrNotSupported
+}
+
+func (t *BranchTarget) GetCommentCount() int64 {
+	return 0
+}
+
+func (t *BranchTarget) GetComments() ([]*codehost.Comment, error) {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+
+	cs, err := t.githubClient.GetCommitComments(ctx, owner, repo, t.GetHeadSHA())
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]*codehost.Comment, len(cs))
+
+	for i, comment := range cs {
+		comments[i] = &codehost.Comment{
+			ID:   comment.GetID(),
+			Body: comment.GetBody(),
+		}
+	}
+
+	return comments, nil
+}
+
+func (t *BranchTarget) GetCreatedAt() string {
+	return t.push.GetHeadCommit().GetTimestamp().String()
+}
+
+func (t *BranchTarget) GetDescription() string {
+	return t.push.GetHeadCommit().GetMessage()
+}
+
+func (t *BranchTarget) GetLabels() []*pbc.Label {
+	return []*pbc.Label{}
+}
+
+func (t *BranchTarget) GetLinkedProjects() ([]gh.GQLProjectV2Item, error) {
+	return nil, codehost.ErrNotSupported
+}
+
+func (t *BranchTarget) GetNodeID() string {
+	return ""
+}
+
+func (t *BranchTarget) GetProjectByName(_ string) (*codehost.Project, error) {
+	return nil, codehost.ErrNotSupported
+}
+
+func (t *BranchTarget) GetProjectFieldsByProjectNumber(_ uint64) ([]*codehost.ProjectField, error) {
+	return nil, codehost.ErrNotSupported
+}
+
+func (t *BranchTarget) GetProjectV2ItemID(_ string) (string, error) {
+	return "", codehost.ErrNotSupported
+}
+
+func (t *BranchTarget) GetState() pbc.PullRequestStatus {
+	return pbc.PullRequestStatus_OPEN
+}
+
+func (t *BranchTarget) GetTargetEntity() *entities.TargetEntity {
+	return t.targetEntity
+}
+
+func (t *BranchTarget) GetTitle() string {
+	return t.GetBranch()
+}
+
+func (t *BranchTarget) GetUpdatedAt() string {
+	return t.push.GetHeadCommit().GetTimestamp().String()
+}
+
+func (t *BranchTarget) IsInProject(_ string) (bool, error) {
+	return false, codehost.ErrNotSupported
+}
+
+func (t *BranchTarget) IsLinkedToProject(_ string) (bool, error) {
+	return false, codehost.ErrNotSupported
+}
+
+func (t *BranchTarget) JSON() (string, error) {
+	j, err := json.Marshal(t.push)
+	if err != nil {
+		return "", err
+	}
+
+	return string(j), nil
+}
+
+func (t *BranchTarget) RemoveLabel(_ string) error {
+	return codehost.ErrNotSupported
+}
+
+func (t *BranchTarget) SetProjectField(_, _, _ string) error {
+	return codehost.ErrNotSupported
+}
@@ -0,0 +1,245 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package target
+
+import (
+	"context"
+	"encoding/json"
+
+	pbc "github.com/reviewpad/api/go/codehost"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	gh "github.com/reviewpad/reviewpad/v4/codehost/github"
+	"github.com/shurcooL/githubv4"
+)
+
+// DiscussionTarget is a GitHub discussion.
+// Discussions are only available through the GraphQL API.
+type DiscussionTarget struct {
+	ctx          context.Context
+	targetEntity *entities.TargetEntity
+	githubClient *gh.GithubClient
+	discussion   *gh.Discussion
+}
+
+// ensure DiscussionTarget conforms to Target interface
+var _ codehost.Target = (*DiscussionTarget)(nil)
+
+func NewDiscussionTarget(ctx context.Context, targetEntity *entities.TargetEntity, githubClient *gh.GithubClient, discussion *gh.Discussion) *DiscussionTarget {
+	return &DiscussionTarget{
+		ctx:          ctx,
+		targetEntity: targetEntity,
+		githubClient: githubClient,
+		discussion:   discussion,
+	}
+}
+
+// GetCategory returns the name of the category of the discussion.
+func (t *DiscussionTarget) GetCategory() string {
+	return t.discussion.Category
+}
+
+// IsAnswered reports whether a comment of the discussion was marked as the answer.
+func (t *DiscussionTarget) IsAnswered() bool {
+	return t.discussion.IsAnswered
+}
+
+// GetDiscussionComments returns the comments of the discussion without its body.
+func (t *DiscussionTarget) GetDiscussionComments() ([]gh.DiscussionComment, error) {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	comments, err := t.githubClient.GetDiscussionComments(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first comment is the body of the discussion.
+	return comments[1:], nil
+}
+
+func (t *DiscussionTarget) AddAssignees(_ []string) error {
+	return codehost.ErrNotSupported
+}
+
+func (t *DiscussionTarget) AddLabels(labels []string) error {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+
+	labelIDs := make([]string, len(labels))
+
+	for i, label := range labels {
+		ghLabel, _, err := t.githubClient.GetLabel(ctx, owner, repo, label)
+		if err != nil {
+			return err
+		}
+
+		labelIDs[i] = ghLabel.GetNodeID()
+	}
+
+	return t.githubClient.AddLabelsToLabelable(ctx, t.discussion.ID, labelIDs)
+}
+
+func (t *DiscussionTarget) AddToProject(_ string) (string, error) {
+	return "", codehost.ErrNotSupported
+}
+
+func (t *DiscussionTarget) Close(comment string, stateReason string) error {
+	if t.discussion.Closed {
+		return nil
+	}
+
+	reason := githubv4.DiscussionCloseReasonOutdated
+	if stateReason == "completed" {
+		reason = githubv4.DiscussionCloseReasonResolved
+	}
+
+	if err := t.githubClient.CloseDiscussion(t.ctx, t.discussion.ID, reason); err != nil {
+		return err
+	}
+
+	if comment != "" {
+		if errComment := t.Comment(comment); errComment != nil {
+			return errComment
+		}
+	}
+
+	return nil
+}
+
+func (t *DiscussionTarget) Comment(comment string) error {
+	return t.githubClient.AddCommentToDiscussion(t.ctx, t.discussion.ID, comment)
+}
+
+func (t *DiscussionTarget) GetAssignees() []*pbc.User {
+	return []*pbc.User{}
+}
+
+func (t *DiscussionTarget) GetAuthor() (*codehost.User, error) {
+	return &codehost.User{Login: t.discussion.AuthorLogin}, nil
+}
+
+func (t *DiscussionTarget) GetAvailableAssignees() ([]*codehost.User, error) {
+	return nil, codehost.ErrNotSupported
+}
+
+func (t *DiscussionTarget) GetCommentCount() int64 {
+	return int64(t.discussion.CommentCount)
+}
+
+func (t *DiscussionTarget) GetComments() ([]*codehost.Comment, error) {
+	discussionComments, err := t.GetDiscussionComments()
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]*codehost.Comment, len(discussionComments))
+
+	for i, comment := range discussionComments {
+		comments[i] = &codehost.Comment{
+			Body: comment.Body,
+		}
+	}
+
+	return comments, nil
+}
+
+func (t *DiscussionTarget) GetCreatedAt() string {
+	return t.discussion.CreatedAt.String()
+}
+
+func (t *DiscussionTarget) GetDescription() string {
+	return t.discussion.Body
+}
+
+func (t *DiscussionTarget) GetLabels() []*pbc.Label {
+	labels := make([]*pbc.Label, len(t.discussion.Labels))
+
+	for i, label := range t.discussion.Labels {
+		labels[i] = &pbc.Label{
+			Id:   label.ID,
+			Name: label.Name,
+		}
+	}
+
+	return labels
+}
+
+func (t *DiscussionTarget) GetLinkedProjects() ([]gh.GQLProjectV2Item, error) {
+	return nil, codehost.ErrNotSupported
+}
+
+func (t *DiscussionTarget) GetNodeID() string {
+	return t.discussion.ID
+}
+
+func (t *DiscussionTarget) GetProjectByName(_ string) (*codehost.Project, error) {
+	return nil, codehost.ErrNotSupported
+}
+
+func (t *DiscussionTarget) GetProjectFieldsByProjectNumber(_ uint64) ([]*codehost.ProjectField, error) {
+	return nil, codehost.ErrNotSupported
+}
+
+func (t *DiscussionTarget) GetProjectV2ItemID(_ string) (string, error) {
+	return "", codehost.ErrNotSupported
+}
+
+func (t *DiscussionTarget) GetState() pbc.PullRequestStatus {
+	if t.discussion.Closed {
+		return pbc.PullRequestStatus_CLOSED
+	}
+
+	return pbc.PullRequestStatus_OPEN
+}
+
+func (t *DiscussionTarget) GetTargetEntity() *entities.TargetEntity {
+	return t.targetEntity
+}
+
+func (t *DiscussionTarget) GetTitle() string {
+	return t.discussion.Title
+}
+
+func (t *DiscussionTarget) GetUpdatedAt() string {
+	return t.discussion.UpdatedAt.String()
+}
+
+func (t *DiscussionTarget) IsInProject(_ string) (bool, error) {
+	return false, codehost.ErrNotSupported
+}
+
+func (t *DiscussionTarget) IsLinkedToProject(_ string) (bool, error) {
+	return false, codehost.ErrNotSupported
+}
+
+func (t *DiscussionTarget) JSON() (string, error) {
+	j, err := json.Marshal(t.discussion)
+	if err != nil {
+		return "", err
+	}
+
+	return string(j), nil
+}
+
+func (t *DiscussionTarget) RemoveLabel(labelName string) error {
+	for _, label := range t.discussion.Labels {
+		if label.Name == labelName {
+			return t.githubClient.RemoveLabelsFromLabelable(t.ctx, t.discussion.ID, []string{label.ID})
+		}
+	}
+
+	// Like on issues, removing a label the discussion doesn't have is not an error.
+	return nil
+}
+
+func (t *DiscussionTarget) SetProjectField(_, _, _ string) error {
+	return codehost.ErrNotSupported
+}
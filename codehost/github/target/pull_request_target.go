@@ -18,7 +18,8 @@ import (
 	"github.com/shurcooL/githubv4"
 )
 
-type Patch map[string]*codehost.File
+// Patch is kept for the builtins that still refer to the patch of GitHub pull requests.
+type Patch = codehost.Patch
 
 type PullRequestTarget struct {
 	*CommonTarget
@@ -29,8 +30,8 @@ type PullRequestTarget struct {
 	Patch        Patch
 }
 
-// ensure PullRequestTarget conforms to Target interface
-var _ codehost.Target = (*PullRequestTarget)(nil)
+// ensure PullRequestTarget conforms to PullRequest interface
+var _ codehost.PullRequest = (*PullRequestTarget)(nil)
 
 func getPullRequestPatch(ctx context.Context, pullRequest *pbc.PullRequest, codehostClient *codehost.CodeHostClient) (Patch, error) {
 	patchMap := make(map[string]*codehost.File)
@@ -71,6 +72,98 @@ func NewPullRequestTarget(ctx context.Context, targetEntity *entities.TargetEnti
 	}, nil
 }
 
+func (t *PullRequestTarget) GetPullRequest() *pbc.PullRequest {
+	return t.PullRequest
+}
+
+func (t *PullRequestTarget) GetPatch() codehost.Patch {
+	return t.Patch
+}
+
+func (t *PullRequestTarget) GetCheckRuns(ref string) ([]*codehost.CheckRun, error) {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	ghCheckRuns, err := t.githubClient.GetCheckRunsForRef(ctx, owner, repo, number, ref, &github.ListCheckRunsOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	checkRuns := make([]*codehost.CheckRun, len(ghCheckRuns))
+
+	for i, ghCheckRun := range ghCheckRuns {
+		checkRuns[i] = &codehost.CheckRun{
+			ID:          ghCheckRun.GetID(),
+			Name:        ghCheckRun.GetName(),
+			Status:      ghCheckRun.GetStatus(),
+			Conclusion:  ghCheckRun.GetConclusion(),
+			CompletedAt: ghCheckRun.CompletedAt.GetTime(),
+		}
+	}
+
+	return checkRuns, nil
+}
+
+func (t *PullRequestTarget) UpdateCheckRun(checkRunID int64, checkRun *codehost.CheckRun) error {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+
+	_, _, err := t.githubClient.GetClientREST().Checks.UpdateCheckRun(ctx, owner, repo, checkRunID, github.UpdateCheckRunOptions{
+		Name:       checkRun.Name,
+		Status:     github.String(checkRun.Status),
+		Conclusion: github.String(checkRun.Conclusion),
+		Output: &github.CheckRunOutput{
+			Title:   github.String(checkRun.Title),
+			Summary: github.String(checkRun.Summary),
+		},
+	})
+
+	return err
+}
+
+func (t *PullRequestTarget) IsMergeQueueEnabled() (bool, error) {
+	pr := t.PullRequest
+
+	return t.githubClient.IsGithubMergeQueueEnabled(t.ctx, pr.GetBase().GetRepo().GetOwner(), pr.GetBase().GetRepo().GetName(), pr.GetBase().GetName())
+}
+
+func (t *PullRequestTarget) GetMergeQueueEntries() ([]int, error) {
+	pr := t.PullRequest
+	totalRetryCount := 2
+
+	return t.githubClient.GetGitHubMergeQueueEntries(t.ctx, pr.GetBase().GetRepo().GetOwner(), pr.GetBase().GetRepo().GetName(), pr.GetBase().GetName(), totalRetryCount)
+}
+
+func (t *PullRequestTarget) AddToMergeQueue() error {
+	return t.githubClient.AddPullRequestToGithubMergeQueue(t.ctx, t.PullRequest.GetId())
+}
+
+func (t *PullRequestTarget) GetBlameAuthors(commitSHA string, filePaths []string) ([]string, error) {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+
+	gitBlame, err := t.githubClient.GetGitBlame(ctx, owner, repo, commitSHA, filePaths)
+	if err != nil {
+		return nil, err
+	}
+
+	authorsRank := t.githubClient.ComputeGitBlameRank(gitBlame)
+	authors := make([]string, len(authorsRank))
+
+	for i, authorRank := range authorsRank {
+		authors[i] = authorRank.Username
+	}
+
+	return authors, nil
+}
+
 func (t *PullRequestTarget) GetNodeID() string {
 	return t.PullRequest.GetId()
 }
@@ -189,12 +282,12 @@ func (t *PullRequestTarget) GetReviews() ([]*codehost.Review, error) {
 
 	for i, ghPrReview := range ghPrReviews {
 		reviews[i] = &codehost.Review{
-			ID:          *ghPrReview.ID,
-			Body:        *ghPrReview.Body,
-			State:       *ghPrReview.State,
+			ID:          ghPrReview.GetID(),
+			Body:        ghPrReview.GetBody(),
+			State:       ghPrReview.GetState(),
 			SubmittedAt: ghPrReview.SubmittedAt.GetTime(),
 			User: &codehost.User{
-				Login: *ghPrReview.User.Login,
+				Login: ghPrReview.GetUser().GetLogin(),
 			},
 		}
 	}
@@ -253,6 +346,61 @@ func (t *PullRequestTarget) Review(reviewEvent, reviewBody string) error {
 	return err
 }
 
+// ReviewWithComments creates a review with inline comments on the lines of the new version of the files.
+func (t *PullRequestTarget) ReviewWithComments(reviewEvent, reviewBody string, comments []*codehost.ReviewComment) error {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	draftComments := make([]*github.DraftReviewComment, len(comments))
+	for i, comment := range comments {
+		draftComments[i] = &github.DraftReviewComment{
+			Path: github.String(comment.Path),
+			Line: github.Int(comment.Line),
+			Side: github.String("RIGHT"),
+			Body: github.String(comment.Body),
+		}
+	}
+
+	_, _, err := t.githubClient.Review(ctx, owner, repo, number, &github.PullRequestReviewRequest{
+		Body:     &reviewBody,
+		Event:    &reviewEvent,
+		Comments: draftComments,
+	})
+
+	return err
+}
+
+func (t *PullRequestTarget) GetReviewComments() ([]*codehost.ReviewComment, error) {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	ghComments, err := t.githubClient.GetPullRequestReviewComments(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]*codehost.ReviewComment, len(ghComments))
+	for i, ghComment := range ghComments {
+		comments[i] = &codehost.ReviewComment{
+			ID:   ghComment.GetID(),
+			Path: ghComment.GetPath(),
+			Line: ghComment.GetLine(),
+			Body: ghComment.GetBody(),
+			User: &codehost.User{
+				Login: ghComment.GetUser().GetLogin(),
+			},
+		}
+	}
+
+	return comments, nil
+}
+
 func (t *PullRequestTarget) RequestReviewers(reviewers []string) error {
 	ctx := t.ctx
 	targetEntity := t.targetEntity
@@ -313,7 +461,8 @@ func (t *PullRequestTarget) GetCommits() ([]*codehost.Commit, error) {
 
 	for i, ghCommit := range ghCommits {
 		commits[i] = &codehost.Commit{
-			Message:      *ghCommit.Commit.Message,
+			SHA:          ghCommit.GetSHA(),
+			Message:      ghCommit.GetCommit().GetMessage(),
 			ParentsCount: len(ghCommit.Parents),
 		}
 	}
@@ -393,6 +542,66 @@ func (t *PullRequestTarget) GetPullRequestLastPushDate() (time.Time, error) {
 	return t.githubClient.GetPullRequestLastPushDate(ctx, owner, repo, number)
 }
 
+func (t *PullRequestTarget) GetFirstCommitAndReviewDate() (*time.Time, *time.Time, error) {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	return t.githubClient.GetFirstCommitAndReviewDate(ctx, owner, repo, number)
+}
+
+func (t *PullRequestTarget) GetAuthenticatedUserLogin() (string, error) {
+	return t.githubClient.GetAuthenticatedUserLogin()
+}
+
+func (t *PullRequestTarget) GetCloneCredentials() (*codehost.CloneCredentials, error) {
+	return &codehost.CloneCredentials{
+		URL:   t.PullRequest.Head.GetRepo().GetUri(),
+		Token: t.githubClient.GetToken(),
+	}, nil
+}
+
+func (t *PullRequestTarget) IsUpdatedWithBaseBranch() (bool, error) {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	return t.githubClient.GetPullRequestUpToDate(ctx, owner, repo, number)
+}
+
+func (t *PullRequestTarget) HasGitConflicts() (bool, error) {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	return t.githubClient.HasGitConflicts(ctx, owner, repo, number)
+}
+
+func (t *PullRequestTarget) DeleteHeadBranch() error {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	ref := "heads/" + t.PullRequest.Head.Name
+
+	refExists, err := t.githubClient.RefExists(ctx, owner, repo, "refs/"+ref)
+	if err != nil {
+		return fmt.Errorf("error getting reference: %w", err)
+	}
+
+	if !refExists {
+		return nil
+	}
+
+	return t.githubClient.DeleteReference(ctx, owner, repo, ref)
+}
+
 func (t *PullRequestTarget) IsFileBinary(branch, file string) (bool, error) {
 	ctx := t.ctx
 	targetEntity := t.targetEntity
@@ -7,9 +7,12 @@ package target_test
 import (
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/go-github/v52/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
 	"github.com/reviewpad/reviewpad/v4/codehost"
 	host "github.com/reviewpad/reviewpad/v4/codehost/github"
 	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
@@ -275,3 +278,46 @@ func TestGetLatestApprovedReviews(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCheckRuns(t *testing.T) {
+	completedAt := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	mockedEnv := aladino.MockDefaultEnv(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.True(t, strings.HasSuffix(r.URL.Path, "/commits/abc/check-runs"))
+
+					utils.MustWriteBytes(w, mock.MustMarshal(github.ListCheckRunsResults{
+						Total: github.Int(2),
+						CheckRuns: []*github.CheckRun{
+							{
+								Name:        github.String("build"),
+								Status:      github.String("completed"),
+								Conclusion:  github.String("success"),
+								CompletedAt: &github.Timestamp{Time: completedAt},
+							},
+							{
+								Name:   github.String("test"),
+								Status: github.String("in_progress"),
+							},
+						},
+					}))
+				}),
+			),
+		},
+		nil,
+		aladino.MockBuiltIns(),
+		nil,
+	)
+
+	gotCheckRuns, err := mockedEnv.GetTarget().(codehost.PullRequest).GetCheckRuns("abc")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*codehost.CheckRun{
+		{Name: "build", Status: "completed", Conclusion: "success", CompletedAt: &completedAt},
+		{Name: "test", Status: "in_progress"},
+	}, gotCheckRuns)
+}
@@ -0,0 +1,185 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package target
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/go-github/v52/github"
+	pbc "github.com/reviewpad/api/go/codehost"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	gh "github.com/reviewpad/reviewpad/v4/codehost/github"
+)
+
+// ReleaseTarget is a release of a repository.
+// Releases have no conversation, so they can't be commented on.
+type ReleaseTarget struct {
+	ctx          context.Context
+	targetEntity *entities.TargetEntity
+	githubClient *gh.GithubClient
+	release      *github.RepositoryRelease
+}
+
+// ensure ReleaseTarget conforms to Target interface
+var _ codehost.Target = (*ReleaseTarget)(nil)
+
+func NewReleaseTarget(ctx context.Context, targetEntity *entities.TargetEntity, githubClient *gh.GithubClient, release *github.RepositoryRelease) *ReleaseTarget {
+	return &ReleaseTarget{
+		ctx:          ctx,
+		targetEntity: targetEntity,
+		githubClient: githubClient,
+		release:      release,
+	}
+}
+
+// GetRelease returns the release.
+func (t *ReleaseTarget) GetRelease() *github.RepositoryRelease {
+	return t.release
+}
+
+// GetTag returns the name of the tag of the release.
+func (t *ReleaseTarget) GetTag() string {
+	return t.release.GetTagName()
+}
+
+// UpdateBody replaces the body of the release.
+func (t *ReleaseTarget) UpdateBody(body string) error {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+
+	release, _, err := t.githubClient.EditRelease(ctx, owner, repo, t.release.GetID(), &github.RepositoryRelease{Body: github.String(body)})
+	if err != nil {
+		return err
+	}
+
+	t.release = release
+
+	return nil
+}
+
+func (t *ReleaseTarget) AddAssignees(_ []string) error {
+	return codehost.ErrNotSupported
+}
+
+func (t *ReleaseTarget) AddLabels(_ []string) error {
+	return codehost.ErrNotSupported
+}
+
+func (t *ReleaseTarget) AddToProject(_ string) (string, error) {
+	return "", codehost.ErrNotSupported
+}
+
+func (t *ReleaseTarget) Close(_ string, _ string) error {
+	return codehost.ErrNotSupported
+}
+
+func (t *ReleaseTarget) Comment(_ string) error {
+	return codehost.ErrNotSupported
+}
+
+func (t *ReleaseTarget) GetAssignees() []*pbc.User {
+	return []*pbc.User{}
+}
+
+func (t *ReleaseTarget) GetAuthor() (*codehost.User, error) {
+	return &codehost.User{Login: t.release.GetAuthor().GetLogin()}, nil
+}
+
+func (t *ReleaseTarget) GetAvailableAssignees() ([]*codehost.User, error) {
+	return nil, codehost.ErrNotSupported
+}
+
+func (t *ReleaseTarget) GetCommentCount() int64 {
+	return 0
+}
+
+func (t *ReleaseTarget) GetComments() ([]*codehost.Comment, error) {
+	return nil, codehost.ErrNotSupported
+}
+
+func (t *ReleaseTarget) GetCreatedAt() string {
+	return t.release.GetCreatedAt().String()
+}
+
+func (t *ReleaseTarget) GetDescription() string {
+	return t.release.GetBody()
+}
+
+func (t *ReleaseTarget) GetLabels() []*pbc.Label {
+	return []*pbc.Label{}
+}
+
+func (t *ReleaseTarget) GetLinkedProjects() ([]gh.GQLProjectV2Item, error) {
+	return nil, codehost.ErrNotSupported
+}
+
+func (t *ReleaseTarget) GetNodeID() string {
+	return t.release.GetNodeID()
+}
+
+func (t *ReleaseTarget) GetProjectByName(_ string) (*codehost.Project, error) {
+	return nil, codehost.ErrNotSupported
+}
+
+func (t *ReleaseTarget) GetProjectFieldsByProjectNumber(_ uint64) ([]*codehost.ProjectField, error) {
+	return nil, codehost.ErrNotSupported
+}
+
+func (t *ReleaseTarget) GetProjectV2ItemID(_ string) (string, error) {
+	return "", codehost.ErrNotSupported
+}
+
+func (t *ReleaseTarget) GetState() pbc.PullRequestStatus {
+	return pbc.PullRequestStatus_OPEN
+}
+
+func (t *ReleaseTarget) GetTargetEntity() *entities.TargetEntity {
+	return t.targetEntity
+}
+
+func (t *ReleaseTarget) GetTitle() string {
+	if t.release.GetName() == "" {
+		return t.GetTag()
+	}
+
+	return t.release.GetName()
+}
+
+func (t *ReleaseTarget) GetUpdatedAt() string {
+	if t.release.PublishedAt == nil {
+		return t.GetCreatedAt()
+	}
+
+	return t.release.GetPublishedAt().String()
+}
+
+func (t *ReleaseTarget) IsInProject(_ string) (bool, error) {
+	return false, codehost.ErrNotSupported
+}
+
+func (t *ReleaseTarget) IsLinkedToProject(_ string) (bool, error) {
+	return false, codehost.ErrNotSupported
+}
+
+func (t *ReleaseTarget) JSON() (string, error) {
+	j, err := json.Marshal(t.release)
+	if err != nil {
+		return "", err
+	}
+
+	return string(j), nil
+}
+
+func (t *ReleaseTarget) RemoveLabel(_ string) error {
+	return codehost.ErrNotSupported
+}
+
+func (t *ReleaseTarget) SetProjectField(_, _, _ string) error {
+	return codehost.ErrNotSupported
+}
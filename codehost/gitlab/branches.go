@@ -0,0 +1,34 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type Branch struct {
+	Name   string  `json:"name"`
+	Commit *Commit `json:"commit"`
+}
+
+func branchPath(owner, repo, branch string) string {
+	return fmt.Sprintf("%s/repository/branches/%s", projectPath(owner, repo), url.PathEscape(branch))
+}
+
+func (c *GitlabClient) GetBranch(ctx context.Context, owner, repo, branch string) (*Branch, error) {
+	b := &Branch{}
+	if err := c.get(ctx, branchPath(owner, repo, branch), b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func (c *GitlabClient) DeleteBranch(ctx context.Context, owner, repo, branch string) error {
+	return c.send(ctx, http.MethodDelete, branchPath(owner, repo, branch), nil, nil)
+}
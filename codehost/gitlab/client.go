@@ -0,0 +1,231 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// GITLAB_API_URL is the URL of the gitlab.com API.
+const GITLAB_API_URL = "https://gitlab.com/api/v4"
+
+// GitlabClient is a client of the GitLab REST API v4.
+type GitlabClient struct {
+	baseURL    *url.URL
+	token      string
+	httpClient *http.Client
+}
+
+// ErrorResponse is an error returned by the GitLab API.
+type ErrorResponse struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *ErrorResponse) Error() string {
+	return fmt.Sprintf("gitlab: %d %s", e.StatusCode, e.Message)
+}
+
+// NewGitlabClient creates a client for the GitLab API at baseURL, e.g. https://gitlab.example.com/api/v4 for self-managed instances.
+// When baseURL is empty, the gitlab.com API is used. When httpClient is nil, http.DefaultClient is used.
+func NewGitlabClient(baseURL, token string, httpClient *http.Client) (*GitlabClient, error) {
+	if baseURL == "" {
+		baseURL = GITLAB_API_URL
+	}
+
+	u, err := url.Parse(strings.TrimSuffix(baseURL, "/") + "/")
+	if err != nil {
+		return nil, fmt.Errorf("invalid GitLab API URL: %v", err)
+	}
+
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &GitlabClient{
+		baseURL:    u,
+		token:      token,
+		httpClient: httpClient,
+	}, nil
+}
+
+func (c *GitlabClient) GetToken() string {
+	return c.token
+}
+
+// projectPath returns the escaped path of the project of the repository, as expected by the :id parameter of the API.
+func projectPath(owner, repo string) string {
+	return "projects/" + url.PathEscape(owner+"/"+repo)
+}
+
+func (c *GitlabClient) newRequest(ctx context.Context, method, path string, query url.Values, body interface{}) (*http.Request, error) {
+	// The path is appended as it is to keep the escaped slashes of the project path.
+	u, err := url.Parse(c.baseURL.String() + path)
+	if err != nil {
+		return nil, err
+	}
+
+	if query != nil {
+		u.RawQuery = query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	if c.token != "" {
+		req.Header.Set("PRIVATE-TOKEN", c.token)
+	}
+
+	return req, nil
+}
+
+// do sends the request and decodes the response into v, when v is not nil.
+// It returns the next page of the results, or 0 when there are no more pages.
+func (c *GitlabClient) do(req *http.Request, v interface{}) (int, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return 0, newErrorResponse(resp)
+	}
+
+	if v != nil && resp.StatusCode != http.StatusNoContent {
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil && err != io.EOF {
+			return 0, err
+		}
+	}
+
+	nextPage, _ := strconv.Atoi(resp.Header.Get("X-Next-Page"))
+
+	return nextPage, nil
+}
+
+func newErrorResponse(resp *http.Response) *ErrorResponse {
+	errResp := &ErrorResponse{StatusCode: resp.StatusCode, Message: resp.Status}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil || len(data) == 0 {
+		return errResp
+	}
+
+	// GitLab errors have either a message or an error field.
+	var body struct {
+		Message interface{} `json:"message"`
+		Error   string      `json:"error"`
+	}
+
+	if err := json.Unmarshal(data, &body); err != nil {
+		return errResp
+	}
+
+	switch {
+	case body.Message != nil:
+		errResp.Message = fmt.Sprint(body.Message)
+	case body.Error != "":
+		errResp.Message = body.Error
+	}
+
+	return errResp
+}
+
+// get fetches path into v.
+func (c *GitlabClient) get(ctx context.Context, path string, v interface{}) error {
+	req, err := c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(req, v)
+
+	return err
+}
+
+// getRaw fetches the content of path, which is not JSON.
+func (c *GitlabClient) getRaw(ctx context.Context, path string) ([]byte, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, newErrorResponse(resp)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// send sends body to path with method and decodes the response into v, when v is not nil.
+func (c *GitlabClient) send(ctx context.Context, method, path string, body, v interface{}) error {
+	req, err := c.newRequest(ctx, method, path, nil, body)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(req, v)
+
+	return err
+}
+
+// paginatedGet fetches the results of every page of path.
+func paginatedGet[T any](ctx context.Context, c *GitlabClient, path string) ([]T, error) {
+	results := make([]T, 0)
+	page := 1
+
+	for page > 0 {
+		query := url.Values{}
+		query.Set("per_page", "100")
+		query.Set("page", strconv.Itoa(page))
+
+		req, err := c.newRequest(ctx, http.MethodGet, path, query, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var pageResults []T
+		page, err = c.do(req, &pageResults)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pageResults...)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package gitlab_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v4/codehost/gitlab"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockGitlabClient(t *testing.T, handler http.HandlerFunc) *gitlab.GitlabClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := gitlab.NewGitlabClient(server.URL+"/api/v4", "token", server.Client())
+	assert.Nil(t, err)
+
+	return client
+}
+
+func TestGetMergeRequest(t *testing.T) {
+	client := mockGitlabClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/api/v4/projects/foobar%2Fsub%2Fdefault-mock-repo/merge_requests/6", r.URL.EscapedPath())
+		assert.Equal(t, "token", r.Header.Get("PRIVATE-TOKEN"))
+
+		w.Write([]byte(`{"id": 16, "iid": 6, "title": "Amazing new feature", "labels": ["enhancement"], "author": {"id": 1, "username": "john"}}`))
+	})
+
+	gotMergeRequest, err := client.GetMergeRequest(context.Background(), "foobar/sub", "default-mock-repo", 6)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &gitlab.MergeRequest{
+		ID:     16,
+		IID:    6,
+		Title:  "Amazing new feature",
+		Labels: []string{"enhancement"},
+		Author: &gitlab.User{ID: 1, Username: "john"},
+	}, gotMergeRequest)
+}
+
+func TestGetMergeRequest_WhenRequestFails(t *testing.T) {
+	client := mockGitlabClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "404 Not found"}`))
+	})
+
+	gotMergeRequest, err := client.GetMergeRequest(context.Background(), "foobar", "default-mock-repo", 6)
+
+	assert.Nil(t, gotMergeRequest)
+	assert.EqualError(t, err, "gitlab: 404 404 Not found")
+}
+
+func TestAcceptMergeRequest(t *testing.T) {
+	var gotBody map[string]interface{}
+	client := mockGitlabClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v4/projects/foobar%2Fdefault-mock-repo/merge_requests/6/merge", r.URL.EscapedPath())
+
+		rawBody, _ := io.ReadAll(r.Body)
+		assert.Nil(t, json.Unmarshal(rawBody, &gotBody))
+
+		w.Write([]byte(`{"iid": 6, "state": "merged"}`))
+	})
+
+	gotMergeRequest, err := client.AcceptMergeRequest(context.Background(), "foobar", "default-mock-repo", 6, &gitlab.AcceptMergeRequestOptions{Squash: true, SHA: "abc"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "merged", gotMergeRequest.State)
+	assert.Equal(t, map[string]interface{}{"squash": true, "sha": "abc"}, gotBody)
+}
+
+func TestListNotes(t *testing.T) {
+	client := mockGitlabClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v4/projects/foobar%2Fdefault-mock-repo/issues/3/notes", r.URL.EscapedPath())
+
+		page := r.URL.Query().Get("page")
+		if page == "1" {
+			w.Header().Set("X-Next-Page", "2")
+		}
+
+		w.Write([]byte(fmt.Sprintf(`[{"id": %s, "body": "note %s"}]`, page, page)))
+	})
+
+	gotNotes, err := client.ListNotes(context.Background(), "foobar", "default-mock-repo", gitlab.ISSUES_NOTEABLE, 3)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*gitlab.Note{{ID: 1, Body: "note 1"}, {ID: 2, Body: "note 2"}}, gotNotes)
+}
+
+func TestGetUserByUsername_WhenUserIsNotFound(t *testing.T) {
+	client := mockGitlabClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v4/users", r.URL.Path)
+		assert.Equal(t, "jane", r.URL.Query().Get("username"))
+
+		w.Write([]byte(`[]`))
+	})
+
+	gotUser, err := client.GetUserByUsername(context.Background(), "jane")
+
+	assert.Nil(t, gotUser)
+	assert.EqualError(t, err, "gitlab: user jane not found")
+}
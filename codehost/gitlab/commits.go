@@ -0,0 +1,58 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type Commit struct {
+	ID           string     `json:"id"`
+	Title        string     `json:"title"`
+	Message      string     `json:"message"`
+	ParentIDs    []string   `json:"parent_ids"`
+	AuthoredDate *time.Time `json:"authored_date"`
+	CreatedAt    *time.Time `json:"created_at"`
+}
+
+// CommitStatus is the status of a job of a pipeline or of an external check of a commit.
+// The status is one of pending, running, success, failed, canceled or skipped.
+type CommitStatus struct {
+	ID         int        `json:"id"`
+	Name       string     `json:"name"`
+	Status     string     `json:"status"`
+	FinishedAt *time.Time `json:"finished_at"`
+}
+
+// SetCommitStatusOptions are the options of the status of a commit.
+// The state is one of pending, running, success, failed or canceled.
+type SetCommitStatusOptions struct {
+	State       string `json:"state"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+func commitPath(owner, repo, sha string) string {
+	return fmt.Sprintf("%s/repository/commits/%s", projectPath(owner, repo), sha)
+}
+
+// ListCommitStatuses returns the statuses of the commit.
+func (c *GitlabClient) ListCommitStatuses(ctx context.Context, owner, repo, sha string) ([]*CommitStatus, error) {
+	return paginatedGet[*CommitStatus](ctx, c, commitPath(owner, repo, sha)+"/statuses")
+}
+
+// SetCommitStatus creates or updates the status of the commit with the name of the options.
+func (c *GitlabClient) SetCommitStatus(ctx context.Context, owner, repo, sha string, opts *SetCommitStatusOptions) (*CommitStatus, error) {
+	status := &CommitStatus{}
+	path := fmt.Sprintf("%s/statuses/%s", projectPath(owner, repo), sha)
+	if err := c.send(ctx, http.MethodPost, path, opts, status); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
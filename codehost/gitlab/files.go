@@ -0,0 +1,18 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// GetRawFile returns the content of the file of the repository at the ref, i.e. a branch, tag or commit.
+func (c *GitlabClient) GetRawFile(ctx context.Context, owner, repo, ref, filePath string) ([]byte, error) {
+	path := fmt.Sprintf("%s/repository/files/%s/raw?ref=%s", projectPath(owner, repo), url.PathEscape(filePath), url.QueryEscape(ref))
+
+	return c.getRaw(ctx, path)
+}
@@ -0,0 +1,52 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type Issue struct {
+	ID             int        `json:"id"`
+	IID            int        `json:"iid"`
+	ProjectID      int        `json:"project_id"`
+	Title          string     `json:"title"`
+	Description    string     `json:"description"`
+	State          string     `json:"state"`
+	Author         *User      `json:"author"`
+	Assignees      []*User    `json:"assignees"`
+	Labels         []string   `json:"labels"`
+	UserNotesCount int        `json:"user_notes_count"`
+	WebURL         string     `json:"web_url"`
+	CreatedAt      *time.Time `json:"created_at"`
+	UpdatedAt      *time.Time `json:"updated_at"`
+}
+
+func issuePath(owner, repo string, iid int) string {
+	return fmt.Sprintf("%s/issues/%d", projectPath(owner, repo), iid)
+}
+
+func (c *GitlabClient) GetIssue(ctx context.Context, owner, repo string, iid int) (*Issue, error) {
+	issue := &Issue{}
+	if err := c.get(ctx, issuePath(owner, repo, iid), issue); err != nil {
+		return nil, err
+	}
+
+	return issue, nil
+}
+
+// UpdateIssue changes the issue.
+// Issues take the same options as merge requests.
+func (c *GitlabClient) UpdateIssue(ctx context.Context, owner, repo string, iid int, opts *UpdateMergeRequestOptions) (*Issue, error) {
+	issue := &Issue{}
+	if err := c.send(ctx, http.MethodPut, issuePath(owner, repo, iid), opts, issue); err != nil {
+		return nil, err
+	}
+
+	return issue, nil
+}
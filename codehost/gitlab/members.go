@@ -0,0 +1,40 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// ListProjectMembers returns the members of the project, including the inherited members.
+func (c *GitlabClient) ListProjectMembers(ctx context.Context, owner, repo string) ([]*User, error) {
+	return paginatedGet[*User](ctx, c, projectPath(owner, repo)+"/members/all")
+}
+
+// GetCurrentUser returns the user of the token of the client.
+func (c *GitlabClient) GetCurrentUser(ctx context.Context) (*User, error) {
+	user := &User{}
+	if err := c.get(ctx, "user", user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// GetUserByUsername returns the user with the username.
+func (c *GitlabClient) GetUserByUsername(ctx context.Context, username string) (*User, error) {
+	var users []*User
+	if err := c.get(ctx, "users?username="+url.QueryEscape(username), &users); err != nil {
+		return nil, err
+	}
+
+	if len(users) == 0 {
+		return nil, fmt.Errorf("gitlab: user %v not found", username)
+	}
+
+	return users[0], nil
+}
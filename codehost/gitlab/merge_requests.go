@@ -0,0 +1,213 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type User struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+}
+
+type MergeRequest struct {
+	ID              int        `json:"id"`
+	IID             int        `json:"iid"`
+	ProjectID       int        `json:"project_id"`
+	SourceProjectID int        `json:"source_project_id"`
+	Title           string     `json:"title"`
+	Description     string     `json:"description"`
+	State           string     `json:"state"`
+	Draft           bool       `json:"draft"`
+	HasConflicts    bool       `json:"has_conflicts"`
+	SourceBranch    string     `json:"source_branch"`
+	TargetBranch    string     `json:"target_branch"`
+	SHA             string     `json:"sha"`
+	Author          *User      `json:"author"`
+	Assignees       []*User    `json:"assignees"`
+	Reviewers       []*User    `json:"reviewers"`
+	Labels          []string   `json:"labels"`
+	UserNotesCount  int        `json:"user_notes_count"`
+	WebURL          string     `json:"web_url"`
+	CreatedAt       *time.Time `json:"created_at"`
+	UpdatedAt       *time.Time `json:"updated_at"`
+	MergedAt        *time.Time `json:"merged_at"`
+	ClosedAt        *time.Time `json:"closed_at"`
+	Milestone       *Milestone `json:"milestone"`
+	DiffRefs        *DiffRefs  `json:"diff_refs"`
+}
+
+type Milestone struct {
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+}
+
+// DiffRefs are the commits of the diff of a merge request, which locate the comments on the diff.
+type DiffRefs struct {
+	BaseSHA  string `json:"base_sha"`
+	HeadSHA  string `json:"head_sha"`
+	StartSHA string `json:"start_sha"`
+}
+
+// MergeRequestDiff is the diff of a file changed by a merge request.
+// The diff has the hunks of the unified diff without the file headers.
+type MergeRequestDiff struct {
+	OldPath     string `json:"old_path"`
+	NewPath     string `json:"new_path"`
+	Diff        string `json:"diff"`
+	NewFile     bool   `json:"new_file"`
+	RenamedFile bool   `json:"renamed_file"`
+	DeletedFile bool   `json:"deleted_file"`
+}
+
+// MergeRequestVersion is a version of the diff of a merge request, created on every push to the source branch.
+type MergeRequestVersion struct {
+	ID        int        `json:"id"`
+	HeadSHA   string     `json:"head_commit_sha"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+// UpdateMergeRequestOptions are the changes of a merge request or issue.
+// The labels are comma separated.
+type UpdateMergeRequestOptions struct {
+	AddLabels    string `json:"add_labels,omitempty"`
+	RemoveLabels string `json:"remove_labels,omitempty"`
+	AssigneeIDs  []int  `json:"assignee_ids,omitempty"`
+	ReviewerIDs  []int  `json:"reviewer_ids,omitempty"`
+	StateEvent   string `json:"state_event,omitempty"`
+}
+
+// AcceptMergeRequestOptions are the options to merge a merge request.
+type AcceptMergeRequestOptions struct {
+	Squash                   bool   `json:"squash,omitempty"`
+	ShouldRemoveSourceBranch bool   `json:"should_remove_source_branch,omitempty"`
+	SHA                      string `json:"sha,omitempty"`
+}
+
+type MergeRequestApprovals struct {
+	Approved   bool `json:"approved"`
+	ApprovedBy []struct {
+		User *User `json:"user"`
+	} `json:"approved_by"`
+}
+
+type Pipeline struct {
+	ID     int    `json:"id"`
+	SHA    string `json:"sha"`
+	Ref    string `json:"ref"`
+	Status string `json:"status"`
+	WebURL string `json:"web_url"`
+}
+
+type Discussion struct {
+	ID    string  `json:"id"`
+	Notes []*Note `json:"notes"`
+}
+
+// CreateDiscussionOptions are the options of a thread, which is a thread on a line of the diff when it has a position.
+type CreateDiscussionOptions struct {
+	Body     string    `json:"body"`
+	Position *Position `json:"position,omitempty"`
+}
+
+func mergeRequestPath(owner, repo string, iid int) string {
+	return fmt.Sprintf("%s/merge_requests/%d", projectPath(owner, repo), iid)
+}
+
+func (c *GitlabClient) GetMergeRequest(ctx context.Context, owner, repo string, iid int) (*MergeRequest, error) {
+	mergeRequest := &MergeRequest{}
+	if err := c.get(ctx, mergeRequestPath(owner, repo, iid), mergeRequest); err != nil {
+		return nil, err
+	}
+
+	return mergeRequest, nil
+}
+
+func (c *GitlabClient) UpdateMergeRequest(ctx context.Context, owner, repo string, iid int, opts *UpdateMergeRequestOptions) (*MergeRequest, error) {
+	mergeRequest := &MergeRequest{}
+	if err := c.send(ctx, http.MethodPut, mergeRequestPath(owner, repo, iid), opts, mergeRequest); err != nil {
+		return nil, err
+	}
+
+	return mergeRequest, nil
+}
+
+func (c *GitlabClient) AcceptMergeRequest(ctx context.Context, owner, repo string, iid int, opts *AcceptMergeRequestOptions) (*MergeRequest, error) {
+	mergeRequest := &MergeRequest{}
+	if err := c.send(ctx, http.MethodPut, mergeRequestPath(owner, repo, iid)+"/merge", opts, mergeRequest); err != nil {
+		return nil, err
+	}
+
+	return mergeRequest, nil
+}
+
+// RebaseMergeRequest rebases the source branch of the merge request onto the target branch.
+// The rebase is asynchronous.
+func (c *GitlabClient) RebaseMergeRequest(ctx context.Context, owner, repo string, iid int) error {
+	return c.send(ctx, http.MethodPut, mergeRequestPath(owner, repo, iid)+"/rebase", nil, nil)
+}
+
+func (c *GitlabClient) ApproveMergeRequest(ctx context.Context, owner, repo string, iid int) error {
+	return c.send(ctx, http.MethodPost, mergeRequestPath(owner, repo, iid)+"/approve", nil, nil)
+}
+
+func (c *GitlabClient) GetMergeRequestApprovals(ctx context.Context, owner, repo string, iid int) (*MergeRequestApprovals, error) {
+	approvals := &MergeRequestApprovals{}
+	if err := c.get(ctx, mergeRequestPath(owner, repo, iid)+"/approvals", approvals); err != nil {
+		return nil, err
+	}
+
+	return approvals, nil
+}
+
+func (c *GitlabClient) ListMergeRequestPipelines(ctx context.Context, owner, repo string, iid int) ([]*Pipeline, error) {
+	return paginatedGet[*Pipeline](ctx, c, mergeRequestPath(owner, repo, iid)+"/pipelines")
+}
+
+func (c *GitlabClient) ListMergeRequestDiscussions(ctx context.Context, owner, repo string, iid int) ([]*Discussion, error) {
+	return paginatedGet[*Discussion](ctx, c, mergeRequestPath(owner, repo, iid)+"/discussions")
+}
+
+// CreateMergeRequestDiscussion starts a thread on the merge request.
+func (c *GitlabClient) CreateMergeRequestDiscussion(ctx context.Context, owner, repo string, iid int, opts *CreateDiscussionOptions) (*Discussion, error) {
+	discussion := &Discussion{}
+	if err := c.send(ctx, http.MethodPost, mergeRequestPath(owner, repo, iid)+"/discussions", opts, discussion); err != nil {
+		return nil, err
+	}
+
+	return discussion, nil
+}
+
+// ResolveMergeRequestDiscussion resolves or unresolves a thread of the merge request.
+func (c *GitlabClient) ResolveMergeRequestDiscussion(ctx context.Context, owner, repo string, iid int, discussionID string, resolved bool) error {
+	path := fmt.Sprintf("%s/discussions/%s", mergeRequestPath(owner, repo, iid), discussionID)
+
+	return c.send(ctx, http.MethodPut, path, map[string]bool{"resolved": resolved}, nil)
+}
+
+// ListMergeRequestDiffs returns the diffs of the files changed by the merge request.
+func (c *GitlabClient) ListMergeRequestDiffs(ctx context.Context, owner, repo string, iid int) ([]*MergeRequestDiff, error) {
+	return paginatedGet[*MergeRequestDiff](ctx, c, mergeRequestPath(owner, repo, iid)+"/diffs")
+}
+
+// ListMergeRequestVersions returns the versions of the diff of the merge request, the most recent first.
+func (c *GitlabClient) ListMergeRequestVersions(ctx context.Context, owner, repo string, iid int) ([]*MergeRequestVersion, error) {
+	return paginatedGet[*MergeRequestVersion](ctx, c, mergeRequestPath(owner, repo, iid)+"/versions")
+}
+
+// ListMergeRequestCommits returns the commits of the merge request, the most recent first.
+func (c *GitlabClient) ListMergeRequestCommits(ctx context.Context, owner, repo string, iid int) ([]*Commit, error) {
+	return paginatedGet[*Commit](ctx, c, mergeRequestPath(owner, repo, iid)+"/commits")
+}
+
+// ListMergeRequestClosesIssues returns the issues that the merge request closes when it is merged.
+func (c *GitlabClient) ListMergeRequestClosesIssues(ctx context.Context, owner, repo string, iid int) ([]*Issue, error) {
+	return paginatedGet[*Issue](ctx, c, mergeRequestPath(owner, repo, iid)+"/closes_issues")
+}
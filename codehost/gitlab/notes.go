@@ -0,0 +1,76 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// The kinds of the noteables, i.e. of the resources that have notes.
+const (
+	MERGE_REQUESTS_NOTEABLE = "merge_requests"
+	ISSUES_NOTEABLE         = "issues"
+)
+
+// Note is a comment on a merge request or issue.
+// System notes are the notes created by GitLab, e.g. when a label is added.
+// The notes of threads on the diff have the position of the line they comment.
+type Note struct {
+	ID         int        `json:"id"`
+	Body       string     `json:"body"`
+	Author     *User      `json:"author"`
+	System     bool       `json:"system"`
+	Resolvable bool       `json:"resolvable"`
+	Resolved   bool       `json:"resolved"`
+	Position   *Position  `json:"position"`
+	CreatedAt  *time.Time `json:"created_at"`
+}
+
+// Position is the line of the diff of a merge request that a thread comments.
+type Position struct {
+	BaseSHA      string `json:"base_sha"`
+	StartSHA     string `json:"start_sha"`
+	HeadSHA      string `json:"head_sha"`
+	PositionType string `json:"position_type"`
+	NewPath      string `json:"new_path,omitempty"`
+	NewLine      int    `json:"new_line,omitempty"`
+}
+
+func notesPath(owner, repo, noteable string, iid int) string {
+	return fmt.Sprintf("%s/%s/%d/notes", projectPath(owner, repo), noteable, iid)
+}
+
+// ListNotes returns the notes of the merge request or issue, including the system notes.
+func (c *GitlabClient) ListNotes(ctx context.Context, owner, repo, noteable string, iid int) ([]*Note, error) {
+	return paginatedGet[*Note](ctx, c, notesPath(owner, repo, noteable, iid))
+}
+
+func (c *GitlabClient) CreateNote(ctx context.Context, owner, repo, noteable string, iid int, body string) (*Note, error) {
+	note := &Note{}
+	if err := c.send(ctx, http.MethodPost, notesPath(owner, repo, noteable, iid), map[string]string{"body": body}, note); err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+func (c *GitlabClient) UpdateNote(ctx context.Context, owner, repo, noteable string, iid, noteID int, body string) (*Note, error) {
+	note := &Note{}
+	path := fmt.Sprintf("%s/%d", notesPath(owner, repo, noteable, iid), noteID)
+	if err := c.send(ctx, http.MethodPut, path, map[string]string{"body": body}, note); err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+func (c *GitlabClient) DeleteNote(ctx context.Context, owner, repo, noteable string, iid, noteID int) error {
+	path := fmt.Sprintf("%s/%d", notesPath(owner, repo, noteable, iid), noteID)
+
+	return c.send(ctx, http.MethodDelete, path, nil, nil)
+}
@@ -0,0 +1,38 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+)
+
+// Project is a GitLab project, i.e. the repository of the merge requests and issues.
+type Project struct {
+	ID                int    `json:"id"`
+	PathWithNamespace string `json:"path_with_namespace"`
+	DefaultBranch     string `json:"default_branch"`
+	WebURL            string `json:"web_url"`
+	HTTPURLToRepo     string `json:"http_url_to_repo"`
+}
+
+func (c *GitlabClient) GetProject(ctx context.Context, owner, repo string) (*Project, error) {
+	project := &Project{}
+	if err := c.get(ctx, projectPath(owner, repo), project); err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+// GetProjectByID returns the project with the ID, e.g. the source project of a merge request from a fork.
+func (c *GitlabClient) GetProjectByID(ctx context.Context, id int) (*Project, error) {
+	project := &Project{}
+	if err := c.get(ctx, fmt.Sprintf("projects/%d", id), project); err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
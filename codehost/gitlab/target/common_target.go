@@ -0,0 +1,171 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package target
+
+import (
+	"context"
+
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	gh "github.com/reviewpad/reviewpad/v4/codehost/github"
+	"github.com/reviewpad/reviewpad/v4/codehost/gitlab"
+)
+
+// CommonTarget has the behaviour shared by merge requests and issues.
+// GitLab projects have no equivalent of GitHub projects V2, so the project methods are not supported.
+type CommonTarget struct {
+	ctx          context.Context
+	targetEntity *entities.TargetEntity
+	gitlabClient *gitlab.GitlabClient
+	noteable     string
+}
+
+func NewCommonTarget(ctx context.Context, targetEntity *entities.TargetEntity, gitlabClient *gitlab.GitlabClient, noteable string) *CommonTarget {
+	return &CommonTarget{
+		ctx,
+		targetEntity,
+		gitlabClient,
+		noteable,
+	}
+}
+
+// getAssigneeIDs returns the IDs of the users with the logins.
+func (t *CommonTarget) getAssigneeIDs(logins []string) ([]int, error) {
+	ids := make([]int, len(logins))
+
+	for i, login := range logins {
+		user, err := t.gitlabClient.GetUserByUsername(t.ctx, login)
+		if err != nil {
+			return nil, err
+		}
+
+		ids[i] = user.ID
+	}
+
+	return ids, nil
+}
+
+func (t *CommonTarget) AddToProject(_ string) (string, error) {
+	return "", codehost.ErrNotSupported
+}
+
+func (t *CommonTarget) Comment(comment string) error {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	_, err := t.gitlabClient.CreateNote(ctx, owner, repo, t.noteable, number, comment)
+
+	return err
+}
+
+// DeleteComment deletes the note of the merge request or issue.
+func (t *CommonTarget) DeleteComment(commentID int64) error {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	return t.gitlabClient.DeleteNote(ctx, owner, repo, t.noteable, number, int(commentID))
+}
+
+// EditComment replaces the body of the note of the merge request or issue.
+func (t *CommonTarget) EditComment(commentID int64, comment string) error {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	_, err := t.gitlabClient.UpdateNote(ctx, owner, repo, t.noteable, number, int(commentID), comment)
+
+	return err
+}
+
+func (t *CommonTarget) GetAvailableAssignees() ([]*codehost.User, error) {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+
+	members, err := t.gitlabClient.ListProjectMembers(ctx, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	assignees := make([]*codehost.User, len(members))
+
+	for i, member := range members {
+		assignees[i] = &codehost.User{
+			Login: member.Username,
+		}
+	}
+
+	return assignees, nil
+}
+
+// GetComments returns the comments of the users, leaving out the system notes.
+func (t *CommonTarget) GetComments() ([]*codehost.Comment, error) {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	notes, err := t.gitlabClient.ListNotes(ctx, owner, repo, t.noteable, number)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]*codehost.Comment, 0, len(notes))
+
+	for _, note := range notes {
+		if note.System {
+			continue
+		}
+
+		comments = append(comments, &codehost.Comment{
+			ID:   int64(note.ID),
+			Body: note.Body,
+		})
+	}
+
+	return comments, nil
+}
+
+func (t *CommonTarget) GetLinkedProjects() ([]gh.GQLProjectV2Item, error) {
+	return nil, codehost.ErrNotSupported
+}
+
+func (t *CommonTarget) GetProjectByName(_ string) (*codehost.Project, error) {
+	return nil, codehost.ErrNotSupported
+}
+
+func (t *CommonTarget) GetProjectFieldsByProjectNumber(_ uint64) ([]*codehost.ProjectField, error) {
+	return nil, codehost.ErrNotSupported
+}
+
+func (t *CommonTarget) GetProjectV2ItemID(_ string) (string, error) {
+	return "", codehost.ErrNotSupported
+}
+
+func (t *CommonTarget) GetTargetEntity() *entities.TargetEntity {
+	return t.targetEntity
+}
+
+func (t *CommonTarget) IsInProject(_ string) (bool, error) {
+	return false, codehost.ErrNotSupported
+}
+
+func (t *CommonTarget) IsLinkedToProject(_ string) (bool, error) {
+	return false, codehost.ErrNotSupported
+}
+
+func (t *CommonTarget) SetProjectField(_, _, _ string) error {
+	return codehost.ErrNotSupported
+}
@@ -0,0 +1,164 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package target
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	pbc "github.com/reviewpad/api/go/codehost"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/codehost/gitlab"
+)
+
+// IssueTarget is a GitLab issue.
+// The number of the target entity is the IID of the issue, i.e. its number in the project.
+type IssueTarget struct {
+	*CommonTarget
+
+	ctx          context.Context
+	targetEntity *entities.TargetEntity
+	gitlabClient *gitlab.GitlabClient
+	issue        *gitlab.Issue
+}
+
+// ensure IssueTarget conforms to Target interface
+var _ codehost.Target = (*IssueTarget)(nil)
+
+func NewIssueTarget(ctx context.Context, targetEntity *entities.TargetEntity, gitlabClient *gitlab.GitlabClient, issue *gitlab.Issue) *IssueTarget {
+	return &IssueTarget{
+		NewCommonTarget(ctx, targetEntity, gitlabClient, gitlab.ISSUES_NOTEABLE),
+		ctx,
+		targetEntity,
+		gitlabClient,
+		issue,
+	}
+}
+
+// LoadIssueTarget fetches the issue of the target entity.
+func LoadIssueTarget(ctx context.Context, targetEntity *entities.TargetEntity, gitlabClient *gitlab.GitlabClient) (*IssueTarget, error) {
+	issue, err := gitlabClient.GetIssue(ctx, targetEntity.Owner, targetEntity.Repo, targetEntity.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewIssueTarget(ctx, targetEntity, gitlabClient, issue), nil
+}
+
+// update applies the changes to the issue and keeps the updated issue.
+func (t *IssueTarget) update(opts *gitlab.UpdateMergeRequestOptions) error {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	issue, err := t.gitlabClient.UpdateIssue(ctx, owner, repo, number, opts)
+	if err != nil {
+		return err
+	}
+
+	t.issue = issue
+
+	return nil
+}
+
+func (t *IssueTarget) AddAssignees(assignees []string) error {
+	ids, err := t.getAssigneeIDs(assignees)
+	if err != nil {
+		return err
+	}
+
+	// The assignee IDs replace the assignees of the issue.
+	for _, assignee := range t.issue.Assignees {
+		ids = append(ids, assignee.ID)
+	}
+
+	return t.update(&gitlab.UpdateMergeRequestOptions{AssigneeIDs: ids})
+}
+
+func (t *IssueTarget) AddLabels(labels []string) error {
+	return t.update(&gitlab.UpdateMergeRequestOptions{AddLabels: strings.Join(labels, ",")})
+}
+
+// Close closes the issue.
+// GitLab issues have no close reason, so the state reason is ignored.
+func (t *IssueTarget) Close(comment string, _ string) error {
+	if t.issue.State == "closed" {
+		return nil
+	}
+
+	if err := t.update(&gitlab.UpdateMergeRequestOptions{StateEvent: "close"}); err != nil {
+		return err
+	}
+
+	if comment != "" {
+		if errComment := t.Comment(comment); errComment != nil {
+			return errComment
+		}
+	}
+
+	return nil
+}
+
+func (t *IssueTarget) GetAssignees() []*pbc.User {
+	return toUsers(t.issue.Assignees)
+}
+
+func (t *IssueTarget) GetAuthor() (*codehost.User, error) {
+	return &codehost.User{Login: t.issue.Author.Username}, nil
+}
+
+func (t *IssueTarget) GetCommentCount() int64 {
+	return int64(t.issue.UserNotesCount)
+}
+
+func (t *IssueTarget) GetCreatedAt() string {
+	return t.issue.CreatedAt.String()
+}
+
+func (t *IssueTarget) GetDescription() string {
+	return t.issue.Description
+}
+
+func (t *IssueTarget) GetLabels() []*pbc.Label {
+	return toLabels(t.issue.Labels)
+}
+
+func (t *IssueTarget) GetNodeID() string {
+	return fmt.Sprintf("gid://gitlab/Issue/%d", t.issue.ID)
+}
+
+func (t *IssueTarget) GetState() pbc.PullRequestStatus {
+	if t.issue.State == "closed" {
+		return pbc.PullRequestStatus_CLOSED
+	}
+
+	return pbc.PullRequestStatus_OPEN
+}
+
+func (t *IssueTarget) GetTitle() string {
+	return t.issue.Title
+}
+
+func (t *IssueTarget) GetUpdatedAt() string {
+	return t.issue.UpdatedAt.String()
+}
+
+func (t *IssueTarget) JSON() (string, error) {
+	j, err := json.Marshal(t.issue)
+	if err != nil {
+		return "", err
+	}
+
+	return string(j), nil
+}
+
+func (t *IssueTarget) RemoveLabel(labelName string) error {
+	return t.update(&gitlab.UpdateMergeRequestOptions{RemoveLabels: labelName})
+}
@@ -0,0 +1,952 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package target
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	pbc "github.com/reviewpad/api/go/codehost"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/codehost/gitlab"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MergeRequestTarget is a GitLab merge request.
+// The number of the target entity is the IID of the merge request, i.e. its number in the project.
+type MergeRequestTarget struct {
+	*CommonTarget
+
+	ctx          context.Context
+	targetEntity *entities.TargetEntity
+	gitlabClient *gitlab.GitlabClient
+	mergeRequest *gitlab.MergeRequest
+	patch        codehost.Patch
+	commits      []*codehost.Commit
+}
+
+// ensure MergeRequestTarget conforms to PullRequest interface
+var _ codehost.PullRequest = (*MergeRequestTarget)(nil)
+
+func NewMergeRequestTarget(ctx context.Context, targetEntity *entities.TargetEntity, gitlabClient *gitlab.GitlabClient, mergeRequest *gitlab.MergeRequest, patch codehost.Patch, commits []*codehost.Commit) *MergeRequestTarget {
+	return &MergeRequestTarget{
+		NewCommonTarget(ctx, targetEntity, gitlabClient, gitlab.MERGE_REQUESTS_NOTEABLE),
+		ctx,
+		targetEntity,
+		gitlabClient,
+		mergeRequest,
+		patch,
+		commits,
+	}
+}
+
+// LoadMergeRequestTarget fetches the merge request of the target entity with its diffs and commits.
+func LoadMergeRequestTarget(ctx context.Context, targetEntity *entities.TargetEntity, gitlabClient *gitlab.GitlabClient) (*MergeRequestTarget, error) {
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	mergeRequest, err := gitlabClient.GetMergeRequest(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs, err := gitlabClient.ListMergeRequestDiffs(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := toPatch(diffs)
+	if err != nil {
+		return nil, err
+	}
+
+	gitlabCommits, err := gitlabClient.ListMergeRequestCommits(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	// The commits are listed the most recent first, while the commits of GitHub pull requests are listed the oldest first.
+	commits := make([]*codehost.Commit, len(gitlabCommits))
+	for i, commit := range gitlabCommits {
+		commits[len(commits)-1-i] = &codehost.Commit{
+			SHA:          commit.ID,
+			Message:      commit.Message,
+			ParentsCount: len(commit.ParentIDs),
+		}
+	}
+
+	return NewMergeRequestTarget(ctx, targetEntity, gitlabClient, mergeRequest, patch, commits), nil
+}
+
+// toPatch converts the diffs of the merge request into the files of its patch.
+func toPatch(diffs []*gitlab.MergeRequestDiff) (codehost.Patch, error) {
+	patch := make(codehost.Patch)
+
+	for _, diff := range diffs {
+		file := &pbc.File{
+			Filename: diff.NewPath,
+			Patch:    diff.Diff,
+			Status:   pbc.File_MODIFIED,
+		}
+
+		switch {
+		case diff.NewFile:
+			file.Status = pbc.File_ADDED
+		case diff.DeletedFile:
+			file.Status = pbc.File_REMOVED
+		case diff.RenamedFile:
+			file.Status = pbc.File_RENAMED
+			file.PreviousFileName = diff.OldPath
+		}
+
+		for _, line := range strings.Split(diff.Diff, "\n") {
+			switch {
+			case strings.HasPrefix(line, "+"):
+				file.AdditionsCount++
+			case strings.HasPrefix(line, "-"):
+				file.DeletionsCount++
+			}
+		}
+
+		file.ChangesCount = file.AdditionsCount + file.DeletionsCount
+
+		patchFile, err := codehost.NewFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		patch[file.Filename] = patchFile
+	}
+
+	return patch, nil
+}
+
+// GetMergeRequest returns the merge request.
+func (t *MergeRequestTarget) GetMergeRequest() *gitlab.MergeRequest {
+	return t.mergeRequest
+}
+
+// GetHeadSHA returns the SHA of the head commit of the source branch.
+func (t *MergeRequestTarget) GetHeadSHA() string {
+	return t.mergeRequest.SHA
+}
+
+// IsDraft reports whether the merge request is marked as a draft.
+func (t *MergeRequestTarget) IsDraft() bool {
+	return t.mergeRequest.Draft
+}
+
+// update applies the changes to the merge request and keeps the updated merge request.
+func (t *MergeRequestTarget) update(opts *gitlab.UpdateMergeRequestOptions) error {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	mergeRequest, err := t.gitlabClient.UpdateMergeRequest(ctx, owner, repo, number, opts)
+	if err != nil {
+		return err
+	}
+
+	t.mergeRequest = mergeRequest
+
+	return nil
+}
+
+// Approve approves the merge request as the user of the client.
+func (t *MergeRequestTarget) Approve() error {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	return t.gitlabClient.ApproveMergeRequest(ctx, owner, repo, number)
+}
+
+// GetApprovers returns the users that approved the merge request.
+func (t *MergeRequestTarget) GetApprovers() ([]*codehost.User, error) {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	approvals, err := t.gitlabClient.GetMergeRequestApprovals(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	approvers := make([]*codehost.User, len(approvals.ApprovedBy))
+
+	for i, approval := range approvals.ApprovedBy {
+		approvers[i] = &codehost.User{
+			Login: approval.User.Username,
+		}
+	}
+
+	return approvers, nil
+}
+
+// GetPipelines returns the pipelines of the merge request, the most recent first.
+func (t *MergeRequestTarget) GetPipelines() ([]*gitlab.Pipeline, error) {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	return t.gitlabClient.ListMergeRequestPipelines(ctx, owner, repo, number)
+}
+
+// GetDiscussions returns the threads of the merge request.
+func (t *MergeRequestTarget) GetDiscussions() ([]*gitlab.Discussion, error) {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	return t.gitlabClient.ListMergeRequestDiscussions(ctx, owner, repo, number)
+}
+
+// StartDiscussion starts a thread on the merge request that must be resolved like a review thread.
+func (t *MergeRequestTarget) StartDiscussion(body string) error {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	_, err := t.gitlabClient.CreateMergeRequestDiscussion(ctx, owner, repo, number, &gitlab.CreateDiscussionOptions{Body: body})
+
+	return err
+}
+
+// Merge merges the merge request with the merge method used on GitHub: merge, squash or rebase.
+// GitLab rebases asynchronously, so the rebase method only rebases the source branch and the merge request must be merged once the rebase is done.
+func (t *MergeRequestTarget) Merge(mergeMethod string) error {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	switch mergeMethod {
+	case "merge", "squash":
+		mergeRequest, err := t.gitlabClient.AcceptMergeRequest(ctx, owner, repo, number, &gitlab.AcceptMergeRequestOptions{
+			Squash: mergeMethod == "squash",
+			SHA:    t.mergeRequest.SHA,
+		})
+		if err != nil {
+			return err
+		}
+
+		t.mergeRequest = mergeRequest
+
+		return nil
+	case "rebase":
+		return t.gitlabClient.RebaseMergeRequest(ctx, owner, repo, number)
+	default:
+		return fmt.Errorf("unsupported merge method %v", mergeMethod)
+	}
+}
+
+func (t *MergeRequestTarget) GetPullRequest() *pbc.PullRequest {
+	mergeRequest := t.mergeRequest
+	owner := t.targetEntity.Owner
+	repo := t.targetEntity.Repo
+
+	repository := &pbc.Repository{
+		Owner:    owner,
+		Name:     repo,
+		FullName: fmt.Sprintf("%s/%s", owner, repo),
+	}
+
+	pullRequest := &pbc.PullRequest{
+		Id:                fmt.Sprint(mergeRequest.ID),
+		Number:            int64(mergeRequest.IID),
+		Title:             mergeRequest.Title,
+		Description:       mergeRequest.Description,
+		Status:            t.GetState(),
+		IsDraft:           mergeRequest.Draft,
+		IsMerged:          mergeRequest.State == "merged",
+		IsClosed:          mergeRequest.State == "closed" || mergeRequest.State == "merged",
+		CommentsCount:     int64(mergeRequest.UserNotesCount),
+		CommitsCount:      t.GetCommitCount(),
+		ChangedFilesCount: int64(len(t.patch)),
+		Url:               mergeRequest.WebURL,
+		Base: &pbc.Branch{
+			Name: mergeRequest.TargetBranch,
+			Repo: repository,
+		},
+		Head: &pbc.Branch{
+			Name: mergeRequest.SourceBranch,
+			Sha:  mergeRequest.SHA,
+			Repo: repository,
+		},
+		Assignees: toUsers(mergeRequest.Assignees),
+		Labels:    toLabels(mergeRequest.Labels),
+		RequestedReviewers: &pbc.RequestedReviewers{
+			Users: toUsers(mergeRequest.Reviewers),
+		},
+	}
+
+	for _, file := range t.patch {
+		pullRequest.AdditionsCount += file.Repr.AdditionsCount
+		pullRequest.DeletionsCount += file.Repr.DeletionsCount
+	}
+
+	if mergeRequest.Author != nil {
+		pullRequest.Author = toUsers([]*gitlab.User{mergeRequest.Author})[0]
+	}
+
+	if mergeRequest.DiffRefs != nil {
+		pullRequest.Base.Sha = mergeRequest.DiffRefs.BaseSHA
+	}
+
+	if mergeRequest.Milestone != nil {
+		pullRequest.Milestone = &pbc.Milestone{
+			Id:    fmt.Sprint(mergeRequest.Milestone.ID),
+			Title: mergeRequest.Milestone.Title,
+		}
+	}
+
+	pullRequest.CreatedAt = toTimestamp(mergeRequest.CreatedAt)
+	pullRequest.UpdatedAt = toTimestamp(mergeRequest.UpdatedAt)
+	pullRequest.ClosedAt = toTimestamp(mergeRequest.ClosedAt)
+	pullRequest.MergedAt = toTimestamp(mergeRequest.MergedAt)
+
+	return pullRequest
+}
+
+func (t *MergeRequestTarget) GetPatch() codehost.Patch {
+	return t.patch
+}
+
+func (t *MergeRequestTarget) GetBase() string {
+	return t.mergeRequest.TargetBranch
+}
+
+func (t *MergeRequestTarget) GetHead() string {
+	return t.mergeRequest.SourceBranch
+}
+
+// IsFileBinary reports whether the file has a NUL byte in its first 8000 bytes, which is how git detects binary files.
+func (t *MergeRequestTarget) IsFileBinary(branch, file string) (bool, error) {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+
+	content, err := t.gitlabClient.GetRawFile(ctx, owner, repo, branch, file)
+	if err != nil {
+		return false, err
+	}
+
+	if len(content) > 8000 {
+		content = content[:8000]
+	}
+
+	return bytes.IndexByte(content, 0) >= 0, nil
+}
+
+func (t *MergeRequestTarget) GetCommitCount() int64 {
+	return int64(len(t.commits))
+}
+
+// GetCommits returns the commits of the merge request, the oldest first.
+func (t *MergeRequestTarget) GetCommits() ([]*codehost.Commit, error) {
+	return t.commits, nil
+}
+
+func (t *MergeRequestTarget) GetLastCommit() (string, error) {
+	return t.mergeRequest.SHA, nil
+}
+
+// GetPullRequestLastPushDate returns the creation date of the latest version of the diff, since every push creates a version.
+func (t *MergeRequestTarget) GetPullRequestLastPushDate() (time.Time, error) {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	versions, err := t.gitlabClient.ListMergeRequestVersions(ctx, owner, repo, number)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if len(versions) == 0 || versions[0].CreatedAt == nil {
+		return time.Time{}, fmt.Errorf("merge request %v has no pushes", number)
+	}
+
+	return *versions[0].CreatedAt, nil
+}
+
+// GetFirstCommitAndReviewDate returns the authoring date of the first commit and the date of the first approval.
+func (t *MergeRequestTarget) GetFirstCommitAndReviewDate() (*time.Time, *time.Time, error) {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	commits, err := t.gitlabClient.ListMergeRequestCommits(ctx, owner, repo, number)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reviews, err := t.GetReviews()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var firstCommit, firstReview *time.Time
+
+	// The commits are listed the most recent first.
+	if len(commits) > 0 {
+		firstCommit = commits[len(commits)-1].AuthoredDate
+	}
+
+	for _, review := range reviews {
+		if review.SubmittedAt != nil && (firstReview == nil || review.SubmittedAt.Before(*firstReview)) {
+			firstReview = review.SubmittedAt
+		}
+	}
+
+	return firstCommit, firstReview, nil
+}
+
+func (t *MergeRequestTarget) GetAuthenticatedUserLogin() (string, error) {
+	user, err := t.gitlabClient.GetCurrentUser(t.ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return user.Username, nil
+}
+
+// GetCloneCredentials returns the URL of the source project, which is a fork when the merge request comes from one.
+func (t *MergeRequestTarget) GetCloneCredentials() (*codehost.CloneCredentials, error) {
+	project, err := t.gitlabClient.GetProjectByID(t.ctx, t.mergeRequest.SourceProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &codehost.CloneCredentials{
+		URL:   project.HTTPURLToRepo,
+		Token: t.gitlabClient.GetToken(),
+	}, nil
+}
+
+// IsUpdatedWithBaseBranch reports whether the diff of the merge request starts at the latest commit of the target branch.
+func (t *MergeRequestTarget) IsUpdatedWithBaseBranch() (bool, error) {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+
+	branch, err := t.gitlabClient.GetBranch(ctx, owner, repo, t.mergeRequest.TargetBranch)
+	if err != nil {
+		return false, err
+	}
+
+	if t.mergeRequest.DiffRefs == nil || branch.Commit == nil {
+		return false, nil
+	}
+
+	return t.mergeRequest.DiffRefs.BaseSHA == branch.Commit.ID, nil
+}
+
+func (t *MergeRequestTarget) HasGitConflicts() (bool, error) {
+	return t.mergeRequest.HasConflicts, nil
+}
+
+func (t *MergeRequestTarget) DeleteHeadBranch() error {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+
+	err := t.gitlabClient.DeleteBranch(ctx, owner, repo, t.mergeRequest.SourceBranch)
+
+	var errResp *gitlab.ErrorResponse
+	if errors.As(err, &errResp) && errResp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	return err
+}
+
+func (t *MergeRequestTarget) GetApprovalsCount() (int, error) {
+	approvers, err := t.GetApprovers()
+	if err != nil {
+		return 0, err
+	}
+
+	return len(approvers), nil
+}
+
+// GetLatestApprovedReviews returns the logins of the users that currently approve the merge request.
+func (t *MergeRequestTarget) GetLatestApprovedReviews() ([]string, error) {
+	approvers, err := t.GetApprovers()
+	if err != nil {
+		return nil, err
+	}
+
+	logins := make([]string, len(approvers))
+	for i, approver := range approvers {
+		logins[i] = approver.Login
+	}
+
+	return logins, nil
+}
+
+func (t *MergeRequestTarget) GetLatestReviewFromReviewer(author string) (*codehost.Review, error) {
+	reviews, err := t.GetReviews()
+	if err != nil {
+		return nil, err
+	}
+
+	return codehost.LastReview(reviews, author), nil
+}
+
+func (t *MergeRequestTarget) GetRequestedReviewers() []*pbc.User {
+	return toUsers(t.mergeRequest.Reviewers)
+}
+
+// GetReviews returns the approvals of the merge request, which are the only reviews of GitLab.
+// The approvals are read from the system notes to know when they were given.
+func (t *MergeRequestTarget) GetReviews() ([]*codehost.Review, error) {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	notes, err := t.gitlabClient.ListNotes(ctx, owner, repo, gitlab.MERGE_REQUESTS_NOTEABLE, number)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews := make([]*codehost.Review, 0)
+
+	for _, note := range notes {
+		if !note.System || note.Body != "approved this merge request" || note.Author == nil {
+			continue
+		}
+
+		reviews = append(reviews, &codehost.Review{
+			ID:          int64(note.ID),
+			State:       "APPROVED",
+			SubmittedAt: note.CreatedAt,
+			User: &codehost.User{
+				Login: note.Author.Username,
+			},
+		})
+	}
+
+	return reviews, nil
+}
+
+// GetReviewComments returns the comments on the lines of the diff.
+func (t *MergeRequestTarget) GetReviewComments() ([]*codehost.ReviewComment, error) {
+	discussions, err := t.GetDiscussions()
+	if err != nil {
+		return nil, err
+	}
+
+	reviewComments := make([]*codehost.ReviewComment, 0)
+
+	for _, discussion := range discussions {
+		for _, note := range discussion.Notes {
+			if note.Position == nil {
+				continue
+			}
+
+			reviewComment := &codehost.ReviewComment{
+				ID:   int64(note.ID),
+				Path: note.Position.NewPath,
+				Line: note.Position.NewLine,
+				Body: note.Body,
+			}
+
+			if note.Author != nil {
+				reviewComment.User = &codehost.User{Login: note.Author.Username}
+			}
+
+			reviewComments = append(reviewComments, reviewComment)
+		}
+	}
+
+	return reviewComments, nil
+}
+
+// GetReviewers returns the reviewers of the merge request.
+// GitLab has no team reviewers.
+func (t *MergeRequestTarget) GetReviewers() (*codehost.Reviewers, error) {
+	users := make([]codehost.User, len(t.mergeRequest.Reviewers))
+
+	for i, reviewer := range t.mergeRequest.Reviewers {
+		users[i] = codehost.User{
+			Login: reviewer.Username,
+		}
+	}
+
+	return &codehost.Reviewers{
+		Users: users,
+		Teams: []codehost.Team{},
+	}, nil
+}
+
+func (t *MergeRequestTarget) RequestReviewers(reviewers []string) error {
+	ids, err := t.getAssigneeIDs(reviewers)
+	if err != nil {
+		return err
+	}
+
+	// The reviewer IDs replace the reviewers of the merge request.
+	for _, reviewer := range t.mergeRequest.Reviewers {
+		ids = append(ids, reviewer.ID)
+	}
+
+	return t.update(&gitlab.UpdateMergeRequestOptions{ReviewerIDs: ids})
+}
+
+func (t *MergeRequestTarget) RequestTeamReviewers(_ []string) error {
+	return codehost.ErrNotSupported
+}
+
+// Review reviews the merge request with the events of GitHub reviews.
+// GitLab has no reviews, so approving approves the merge request and requesting changes starts a thread that must be resolved.
+func (t *MergeRequestTarget) Review(reviewEvent, reviewBody string) error {
+	switch reviewEvent {
+	case "APPROVE":
+		if err := t.Approve(); err != nil {
+			return err
+		}
+
+		if reviewBody == "" {
+			return nil
+		}
+
+		return t.Comment(reviewBody)
+	case "REQUEST_CHANGES":
+		return t.StartDiscussion(reviewBody)
+	case "COMMENT":
+		return t.Comment(reviewBody)
+	default:
+		return fmt.Errorf("unsupported review event %v", reviewEvent)
+	}
+}
+
+// ReviewWithComments starts a thread on the line of each comment and then reviews the merge request.
+func (t *MergeRequestTarget) ReviewWithComments(reviewEvent, reviewBody string, comments []*codehost.ReviewComment) error {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	diffRefs := t.mergeRequest.DiffRefs
+	if diffRefs == nil && len(comments) > 0 {
+		return fmt.Errorf("merge request %v has no diff to comment", number)
+	}
+
+	for _, comment := range comments {
+		_, err := t.gitlabClient.CreateMergeRequestDiscussion(ctx, owner, repo, number, &gitlab.CreateDiscussionOptions{
+			Body: comment.Body,
+			Position: &gitlab.Position{
+				BaseSHA:      diffRefs.BaseSHA,
+				StartSHA:     diffRefs.StartSHA,
+				HeadSHA:      diffRefs.HeadSHA,
+				PositionType: "text",
+				NewPath:      comment.Path,
+				NewLine:      comment.Line,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return t.Review(reviewEvent, reviewBody)
+}
+
+// GetReviewThreads returns the threads that must be resolved.
+// GitLab does not mark threads as outdated.
+func (t *MergeRequestTarget) GetReviewThreads() ([]*codehost.ReviewThread, error) {
+	discussions, err := t.GetDiscussions()
+	if err != nil {
+		return nil, err
+	}
+
+	reviewThreads := make([]*codehost.ReviewThread, 0)
+
+	for _, discussion := range discussions {
+		if len(discussion.Notes) == 0 || !discussion.Notes[0].Resolvable {
+			continue
+		}
+
+		reviewThreads = append(reviewThreads, &codehost.ReviewThread{
+			IsResolved: discussion.Notes[0].Resolved,
+		})
+	}
+
+	return reviewThreads, nil
+}
+
+// GetCheckRuns returns the commit statuses of the ref, i.e. the jobs of its pipelines and its external checks, as check runs.
+func (t *MergeRequestTarget) GetCheckRuns(ref string) ([]*codehost.CheckRun, error) {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+
+	statuses, err := t.gitlabClient.ListCommitStatuses(ctx, owner, repo, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	checkRuns := make([]*codehost.CheckRun, len(statuses))
+
+	for i, status := range statuses {
+		checkRun := &codehost.CheckRun{
+			ID:          int64(status.ID),
+			Name:        status.Name,
+			Status:      "completed",
+			CompletedAt: status.FinishedAt,
+		}
+
+		switch status.Status {
+		case "success":
+			checkRun.Conclusion = "success"
+		case "failed":
+			checkRun.Conclusion = "failure"
+		case "canceled":
+			checkRun.Conclusion = "cancelled"
+		case "skipped":
+			checkRun.Conclusion = "skipped"
+		case "running":
+			checkRun.Status = "in_progress"
+		default:
+			checkRun.Status = "queued"
+		}
+
+		checkRuns[i] = checkRun
+	}
+
+	return checkRuns, nil
+}
+
+// UpdateCheckRun sets the status of the head commit with the name of the check run, since GitLab has no check runs.
+// The check run ID is ignored and the title of the check run is the description of the status.
+func (t *MergeRequestTarget) UpdateCheckRun(_ int64, checkRun *codehost.CheckRun) error {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+
+	state := "running"
+	if checkRun.Status == "completed" {
+		switch checkRun.Conclusion {
+		case "success", "neutral", "skipped":
+			state = "success"
+		case "cancelled":
+			state = "canceled"
+		default:
+			state = "failed"
+		}
+	}
+
+	_, err := t.gitlabClient.SetCommitStatus(ctx, owner, repo, t.mergeRequest.SHA, &gitlab.SetCommitStatusOptions{
+		State:       state,
+		Name:        checkRun.Name,
+		Description: checkRun.Title,
+	})
+
+	return err
+}
+
+// IsMergeQueueEnabled reports that there is no merge queue, since GitLab merge trains are not supported.
+func (t *MergeRequestTarget) IsMergeQueueEnabled() (bool, error) {
+	return false, nil
+}
+
+func (t *MergeRequestTarget) GetMergeQueueEntries() ([]int, error) {
+	return nil, codehost.ErrNotSupported
+}
+
+func (t *MergeRequestTarget) AddToMergeQueue() error {
+	return codehost.ErrNotSupported
+}
+
+func (t *MergeRequestTarget) GetBlameAuthors(_ string, _ []string) ([]string, error) {
+	return nil, codehost.ErrNotSupported
+}
+
+// GetLinkedIssuesCount returns the number of issues that the merge request closes.
+func (t *MergeRequestTarget) GetLinkedIssuesCount() (int, error) {
+	ctx := t.ctx
+	targetEntity := t.targetEntity
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+	number := targetEntity.Number
+
+	issues, err := t.gitlabClient.ListMergeRequestClosesIssues(ctx, owner, repo, number)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(issues), nil
+}
+
+// TriggerWorkflowByFileName is not supported, since GitLab pipelines are not defined by workflow files.
+func (t *MergeRequestTarget) TriggerWorkflowByFileName(_ string) error {
+	return codehost.ErrNotSupported
+}
+
+func (t *MergeRequestTarget) AddAssignees(assignees []string) error {
+	ids, err := t.getAssigneeIDs(assignees)
+	if err != nil {
+		return err
+	}
+
+	// The assignee IDs replace the assignees of the merge request.
+	for _, assignee := range t.mergeRequest.Assignees {
+		ids = append(ids, assignee.ID)
+	}
+
+	return t.update(&gitlab.UpdateMergeRequestOptions{AssigneeIDs: ids})
+}
+
+func (t *MergeRequestTarget) AddLabels(labels []string) error {
+	return t.update(&gitlab.UpdateMergeRequestOptions{AddLabels: strings.Join(labels, ",")})
+}
+
+func (t *MergeRequestTarget) Close(comment string, _ string) error {
+	if t.mergeRequest.State != "opened" {
+		return nil
+	}
+
+	if err := t.update(&gitlab.UpdateMergeRequestOptions{StateEvent: "close"}); err != nil {
+		return err
+	}
+
+	if comment != "" {
+		if errComment := t.Comment(comment); errComment != nil {
+			return errComment
+		}
+	}
+
+	return nil
+}
+
+func (t *MergeRequestTarget) GetAssignees() []*pbc.User {
+	return toUsers(t.mergeRequest.Assignees)
+}
+
+func (t *MergeRequestTarget) GetAuthor() (*codehost.User, error) {
+	return &codehost.User{Login: t.mergeRequest.Author.Username}, nil
+}
+
+func (t *MergeRequestTarget) GetCommentCount() int64 {
+	return int64(t.mergeRequest.UserNotesCount)
+}
+
+func (t *MergeRequestTarget) GetCreatedAt() string {
+	return t.mergeRequest.CreatedAt.String()
+}
+
+func (t *MergeRequestTarget) GetDescription() string {
+	return t.mergeRequest.Description
+}
+
+func (t *MergeRequestTarget) GetLabels() []*pbc.Label {
+	return toLabels(t.mergeRequest.Labels)
+}
+
+func (t *MergeRequestTarget) GetNodeID() string {
+	return fmt.Sprintf("gid://gitlab/MergeRequest/%d", t.mergeRequest.ID)
+}
+
+func (t *MergeRequestTarget) GetState() pbc.PullRequestStatus {
+	switch t.mergeRequest.State {
+	case "merged":
+		return pbc.PullRequestStatus_MERGED
+	case "closed":
+		return pbc.PullRequestStatus_CLOSED
+	case "locked":
+		return pbc.PullRequestStatus_LOCKED
+	default:
+		return pbc.PullRequestStatus_OPEN
+	}
+}
+
+func (t *MergeRequestTarget) GetTitle() string {
+	return t.mergeRequest.Title
+}
+
+func (t *MergeRequestTarget) GetUpdatedAt() string {
+	return t.mergeRequest.UpdatedAt.String()
+}
+
+func (t *MergeRequestTarget) JSON() (string, error) {
+	j, err := json.Marshal(t.mergeRequest)
+	if err != nil {
+		return "", err
+	}
+
+	return string(j), nil
+}
+
+func (t *MergeRequestTarget) RemoveLabel(labelName string) error {
+	return t.update(&gitlab.UpdateMergeRequestOptions{RemoveLabels: labelName})
+}
+
+func toUsers(users []*gitlab.User) []*pbc.User {
+	pbcUsers := make([]*pbc.User, len(users))
+
+	for i, user := range users {
+		pbcUsers[i] = &pbc.User{
+			Id:    fmt.Sprint(user.ID),
+			Login: user.Username,
+		}
+	}
+
+	return pbcUsers
+}
+
+// toLabels converts the labels, which GitLab only identifies by name.
+func toLabels(labels []string) []*pbc.Label {
+	pbcLabels := make([]*pbc.Label, len(labels))
+
+	for i, label := range labels {
+		pbcLabels[i] = &pbc.Label{
+			Id:   label,
+			Name: label,
+		}
+	}
+
+	return pbcLabels
+}
+
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,475 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package target_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	pbc "github.com/reviewpad/api/go/codehost"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/codehost/gitlab"
+	"github.com/reviewpad/reviewpad/v4/codehost/gitlab/target"
+	"github.com/stretchr/testify/assert"
+)
+
+const mergeRequestPath = "/api/v4/projects/foobar%2Fdefault-mock-repo/merge_requests/6"
+
+var mockTargetEntity = &entities.TargetEntity{
+	Kind:   entities.PullRequest,
+	Number: 6,
+	Owner:  "foobar",
+	Repo:   "default-mock-repo",
+}
+
+func mockMergeRequestTarget(t *testing.T, handler http.HandlerFunc) *target.MergeRequestTarget {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := gitlab.NewGitlabClient(server.URL+"/api/v4", "token", server.Client())
+	assert.Nil(t, err)
+
+	mergeRequest := &gitlab.MergeRequest{
+		ID:              16,
+		IID:             6,
+		SourceProjectID: 3,
+		Title:           "Amazing new feature",
+		State:           "opened",
+		SourceBranch:    "feature",
+		TargetBranch:    "main",
+		SHA:             "abc",
+		Author:          &gitlab.User{ID: 1, Username: "john"},
+		Assignees:       []*gitlab.User{{ID: 2, Username: "jane"}},
+		Labels:          []string{"enhancement"},
+		DiffRefs:        &gitlab.DiffRefs{BaseSHA: "def"},
+	}
+
+	return target.NewMergeRequestTarget(context.Background(), mockTargetEntity, client, mergeRequest, nil, nil)
+}
+
+func readBody(t *testing.T, r *http.Request) map[string]interface{} {
+	var body map[string]interface{}
+	rawBody, _ := io.ReadAll(r.Body)
+	assert.Nil(t, json.Unmarshal(rawBody, &body))
+
+	return body
+}
+
+func TestMergeRequestTarget(t *testing.T) {
+	mergeRequestTarget := mockMergeRequestTarget(t, nil)
+
+	author, err := mergeRequestTarget.GetAuthor()
+
+	assert.Nil(t, err)
+	assert.Equal(t, &codehost.User{Login: "john"}, author)
+	assert.Equal(t, "Amazing new feature", mergeRequestTarget.GetTitle())
+	assert.Equal(t, pbc.PullRequestStatus_OPEN, mergeRequestTarget.GetState())
+	assert.Equal(t, []*pbc.User{{Id: "2", Login: "jane"}}, mergeRequestTarget.GetAssignees())
+	assert.Equal(t, []*pbc.Label{{Id: "enhancement", Name: "enhancement"}}, mergeRequestTarget.GetLabels())
+	assert.Equal(t, "gid://gitlab/MergeRequest/16", mergeRequestTarget.GetNodeID())
+}
+
+func TestAddLabels(t *testing.T) {
+	mergeRequestTarget := mockMergeRequestTarget(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, mergeRequestPath, r.URL.EscapedPath())
+		assert.Equal(t, map[string]interface{}{"add_labels": "bug,ui"}, readBody(t, r))
+
+		w.Write([]byte(`{"iid": 6, "labels": ["bug", "enhancement", "ui"]}`))
+	})
+
+	err := mergeRequestTarget.AddLabels([]string{"bug", "ui"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"bug", "enhancement", "ui"}, mergeRequestTarget.GetMergeRequest().Labels)
+}
+
+func TestAddAssignees(t *testing.T) {
+	mergeRequestTarget := mockMergeRequestTarget(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v4/users":
+			w.Write([]byte(`[{"id": 3, "username": "jack"}]`))
+		default:
+			assert.Equal(t, map[string]interface{}{"assignee_ids": []interface{}{3.0, 2.0}}, readBody(t, r))
+			w.Write([]byte(`{"iid": 6}`))
+		}
+	})
+
+	err := mergeRequestTarget.AddAssignees([]string{"jack"})
+
+	assert.Nil(t, err)
+}
+
+func TestGetComments(t *testing.T) {
+	mergeRequestTarget := mockMergeRequestTarget(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, mergeRequestPath+"/notes", r.URL.EscapedPath())
+
+		w.Write([]byte(`[{"id": 1, "body": "added ~bug label", "system": true}, {"id": 2, "body": "Looks good"}]`))
+	})
+
+	gotComments, err := mergeRequestTarget.GetComments()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*codehost.Comment{{ID: 2, Body: "Looks good"}}, gotComments)
+}
+
+func TestGetApprovers(t *testing.T) {
+	mergeRequestTarget := mockMergeRequestTarget(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, mergeRequestPath+"/approvals", r.URL.EscapedPath())
+
+		w.Write([]byte(`{"approved": true, "approved_by": [{"user": {"id": 2, "username": "jane"}}]}`))
+	})
+
+	gotApprovers, err := mergeRequestTarget.GetApprovers()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*codehost.User{{Login: "jane"}}, gotApprovers)
+}
+
+func TestMerge(t *testing.T) {
+	tests := map[string]struct {
+		mergeMethod string
+		wantPath    string
+		wantBody    map[string]interface{}
+		wantErr     string
+	}{
+		"when merge method is merge": {
+			mergeMethod: "merge",
+			wantPath:    mergeRequestPath + "/merge",
+			wantBody:    map[string]interface{}{"sha": "abc"},
+		},
+		"when merge method is squash": {
+			mergeMethod: "squash",
+			wantPath:    mergeRequestPath + "/merge",
+			wantBody:    map[string]interface{}{"squash": true, "sha": "abc"},
+		},
+		"when merge method is rebase": {
+			mergeMethod: "rebase",
+			wantPath:    mergeRequestPath + "/rebase",
+		},
+		"when merge method is not supported": {
+			mergeMethod: "fast-forward",
+			wantErr:     "unsupported merge method fast-forward",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotPath string
+			var gotBody map[string]interface{}
+			mergeRequestTarget := mockMergeRequestTarget(t, func(w http.ResponseWriter, r *http.Request) {
+				gotPath = r.URL.EscapedPath()
+				if r.ContentLength > 0 {
+					gotBody = readBody(t, r)
+				}
+
+				w.Write([]byte(`{"iid": 6, "state": "merged"}`))
+			})
+
+			err := mergeRequestTarget.Merge(test.mergeMethod)
+
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantPath, gotPath)
+			assert.Equal(t, test.wantBody, gotBody)
+		})
+	}
+}
+
+func TestClose(t *testing.T) {
+	var gotComment string
+	mergeRequestTarget := mockMergeRequestTarget(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case mergeRequestPath:
+			assert.Equal(t, map[string]interface{}{"state_event": "close"}, readBody(t, r))
+			w.Write([]byte(`{"iid": 6, "state": "closed"}`))
+		case mergeRequestPath + "/notes":
+			gotComment = readBody(t, r)["body"].(string)
+			w.Write([]byte(`{"id": 3}`))
+		}
+	})
+
+	err := mergeRequestTarget.Close("Closed by Reviewpad", "")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Closed by Reviewpad", gotComment)
+	assert.Equal(t, pbc.PullRequestStatus_CLOSED, mergeRequestTarget.GetState())
+}
+
+func TestGetProjectByName(t *testing.T) {
+	mergeRequestTarget := mockMergeRequestTarget(t, nil)
+
+	gotProject, err := mergeRequestTarget.GetProjectByName("reviewpad")
+
+	assert.Nil(t, gotProject)
+	assert.Equal(t, codehost.ErrNotSupported, err)
+}
+
+func TestLoadMergeRequestTarget(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case mergeRequestPath:
+			w.Write([]byte(`{"id": 16, "iid": 6, "title": "Amazing new feature", "state": "opened", "source_branch": "feature", "target_branch": "main", "sha": "abc", "author": {"id": 1, "username": "john"}, "diff_refs": {"base_sha": "def"}}`))
+		case mergeRequestPath + "/diffs":
+			w.Write([]byte(`[{"old_path": "README.md", "new_path": "README.md", "diff": "@@ -1,2 +1,2 @@\n-# Title\n+# New title\n+Description\n text"}, {"old_path": "old.go", "new_path": "new.go", "diff": "", "renamed_file": true}]`))
+		case mergeRequestPath + "/commits":
+			w.Write([]byte(`[{"id": "abc", "message": "Add description", "parent_ids": ["bcd"]}, {"id": "bcd", "message": "Rename title", "parent_ids": ["def"]}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := gitlab.NewGitlabClient(server.URL+"/api/v4", "token", server.Client())
+	assert.Nil(t, err)
+
+	mergeRequestTarget, err := target.LoadMergeRequestTarget(context.Background(), mockTargetEntity, client)
+	assert.Nil(t, err)
+
+	gotCommits, err := mergeRequestTarget.GetCommits()
+	assert.Nil(t, err)
+	assert.Equal(t, []*codehost.Commit{
+		{SHA: "bcd", Message: "Rename title", ParentsCount: 1},
+		{SHA: "abc", Message: "Add description", ParentsCount: 1},
+	}, gotCommits)
+
+	gotPatch := mergeRequestTarget.GetPatch()
+	assert.Len(t, gotPatch, 2)
+	assert.Equal(t, pbc.File_MODIFIED, gotPatch["README.md"].Repr.Status)
+	assert.Equal(t, int64(2), gotPatch["README.md"].Repr.AdditionsCount)
+	assert.Equal(t, int64(1), gotPatch["README.md"].Repr.DeletionsCount)
+	assert.Equal(t, pbc.File_RENAMED, gotPatch["new.go"].Repr.Status)
+	assert.Equal(t, "old.go", gotPatch["new.go"].Repr.PreviousFileName)
+
+	gotPullRequest := mergeRequestTarget.GetPullRequest()
+	assert.Equal(t, int64(6), gotPullRequest.Number)
+	assert.Equal(t, int64(2), gotPullRequest.CommitsCount)
+	assert.Equal(t, int64(2), gotPullRequest.AdditionsCount)
+	assert.Equal(t, int64(2), gotPullRequest.ChangedFilesCount)
+	assert.Equal(t, &pbc.Branch{Name: "main", Sha: "def", Repo: &pbc.Repository{Owner: "foobar", Name: "default-mock-repo", FullName: "foobar/default-mock-repo"}}, gotPullRequest.Base)
+	assert.Equal(t, "feature", gotPullRequest.Head.Name)
+	assert.Equal(t, "john", gotPullRequest.Author.Login)
+}
+
+func TestReview(t *testing.T) {
+	tests := map[string]struct {
+		reviewEvent string
+		wantPaths   []string
+		wantErr     string
+	}{
+		"when review approves": {
+			reviewEvent: "APPROVE",
+			wantPaths:   []string{mergeRequestPath + "/approve", mergeRequestPath + "/notes"},
+		},
+		"when review requests changes": {
+			reviewEvent: "REQUEST_CHANGES",
+			wantPaths:   []string{mergeRequestPath + "/discussions"},
+		},
+		"when review comments": {
+			reviewEvent: "COMMENT",
+			wantPaths:   []string{mergeRequestPath + "/notes"},
+		},
+		"when review event is not supported": {
+			reviewEvent: "DISMISS",
+			wantErr:     "unsupported review event DISMISS",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotPaths []string
+			mergeRequestTarget := mockMergeRequestTarget(t, func(w http.ResponseWriter, r *http.Request) {
+				gotPaths = append(gotPaths, r.URL.EscapedPath())
+
+				w.Write([]byte(`{}`))
+			})
+
+			err := mergeRequestTarget.Review(test.reviewEvent, "Needs tests")
+
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantPaths, gotPaths)
+		})
+	}
+}
+
+func TestGetCheckRuns(t *testing.T) {
+	mergeRequestTarget := mockMergeRequestTarget(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v4/projects/foobar%2Fdefault-mock-repo/repository/commits/abc/statuses", r.URL.EscapedPath())
+
+		w.Write([]byte(`[{"name": "build", "status": "success"}, {"name": "test", "status": "failed"}, {"name": "lint", "status": "running"}]`))
+	})
+
+	gotCheckRuns, err := mergeRequestTarget.GetCheckRuns("abc")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*codehost.CheckRun{
+		{Name: "build", Status: "completed", Conclusion: "success"},
+		{Name: "test", Status: "completed", Conclusion: "failure"},
+		{Name: "lint", Status: "in_progress"},
+	}, gotCheckRuns)
+}
+
+func TestUpdateCheckRun(t *testing.T) {
+	var gotBody map[string]interface{}
+	mergeRequestTarget := mockMergeRequestTarget(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v4/projects/foobar%2Fdefault-mock-repo/statuses/abc", r.URL.EscapedPath())
+		gotBody = readBody(t, r)
+
+		w.Write([]byte(`{"id": 1}`))
+	})
+
+	err := mergeRequestTarget.UpdateCheckRun(1, &codehost.CheckRun{
+		Name:       "reviewpad",
+		Status:     "completed",
+		Conclusion: "failure",
+		Title:      "Reviewpad found issues",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"state": "failed", "name": "reviewpad", "description": "Reviewpad found issues"}, gotBody)
+}
+
+func TestGetReviewThreads(t *testing.T) {
+	mergeRequestTarget := mockMergeRequestTarget(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, mergeRequestPath+"/discussions", r.URL.EscapedPath())
+
+		w.Write([]byte(`[{"id": "a", "notes": [{"id": 1, "resolvable": true, "resolved": true}]}, {"id": "b", "notes": [{"id": 2}]}, {"id": "c", "notes": [{"id": 3, "resolvable": true}]}]`))
+	})
+
+	gotReviewThreads, err := mergeRequestTarget.GetReviewThreads()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*codehost.ReviewThread{{IsResolved: true}, {IsResolved: false}}, gotReviewThreads)
+}
+
+func TestGetAuthenticatedUserLogin(t *testing.T) {
+	mergeRequestTarget := mockMergeRequestTarget(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v4/user", r.URL.EscapedPath())
+
+		w.Write([]byte(`{"id": 4, "username": "reviewpad-bot"}`))
+	})
+
+	gotLogin, err := mergeRequestTarget.GetAuthenticatedUserLogin()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "reviewpad-bot", gotLogin)
+}
+
+func TestGetCloneCredentials(t *testing.T) {
+	mergeRequestTarget := mockMergeRequestTarget(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v4/projects/3", r.URL.EscapedPath())
+
+		w.Write([]byte(`{"id": 3, "http_url_to_repo": "https://gitlab.com/jane/default-mock-repo.git"}`))
+	})
+
+	gotCredentials, err := mergeRequestTarget.GetCloneCredentials()
+
+	assert.Nil(t, err)
+	assert.Equal(t, &codehost.CloneCredentials{URL: "https://gitlab.com/jane/default-mock-repo.git", Token: "token"}, gotCredentials)
+}
+
+func TestIsUpdatedWithBaseBranch(t *testing.T) {
+	tests := map[string]struct {
+		branchSHA string
+		wantVal   bool
+	}{
+		"when the diff starts at the head of the target branch": {
+			branchSHA: "def",
+			wantVal:   true,
+		},
+		"when the target branch has new commits": {
+			branchSHA: "efg",
+			wantVal:   false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mergeRequestTarget := mockMergeRequestTarget(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v4/projects/foobar%2Fdefault-mock-repo/repository/branches/main", r.URL.EscapedPath())
+
+				w.Write([]byte(`{"name": "main", "commit": {"id": "` + test.branchSHA + `"}}`))
+			})
+
+			gotVal, err := mergeRequestTarget.IsUpdatedWithBaseBranch()
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantVal, gotVal)
+		})
+	}
+}
+
+func TestDeleteHeadBranch(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		wantErr string
+	}{
+		"when the branch is deleted": {
+			status: http.StatusNoContent,
+		},
+		"when the branch no longer exists": {
+			status: http.StatusNotFound,
+		},
+		"when the branch is protected": {
+			status:  http.StatusForbidden,
+			wantErr: "gitlab: 403 403 Forbidden",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mergeRequestTarget := mockMergeRequestTarget(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, "/api/v4/projects/foobar%2Fdefault-mock-repo/repository/branches/feature", r.URL.EscapedPath())
+
+				w.WriteHeader(test.status)
+			})
+
+			err := mergeRequestTarget.DeleteHeadBranch()
+
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestGetFirstCommitAndReviewDate(t *testing.T) {
+	mergeRequestTarget := mockMergeRequestTarget(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case mergeRequestPath + "/commits":
+			w.Write([]byte(`[{"id": "abc", "authored_date": "2023-03-02T10:00:00Z"}, {"id": "bcd", "authored_date": "2023-03-01T10:00:00Z"}]`))
+		case mergeRequestPath + "/notes":
+			w.Write([]byte(`[{"id": 2, "system": true, "body": "approved this merge request", "author": {"username": "jane"}, "created_at": "2023-03-04T10:00:00Z"}, {"id": 1, "system": true, "body": "approved this merge request", "author": {"username": "jack"}, "created_at": "2023-03-03T10:00:00Z"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	gotFirstCommitDate, gotFirstReviewDate, err := mergeRequestTarget.GetFirstCommitAndReviewDate()
+
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC), *gotFirstCommitDate)
+	assert.Equal(t, time.Date(2023, 3, 3, 10, 0, 0, 0, time.UTC), *gotFirstReviewDate)
+}
@@ -0,0 +1,99 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package codehost
+
+import (
+	"time"
+
+	pbc "github.com/reviewpad/api/go/codehost"
+)
+
+// Patch is the set of files changed by a pull request, by file name.
+type Patch map[string]*File
+
+type CheckRun struct {
+	ID          int64
+	Name        string
+	Status      string
+	Conclusion  string
+	CompletedAt *time.Time
+	// Title and Summary are the output of the check run.
+	Title   string
+	Summary string
+}
+
+// CloneCredentials are the credentials to clone the head repository of a pull request and push to it.
+type CloneCredentials struct {
+	// URL is the HTTPS URL of the head repository.
+	URL   string
+	Token string
+}
+
+// PullRequest is the target of a pull request of any code host.
+// Builtins should depend on it rather than on the pull request target of a specific code host.
+type PullRequest interface {
+	Target
+
+	GetPullRequest() *pbc.PullRequest
+	GetPatch() Patch
+	GetBase() string
+	GetHead() string
+	IsDraft() bool
+	IsFileBinary(branch, file string) (bool, error)
+	// GetAuthenticatedUserLogin returns the login of the user the code host client acts as.
+	GetAuthenticatedUserLogin() (string, error)
+	GetCloneCredentials() (*CloneCredentials, error)
+
+	// Branches
+	// IsUpdatedWithBaseBranch reports whether the head branch contains the latest commit of the base branch.
+	IsUpdatedWithBaseBranch() (bool, error)
+	HasGitConflicts() (bool, error)
+	// DeleteHeadBranch deletes the head branch, unless it no longer exists.
+	DeleteHeadBranch() error
+
+	// Commits
+	GetCommitCount() int64
+	GetCommits() ([]*Commit, error)
+	GetLastCommit() (string, error)
+	GetPullRequestLastPushDate() (time.Time, error)
+	// GetFirstCommitAndReviewDate returns the authoring date of the first commit and the date of the first review, when they exist.
+	GetFirstCommitAndReviewDate() (*time.Time, *time.Time, error)
+
+	// Reviews
+	GetApprovalsCount() (int, error)
+	GetLatestApprovedReviews() ([]string, error)
+	GetLatestReviewFromReviewer(author string) (*Review, error)
+	GetRequestedReviewers() []*pbc.User
+	GetReviewComments() ([]*ReviewComment, error)
+	GetReviewers() (*Reviewers, error)
+	GetReviews() ([]*Review, error)
+	RequestReviewers(reviewers []string) error
+	RequestTeamReviewers(reviewers []string) error
+	Review(reviewEvent, reviewBody string) error
+	ReviewWithComments(reviewEvent, reviewBody string, comments []*ReviewComment) error
+
+	// Review threads
+	GetReviewThreads() ([]*ReviewThread, error)
+
+	// Checks
+	GetCheckRuns(ref string) ([]*CheckRun, error)
+	// UpdateCheckRun updates the name, status, conclusion and output of the check run.
+	UpdateCheckRun(checkRunID int64, checkRun *CheckRun) error
+
+	// Merge queue
+	// IsMergeQueueEnabled reports whether the pull requests to the base branch are merged by the merge queue of the code host.
+	IsMergeQueueEnabled() (bool, error)
+	// GetMergeQueueEntries returns the numbers of the pull requests in the merge queue of the base branch.
+	GetMergeQueueEntries() ([]int, error)
+	AddToMergeQueue() error
+
+	// Blame
+	// GetBlameAuthors returns the logins of the authors of the files at the commit, the authors of the most lines first.
+	GetBlameAuthors(commitSHA string, filePaths []string) ([]string, error)
+
+	GetLinkedIssuesCount() (int, error)
+	Merge(mergeMethod string) error
+	TriggerWorkflowByFileName(workflowFileName string) error
+}
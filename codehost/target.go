@@ -17,6 +17,12 @@ var (
 	ErrNotSupported = errors.New("not supported on this entity kind")
 )
 
+// Branch is the kind of the targets of pushes to branches.
+const Branch entities.TargetEntityKind = "branch"
+
+// Release is the kind of the targets of releases.
+const Release entities.TargetEntityKind = "release"
+
 type Target interface {
 	AddAssignees(assignees []string) error
 	AddLabels(labels []string) error
@@ -57,6 +63,7 @@ type Team struct {
 }
 
 type Comment struct {
+	ID   int64
 	Body string
 }
 
@@ -73,6 +80,14 @@ type Review struct {
 	SubmittedAt *time.Time
 }
 
+type ReviewComment struct {
+	ID   int64
+	User *User
+	Path string
+	Line int
+	Body string
+}
+
 type Project struct {
 	ID     string
 	Number uint64
@@ -89,6 +104,7 @@ type ProjectField struct {
 }
 
 type Commit struct {
+	SHA          string
 	Message      string
 	ParentsCount int
 }
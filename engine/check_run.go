@@ -0,0 +1,94 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package engine
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/reviewpad/reviewpad/v4/utils"
+)
+
+const (
+	DEFAULT_CHECK_RUN_NAME = "reviewpad-report"
+
+	CHECK_RUN_SEVERITY_ERROR   = "error"
+	CHECK_RUN_SEVERITY_WARNING = "warning"
+	CHECK_RUN_SEVERITY_INFO    = "info"
+)
+
+var defaultCheckRunConclusions = map[string]string{
+	CHECK_RUN_SEVERITY_ERROR:   "failure",
+	CHECK_RUN_SEVERITY_WARNING: "neutral",
+	CHECK_RUN_SEVERITY_INFO:    "success",
+}
+
+var checkRunConclusions = []string{"action_required", "cancelled", "failure", "neutral", "skipped", "success", "timed_out"}
+
+// PadCheckRun configures the check run published with the report of the reported messages.
+type PadCheckRun struct {
+	// Name is the name of the check run, reviewpad-report by default.
+	Name string `yaml:"name"`
+	// Conclusions maps the severities of the reported messages, error, warning and info,
+	// to the conclusion of the check run when they are the most severe messages reported.
+	Conclusions map[string]string `yaml:"conclusions"`
+}
+
+func (c *PadCheckRun) equals(o *PadCheckRun) bool {
+	if c == nil || o == nil {
+		return c == o
+	}
+
+	if c.Name != o.Name || len(c.Conclusions) != len(o.Conclusions) {
+		return false
+	}
+
+	for severity, conclusion := range c.Conclusions {
+		if o.Conclusions[severity] != conclusion {
+			return false
+		}
+	}
+
+	return true
+}
+
+// GetName returns the name of the check run.
+func (c *PadCheckRun) GetName() string {
+	if c.Name == "" {
+		return DEFAULT_CHECK_RUN_NAME
+	}
+
+	return c.Name
+}
+
+// GetConclusion returns the conclusion of the check run when the most severe messages reported have the severity.
+func (c *PadCheckRun) GetConclusion(severity string) string {
+	if conclusion, ok := c.Conclusions[severity]; ok {
+		return conclusion
+	}
+
+	return defaultCheckRunConclusions[severity]
+}
+
+func (c *PadCheckRun) validate() error {
+	severities := make([]string, 0, len(c.Conclusions))
+	for severity := range c.Conclusions {
+		severities = append(severities, severity)
+	}
+	sort.Strings(severities)
+
+	for _, severity := range severities {
+		if _, ok := defaultCheckRunConclusions[severity]; !ok {
+			return fmt.Errorf("invalid check-run severity `%v`: expected `%v`, `%v` or `%v`", severity, CHECK_RUN_SEVERITY_ERROR, CHECK_RUN_SEVERITY_WARNING, CHECK_RUN_SEVERITY_INFO)
+		}
+
+		conclusion := c.Conclusions[severity]
+		if !utils.ElementOf(checkRunConclusions, conclusion) {
+			return fmt.Errorf("invalid check-run conclusion `%v` for severity `%v`", conclusion, severity)
+		}
+	}
+
+	return nil
+}
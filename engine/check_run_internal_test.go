@@ -0,0 +1,111 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_WithCheckRun(t *testing.T) {
+	file, err := parse([]byte(`
+check-run:
+  name: reviewpad-findings
+  conclusions:
+    warning: failure
+`))
+
+	assert.Nil(t, err)
+	assert.Equal(t, &PadCheckRun{
+		Name: "reviewpad-findings",
+		Conclusions: map[string]string{
+			"warning": "failure",
+		},
+	}, file.CheckRun)
+}
+
+func TestPadCheckRun_GetName(t *testing.T) {
+	assert.Equal(t, "reviewpad-report", (&PadCheckRun{}).GetName())
+	assert.Equal(t, "reviewpad-findings", (&PadCheckRun{Name: "reviewpad-findings"}).GetName())
+}
+
+func TestPadCheckRun_GetConclusion(t *testing.T) {
+	checkRun := &PadCheckRun{
+		Conclusions: map[string]string{
+			"warning": "failure",
+		},
+	}
+
+	assert.Equal(t, "failure", checkRun.GetConclusion("error"))
+	assert.Equal(t, "failure", checkRun.GetConclusion("warning"))
+	assert.Equal(t, "success", checkRun.GetConclusion("info"))
+}
+
+func TestLint_WithCheckRun(t *testing.T) {
+	tests := map[string]struct {
+		checkRun *PadCheckRun
+		wantErr  string
+	}{
+		"when check run is valid": {
+			checkRun: &PadCheckRun{
+				Name: "reviewpad-findings",
+				Conclusions: map[string]string{
+					"error":   "action_required",
+					"warning": "neutral",
+					"info":    "skipped",
+				},
+			},
+		},
+		"when severity is invalid": {
+			checkRun: &PadCheckRun{
+				Conclusions: map[string]string{
+					"fatal": "failure",
+				},
+			},
+			wantErr: "invalid check-run severity `fatal`: expected `error`, `warning` or `info`",
+		},
+		"when conclusion is invalid": {
+			checkRun: &PadCheckRun{
+				Conclusions: map[string]string{
+					"error": "failed",
+				},
+			},
+			wantErr: "invalid check-run conclusion `failed` for severity `error`",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := Lint(&ReviewpadFile{CheckRun: test.checkRun}, nil, logrus.NewEntry(logrus.New()))
+
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestReviewpadFile_Extend_WithCheckRun(t *testing.T) {
+	file := &ReviewpadFile{CheckRun: &PadCheckRun{Name: "reviewpad-findings"}}
+
+	file.extend(&ReviewpadFile{})
+	assert.Equal(t, &PadCheckRun{Name: "reviewpad-findings"}, file.CheckRun)
+
+	file.extend(&ReviewpadFile{CheckRun: &PadCheckRun{Name: "findings"}})
+	assert.Equal(t, &PadCheckRun{Name: "findings"}, file.CheckRun)
+}
+
+func TestReviewpadFile_Equals_WithCheckRun(t *testing.T) {
+	file := &ReviewpadFile{CheckRun: &PadCheckRun{Conclusions: map[string]string{"info": "neutral"}}}
+
+	assert.True(t, file.equals(&ReviewpadFile{CheckRun: &PadCheckRun{Conclusions: map[string]string{"info": "neutral"}}}))
+	assert.False(t, file.equals(&ReviewpadFile{CheckRun: &PadCheckRun{Conclusions: map[string]string{"info": "success"}}}))
+	assert.False(t, file.equals(&ReviewpadFile{}))
+}
@@ -42,8 +42,10 @@ type Interpreter interface {
 	ExecProgram(program *Program) (ExitStatus, error)
 	ExecStatement(statement *Statement) error
 	Report(mode string, safeMode bool) error
+	ReportCheckRun(mode string, safeMode bool, checkRun *PadCheckRun) error
 	ReportMetrics() error
 	GetCheckRunConclusion() string
+	SetCheckRun(checkRun *PadCheckRun)
 	ProcessIterable(expr string) (lang.Value, error)
 	StoreTemporaryVariable(name string, value lang.Value)
 	ProcessDictionary(name string, dictionary map[string]string) error
@@ -57,7 +59,10 @@ type Env struct {
 	Interpreter  Interpreter
 	TargetEntity *entities.TargetEntity
 	EventDetails *entities.EventDetails
-	Logger       *logrus.Entry
+	// BatchedEventDetails are the other events of the target evaluated together with EventDetails.
+	// The workflows triggered by any of them are run.
+	BatchedEventDetails []*entities.EventDetails
+	Logger              *logrus.Entry
 }
 
 func NewEvalEnv(
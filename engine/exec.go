@@ -66,6 +66,9 @@ func EvalConfigurationFile(file *ReviewpadFile, env *Env) (*Program, error) {
 		},
 	}).Debugln("reviewpad file")
 
+	// the actions need the check run of the report, e.g. to ignore it when waiting for the checks
+	interpreter.SetCheckRun(file.CheckRun)
+
 	// process labels
 	for labelKeyName, label := range file.Labels {
 		labelName := labelKeyName
@@ -148,15 +151,7 @@ func EvalConfigurationFile(file *ReviewpadFile, env *Env) (*Program, error) {
 			continue
 		}
 
-		shouldRun := false
-		for _, eventKind := range workflow.On {
-			if eventKind == env.TargetEntity.Kind {
-				shouldRun = true
-				break
-			}
-		}
-
-		if !shouldRun {
+		if !workflowShouldRun(workflow, env.TargetEntity.Kind, env.EventDetails, env.BatchedEventDetails) {
 			workflowLog.Infof("skipping workflow because event kind is `%v` and workflow is on `%v`", env.TargetEntity.Kind, workflow.On)
 			continue
 		}
@@ -340,15 +335,7 @@ func ExecConfigurationFile(env *Env, file *ReviewpadFile) (ExitStatus, *Program,
 			continue
 		}
 
-		shouldRun := false
-		for _, eventKind := range workflow.On {
-			if eventKind == env.TargetEntity.Kind {
-				shouldRun = true
-				break
-			}
-		}
-
-		if !shouldRun {
+		if !workflowShouldRun(workflow, env.TargetEntity.Kind, env.EventDetails, env.BatchedEventDetails) {
 			workflowLog.Infof("skipping workflow because event kind is `%v` and workflow is on `%v`", env.TargetEntity.Kind, workflow.On)
 			continue
 		}
@@ -647,6 +647,53 @@ func TestEvalConfigurationFile(t *testing.T) {
 			),
 			targetEntity: engine.DefaultMockTargetEntity,
 		},
+		"when workflow is triggered by event action": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_event_triggers.yml",
+			wantProgram: engine.BuildProgram(
+				[]*engine.Statement{
+					engine.BuildStatement(`$addLabel("opened")`),
+				},
+			),
+			targetEntity: engine.DefaultMockTargetEntity,
+			eventDetails: &entities.EventDetails{
+				EventName:   "pull_request_target",
+				EventAction: "opened",
+			},
+		},
+		"when workflow is triggered by event with matching payload filters": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_event_triggers.yml",
+			wantProgram: engine.BuildProgram(
+				[]*engine.Statement{
+					engine.BuildStatement(`$addLabel("labeled")`),
+				},
+			),
+			targetEntity: engine.DefaultMockTargetEntity,
+			eventDetails: &entities.EventDetails{
+				EventName:   "pull_request",
+				EventAction: "labeled",
+				Payload: &github.PullRequestEvent{
+					Label: &github.Label{
+						Name: github.String("urgent"),
+					},
+				},
+			},
+		},
+		"when workflow is not triggered by event with payload filters": {
+			inputReviewpadFilePath: "testdata/exec/reviewpad_with_event_triggers.yml",
+			wantProgram: engine.BuildProgram(
+				[]*engine.Statement{},
+			),
+			targetEntity: engine.DefaultMockTargetEntity,
+			eventDetails: &entities.EventDetails{
+				EventName:   "pull_request",
+				EventAction: "labeled",
+				Payload: &github.PullRequestEvent{
+					Label: &github.Label{
+						Name: github.String("documentation"),
+					},
+				},
+			},
+		},
 		"when run is a combination": {
 			inputReviewpadFilePath: "testdata/exec/reviewpad_with_combination_run.yml",
 			wantProgram: engine.BuildProgram(
@@ -655,7 +702,7 @@ func TestEvalConfigurationFile(t *testing.T) {
 					engine.BuildStatement(`$addLabel("label")`),
 					engine.BuildStatement(`$removeLabel("remove-label")`),
 					engine.BuildStatement(`$assignRandomReviewer()`),
-					engine.BuildStatement(`$info("reviewpad supports nested conditions")`),
+					engine.BuildStatement(`$info("reviewpad supports nested conditions", "", 0)`),
 					engine.BuildStatement(`$comment("combination-run-2")`),
 					engine.BuildStatement(`$addLabel("bug")`),
 					engine.BuildStatement(`$addLabel("documentation")`),
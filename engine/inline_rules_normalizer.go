@@ -30,6 +30,8 @@ func inlineModificator(file *ReviewpadFile) (*ReviewpadFile, error) {
 		Pipelines:      file.Pipelines,
 		Recipes:        file.Recipes,
 		Dictionaries:   file.Dictionaries,
+		Schedule:       file.Schedule,
+		CheckRun:       file.CheckRun,
 	}
 
 	for i, workflow := range reviewpadFile.Workflows {
@@ -68,6 +70,15 @@ func processWorkflow(workflow PadWorkflow, currentRules []PadRule) (*PadWorkflow
 		On:          workflow.On,
 	}
 
+	if workflow.NonNormalizedOn != nil {
+		on, err := normalizeOn(workflow.NonNormalizedOn)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		wf.On = on
+	}
+
 	runs, runRules, err := normalizeRun(workflow.NonNormalizedRun, currentRules)
 	if err != nil {
 		return nil, nil, err
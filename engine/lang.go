@@ -6,8 +6,6 @@ package engine
 
 import (
 	"reflect"
-
-	"github.com/reviewpad/go-lib/entities"
 )
 
 const (
@@ -100,17 +98,18 @@ func (p PadLabel) equals(o PadLabel) bool {
 }
 
 type PadWorkflow struct {
-	Name                 string                      `yaml:"name"`
-	On                   []entities.TargetEntityKind `yaml:"on"`
-	Description          string                      `yaml:"description"`
-	AlwaysRun            bool                        `yaml:"always-run"`
-	Rules                []PadWorkflowRule           `yaml:"-"`
-	Actions              []string                    `yaml:"-"`
-	Runs                 []PadWorkflowRunBlock       `yaml:"-"`
-	NonNormalizedRules   any                         `yaml:"if"`
-	NonNormalizedActions any                         `yaml:"then"`
-	NonNormalizedElse    any                         `yaml:"else"`
-	NonNormalizedRun     any                         `yaml:"run"`
+	Name                 string                `yaml:"name"`
+	On                   []PadWorkflowTrigger  `yaml:"-"`
+	Description          string                `yaml:"description"`
+	AlwaysRun            bool                  `yaml:"always-run"`
+	Rules                []PadWorkflowRule     `yaml:"-"`
+	Actions              []string              `yaml:"-"`
+	Runs                 []PadWorkflowRunBlock `yaml:"-"`
+	NonNormalizedOn      any                   `yaml:"on"`
+	NonNormalizedRules   any                   `yaml:"if"`
+	NonNormalizedActions any                   `yaml:"then"`
+	NonNormalizedElse    any                   `yaml:"else"`
+	NonNormalizedRun     any                   `yaml:"run"`
 }
 
 type PadWorkflowRunBlock struct {
@@ -139,7 +138,7 @@ func (p PadWorkflow) equals(o PadWorkflow) bool {
 
 	for i, pO := range p.On {
 		oO := o.On[i]
-		if pO != oO {
+		if !pO.equals(oO) {
 			return false
 		}
 	}
@@ -228,6 +227,8 @@ type ReviewpadFile struct {
 	Pipelines      []PadPipeline       `yaml:"pipelines"`
 	Recipes        map[string]*bool    `yaml:"recipes"`
 	Dictionaries   []PadDictionary     `yaml:"dictionaries"`
+	Schedule       *PadSchedule        `yaml:"schedule"`
+	CheckRun       *PadCheckRun        `yaml:"check-run"`
 }
 
 type PadDictionary struct {
@@ -388,6 +389,14 @@ func (r *ReviewpadFile) equals(o *ReviewpadFile) bool {
 		}
 	}
 
+	if !r.Schedule.equals(o.Schedule) {
+		return false
+	}
+
+	if !r.CheckRun.equals(o.CheckRun) {
+		return false
+	}
+
 	return reflect.DeepEqual(r.Recipes, o.Recipes)
 }
 
@@ -484,6 +493,14 @@ func (r *ReviewpadFile) extend(o *ReviewpadFile) {
 		r.MetricsOnMerge = o.MetricsOnMerge
 	}
 
+	if o.Schedule != nil {
+		r.Schedule = o.Schedule
+	}
+
+	if o.CheckRun != nil {
+		r.CheckRun = o.CheckRun
+	}
+
 	r.appendLabels(o)
 	r.appendGroups(o)
 	r.appendRules(o)
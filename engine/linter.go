@@ -131,6 +131,12 @@ func lintWorkflows(log *logrus.Entry, rules []PadRule, padWorkflows []PadWorkflo
 			}
 		}
 
+		for _, trigger := range workflow.On {
+			if err := trigger.validate(); err != nil {
+				return fmt.Errorf("workflow `%v` has an invalid trigger: %v", workflow.Name, err)
+			}
+		}
+
 		for _, rule := range workflow.Rules {
 			ruleName := rule.Rule
 			if ruleName == "" {
@@ -404,5 +410,19 @@ func Lint(file *ReviewpadFile, reserved []string, logger *logrus.Entry) error {
 		return err
 	}
 
+	if file.Schedule != nil {
+		err = file.Schedule.validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	if file.CheckRun != nil {
+		err = file.CheckRun.validate()
+		if err != nil {
+			return err
+		}
+	}
+
 	return lintGroupsMentions(file.Groups, file.Rules, file.Workflows)
 }
@@ -207,7 +207,7 @@ func transform(file *ReviewpadFile) *ReviewpadFile {
 			transformRunBlock(run, transformAladinoExpression)
 		}
 
-		transformedOn := []entities.TargetEntityKind{entities.PullRequest}
+		transformedOn := []PadWorkflowTrigger{{Event: entities.PullRequest.String()}}
 		if len(workflow.On) > 0 {
 			transformedOn = workflow.On
 		}
@@ -259,6 +259,8 @@ func transform(file *ReviewpadFile) *ReviewpadFile {
 		Pipelines:      transformedPipelines,
 		Recipes:        file.Recipes,
 		Dictionaries:   file.Dictionaries,
+		Schedule:       file.Schedule,
+		CheckRun:       file.CheckRun,
 	}
 }
 
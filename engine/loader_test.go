@@ -14,7 +14,6 @@ import (
 	"github.com/google/go-github/v52/github"
 	"github.com/jarcoal/httpmock"
 	"github.com/migueleliasweb/go-github-mock/src/mock"
-	"github.com/reviewpad/go-lib/entities"
 	gh "github.com/reviewpad/reviewpad/v4/codehost/github"
 	"github.com/reviewpad/reviewpad/v4/engine"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
@@ -151,8 +150,8 @@ func TestLoadWithAST(t *testing.T) {
 			{
 				Name:      "add-label-with-medium-size",
 				AlwaysRun: false,
-				On: []entities.TargetEntityKind{
-					"pull_request",
+				On: []engine.PadWorkflowTrigger{
+					{Event: "pull_request"},
 				},
 				Runs: []engine.PadWorkflowRunBlock{
 					{
@@ -174,8 +173,8 @@ func TestLoadWithAST(t *testing.T) {
 			{
 				Name:      "info-owners",
 				AlwaysRun: false,
-				On: []entities.TargetEntityKind{
-					"pull_request",
+				On: []engine.PadWorkflowTrigger{
+					{Event: "pull_request"},
 				},
 				Runs: []engine.PadWorkflowRunBlock{
 					{
@@ -187,7 +186,7 @@ func TestLoadWithAST(t *testing.T) {
 						Then: []engine.PadWorkflowRunBlock{
 							{
 								Actions: []string{
-									"$info(\"bob has authored a PR\")",
+									"$info(\"bob has authored a PR\", \"\", 0)",
 								},
 							},
 						},
@@ -197,8 +196,8 @@ func TestLoadWithAST(t *testing.T) {
 			{
 				Name:      "check-title",
 				AlwaysRun: false,
-				On: []entities.TargetEntityKind{
-					"pull_request",
+				On: []engine.PadWorkflowTrigger{
+					{Event: "pull_request"},
 				},
 				Runs: []engine.PadWorkflowRunBlock{
 					{
@@ -220,8 +219,8 @@ func TestLoadWithAST(t *testing.T) {
 			{
 				Name:      "add-label-with-small-size",
 				AlwaysRun: false,
-				On: []entities.TargetEntityKind{
-					"pull_request",
+				On: []engine.PadWorkflowTrigger{
+					{Event: "pull_request"},
 				},
 				Runs: []engine.PadWorkflowRunBlock{
 					{
@@ -0,0 +1,92 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package engine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/reviewpad/go-lib/entities"
+)
+
+// PadSchedule scopes the pull requests and issues evaluated on schedule events.
+type PadSchedule struct {
+	// Kind is either pull_request or issue. Both are evaluated when empty.
+	Kind string `yaml:"kind"`
+	// Labels are the labels that the pull requests and issues must all have.
+	Labels []string `yaml:"labels"`
+	// UpdatedBefore is how long ago the pull requests and issues must have been last updated, e.g. 30d, 2w or 12h.
+	UpdatedBefore string `yaml:"updated-before"`
+	// MaxTargets is the maximum number of pull requests and issues evaluated, the least recently updated first.
+	MaxTargets int `yaml:"max-targets"`
+}
+
+func (s *PadSchedule) equals(o *PadSchedule) bool {
+	if s == nil || o == nil {
+		return s == o
+	}
+
+	if s.Kind != o.Kind || s.UpdatedBefore != o.UpdatedBefore || s.MaxTargets != o.MaxTargets {
+		return false
+	}
+
+	if len(s.Labels) != len(o.Labels) {
+		return false
+	}
+
+	for i, label := range s.Labels {
+		if label != o.Labels[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// UpdatedBeforeDuration returns the duration of UpdatedBefore or zero when it is empty.
+func (s *PadSchedule) UpdatedBeforeDuration() (time.Duration, error) {
+	if s.UpdatedBefore == "" {
+		return 0, nil
+	}
+
+	// Days and weeks are not supported by time.ParseDuration.
+	units := map[string]time.Duration{
+		"d": 24 * time.Hour,
+		"w": 7 * 24 * time.Hour,
+	}
+
+	for suffix, unit := range units {
+		if value, ok := strings.CutSuffix(s.UpdatedBefore, suffix); ok {
+			amount, err := strconv.Atoi(value)
+			if err != nil || amount < 0 {
+				return 0, fmt.Errorf("invalid schedule updated-before `%v`", s.UpdatedBefore)
+			}
+
+			return time.Duration(amount) * unit, nil
+		}
+	}
+
+	duration, err := time.ParseDuration(s.UpdatedBefore)
+	if err != nil || duration < 0 {
+		return 0, fmt.Errorf("invalid schedule updated-before `%v`", s.UpdatedBefore)
+	}
+
+	return duration, nil
+}
+
+func (s *PadSchedule) validate() error {
+	if s.Kind != "" && s.Kind != entities.PullRequest.String() && s.Kind != entities.Issue.String() {
+		return fmt.Errorf("invalid schedule kind `%v`: expected `%v` or `%v`", s.Kind, entities.PullRequest, entities.Issue)
+	}
+
+	if s.MaxTargets < 0 {
+		return fmt.Errorf("invalid schedule max-targets `%v`", s.MaxTargets)
+	}
+
+	_, err := s.UpdatedBeforeDuration()
+	return err
+}
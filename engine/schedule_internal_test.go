@@ -0,0 +1,136 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_WithSchedule(t *testing.T) {
+	file, err := parse([]byte(`
+schedule:
+  kind: pull_request
+  labels:
+    - stale
+  updated-before: 30d
+  max-targets: 50
+`))
+
+	assert.Nil(t, err)
+	assert.Equal(t, &PadSchedule{
+		Kind:          "pull_request",
+		Labels:        []string{"stale"},
+		UpdatedBefore: "30d",
+		MaxTargets:    50,
+	}, file.Schedule)
+}
+
+func TestPadSchedule_UpdatedBeforeDuration(t *testing.T) {
+	tests := map[string]struct {
+		updatedBefore string
+		wantDuration  time.Duration
+		wantErr       string
+	}{
+		"when empty": {
+			wantDuration: 0,
+		},
+		"when in days": {
+			updatedBefore: "30d",
+			wantDuration:  30 * 24 * time.Hour,
+		},
+		"when in weeks": {
+			updatedBefore: "2w",
+			wantDuration:  14 * 24 * time.Hour,
+		},
+		"when in hours": {
+			updatedBefore: "12h",
+			wantDuration:  12 * time.Hour,
+		},
+		"when invalid": {
+			updatedBefore: "a month",
+			wantErr:       "invalid schedule updated-before `a month`",
+		},
+		"when negative": {
+			updatedBefore: "-1d",
+			wantErr:       "invalid schedule updated-before `-1d`",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			schedule := &PadSchedule{UpdatedBefore: test.updatedBefore}
+
+			gotDuration, err := schedule.UpdatedBeforeDuration()
+
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantDuration, gotDuration)
+		})
+	}
+}
+
+func TestLint_WithSchedule(t *testing.T) {
+	tests := map[string]struct {
+		schedule *PadSchedule
+		wantErr  string
+	}{
+		"when schedule is valid": {
+			schedule: &PadSchedule{
+				Kind:          "issue",
+				UpdatedBefore: "1w",
+				MaxTargets:    10,
+			},
+		},
+		"when kind is invalid": {
+			schedule: &PadSchedule{
+				Kind: "discussion",
+			},
+			wantErr: "invalid schedule kind `discussion`: expected `pull_request` or `issue`",
+		},
+		"when max targets is negative": {
+			schedule: &PadSchedule{
+				MaxTargets: -1,
+			},
+			wantErr: "invalid schedule max-targets `-1`",
+		},
+		"when updated before is invalid": {
+			schedule: &PadSchedule{
+				UpdatedBefore: "yesterday",
+			},
+			wantErr: "invalid schedule updated-before `yesterday`",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := Lint(&ReviewpadFile{Schedule: test.schedule}, nil, logrus.NewEntry(logrus.New()))
+
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestReviewpadFile_Extend_WithSchedule(t *testing.T) {
+	file := &ReviewpadFile{Schedule: &PadSchedule{Kind: "issue"}}
+
+	file.extend(&ReviewpadFile{})
+	assert.Equal(t, &PadSchedule{Kind: "issue"}, file.Schedule)
+
+	file.extend(&ReviewpadFile{Schedule: &PadSchedule{MaxTargets: 5}})
+	assert.Equal(t, &PadSchedule{MaxTargets: 5}, file.Schedule)
+}
@@ -37,6 +37,10 @@ func addDefaultMergeMethod(str string) string {
 	return strings.ReplaceAll(str, "$merge()", "$merge(\"merge\")")
 }
 
+func addDefaultMergeQueueMethod(str string) string {
+	return strings.ReplaceAll(str, "$mergeQueue()", "$mergeQueue(\"merge\")")
+}
+
 func addDefaultSizeMethod(str string) string {
 	return strings.ReplaceAll(str, "$size()", "$size([])")
 }
@@ -149,6 +153,115 @@ func addEmptyFilterToHasCodeWithoutSemanticChanges(str string) string {
 	return strings.ReplaceAll(str, "$hasCodeWithoutSemanticChanges()", "$hasCodeWithoutSemanticChanges([])")
 }
 
+func addEmptyLockReason(str string) string {
+	return strings.ReplaceAll(str, "$lock()", "$lock(\"\")")
+}
+
+func addDefaultsToGenerateReleaseNotes(str string) string {
+	str = strings.ReplaceAll(str, "$generateReleaseNotes()", `$generateReleaseNotes("")`)
+	r := regexp.MustCompile(`\$generateReleaseNotes\(((?:"[^"]*")|(?:\$\w+))\)`)
+	str = r.ReplaceAllString(str, `$$generateReleaseNotes($1, "type")`)
+	r = regexp.MustCompile(`\$generateReleaseNotes\(((?:"[^"]*")|(?:\$\w+)),\s*((?:"[^"]*")|(?:\$\w+))\)`)
+	return r.ReplaceAllString(str, `$$generateReleaseNotes($1, $2, "")`)
+}
+
+func addDefaultsToUpdateBranch(str string) string {
+	str = strings.ReplaceAll(str, "$updateBranch()", `$updateBranch("merge")`)
+	r := regexp.MustCompile(`\$updateBranch\(((?:"[^"]*")|(?:\$\w+))\)`)
+	return r.ReplaceAllString(str, `$$updateBranch($1, false)`)
+}
+
+// addDefaultLocationToReportedMessages adds an empty path and line to the messages reported by
+// $error, $warn and $info that are not reported on a line of a file.
+// The arguments can be calls with commas of their own, so they are scanned rather than matched.
+func addDefaultLocationToReportedMessages(str string) string {
+	for _, action := range []string{"$error(", "$warn(", "$info("} {
+		for i := indexOutsideStringLiterals(str, action, 0); i != -1; {
+			argsStart := i + len(action)
+			argsEnd, numArgs := scanCallArgs(str, argsStart)
+			if argsEnd != -1 && numArgs == 1 {
+				str = str[:argsEnd] + `, "", 0` + str[argsEnd:]
+			}
+
+			i = indexOutsideStringLiterals(str, action, argsStart)
+		}
+	}
+
+	return str
+}
+
+// indexOutsideStringLiterals returns the index of the first occurrence of substr at or after from
+// that is not inside a string literal, or -1 when there is none.
+func indexOutsideStringLiterals(str, substr string, from int) int {
+	inString := false
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+
+		if inString {
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				inString = false
+			}
+			continue
+		}
+
+		if i >= from && strings.HasPrefix(str[i:], substr) {
+			return i
+		}
+
+		if c == '"' {
+			inString = true
+		}
+	}
+
+	return -1
+}
+
+// scanCallArgs returns the index of the closing parenthesis of the call whose arguments start at start
+// and the number of arguments of the call, or -1 when the call is not closed.
+func scanCallArgs(str string, start int) (int, int) {
+	depth := 0
+	numArgs := 0
+	inString := false
+	for i := start; i < len(str); i++ {
+		c := str[i]
+
+		if inString {
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				inString = false
+			}
+			continue
+		}
+
+		if numArgs == 0 && !strings.ContainsRune(" \t\n)", rune(c)) {
+			numArgs = 1
+		}
+
+		switch c {
+		case '"':
+			inString = true
+		case '(', '[':
+			depth++
+		case ']':
+			depth--
+		case ')':
+			if depth == 0 {
+				return i, numArgs
+			}
+			depth--
+		case ',':
+			if depth == 0 {
+				numArgs++
+			}
+		}
+	}
+
+	return -1, numArgs
+}
+
 func summarizeAlias(str string) string {
 	return strings.ReplaceAll(str, "$summarize()", `$robinSummarize("default", "openai-gpt-4")`)
 }
@@ -159,6 +272,7 @@ func transformAladinoExpression(str string) string {
 	var transformations = [](func(str string) string){
 		addDefaultsToRequestedReviewers,
 		addDefaultMergeMethod,
+		addDefaultMergeQueueMethod,
 		addDefaultSizeMethod,
 		addDefaultIssueCountBy,
 		addDefaultPullRequestCountBy,
@@ -171,7 +285,11 @@ func transformAladinoExpression(str string) string {
 		addDefaultHasAnyCheckRunCompleted,
 		addDefaultsToRequestedAssignees,
 		addEmptyFilterToHasCodeWithoutSemanticChanges,
+		addEmptyLockReason,
+		addDefaultsToGenerateReleaseNotes,
+		addDefaultsToUpdateBranch,
 		summarizeAlias,
+		addDefaultLocationToReportedMessages,
 	}
 
 	for i := range transformations {
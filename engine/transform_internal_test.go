@@ -431,6 +431,86 @@ func TestTransformAladinoExpression(t *testing.T) {
 			arg:     `$assignReviewer(["john", "jane"], $maxReviewers, $strategy)`,
 			wantVal: `$assignReviewer(["john", "jane"], $maxReviewers, $strategy)`,
 		},
+		"lock": {
+			arg:     `$lock()`,
+			wantVal: `$lock("")`,
+		},
+		"lock with reason": {
+			arg:     `$lock("resolved")`,
+			wantVal: `$lock("resolved")`,
+		},
+		"generateReleaseNotes": {
+			arg:     `$generateReleaseNotes()`,
+			wantVal: `$generateReleaseNotes("", "type", "")`,
+		},
+		"generateReleaseNotes with previous tag": {
+			arg:     `$generateReleaseNotes("v1.0.0")`,
+			wantVal: `$generateReleaseNotes("v1.0.0", "type", "")`,
+		},
+		"generateReleaseNotes with previous tag and group by": {
+			arg:     `$generateReleaseNotes("v1.0.0", "label")`,
+			wantVal: `$generateReleaseNotes("v1.0.0", "label", "")`,
+		},
+		"generateReleaseNotes with variables": {
+			arg:     `$generateReleaseNotes($previousTag, $groupBy)`,
+			wantVal: `$generateReleaseNotes($previousTag, $groupBy, "")`,
+		},
+		"generateReleaseNotes with all arguments": {
+			arg:     `$generateReleaseNotes("v1.0.0", "label", $template)`,
+			wantVal: `$generateReleaseNotes("v1.0.0", "label", $template)`,
+		},
+		"mergeQueue": {
+			arg:     `$mergeQueue()`,
+			wantVal: `$mergeQueue("merge")`,
+		},
+		"mergeQueue with merge method": {
+			arg:     `$mergeQueue("squash")`,
+			wantVal: `$mergeQueue("squash")`,
+		},
+		"updateBranch": {
+			arg:     `$updateBranch()`,
+			wantVal: `$updateBranch("merge", false)`,
+		},
+		"updateBranch with strategy": {
+			arg:     `$updateBranch("rebase")`,
+			wantVal: `$updateBranch("rebase", false)`,
+		},
+		"updateBranch with all arguments": {
+			arg:     `$updateBranch($strategy, true)`,
+			wantVal: `$updateBranch($strategy, true)`,
+		},
+		"error": {
+			arg:     `$error("message")`,
+			wantVal: `$error("message", "", 0)`,
+		},
+		"error with location": {
+			arg:     `$error("message", "main.go", 10)`,
+			wantVal: `$error("message", "main.go", 10)`,
+		},
+		"warn with call argument": {
+			arg:     `$warn($sprintf("%s, (%s)", [$author(), "(,"]))`,
+			wantVal: `$warn($sprintf("%s, (%s)", [$author(), "(,"]), "", 0)`,
+		},
+		"info in condition": {
+			arg:     `$info("first") && $info("second")`,
+			wantVal: `$info("first", "", 0) && $info("second", "", 0)`,
+		},
+		"info with escaped quote": {
+			arg:     `$info("say \"hi\")")`,
+			wantVal: `$info("say \"hi\")", "", 0)`,
+		},
+		"error in string literal": {
+			arg:     `$comment("use $error(\"message\") to fail")`,
+			wantVal: `$comment("use $error(\"message\") to fail")`,
+		},
+		"info after string literal with info": {
+			arg:     `$info("$info(") && $info("second")`,
+			wantVal: `$info("$info(", "", 0) && $info("second", "", 0)`,
+		},
+		"errorMsg is not error": {
+			arg:     `$errorMsg("message")`,
+			wantVal: `$errorMsg("message")`,
+		},
 		// TODO: test addDefaultTotalRequestedReviewers
 	}
 
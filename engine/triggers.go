@@ -0,0 +1,288 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/utils"
+)
+
+// PadWorkflowTrigger is an event that triggers a workflow.
+// The event is either a target kind (e.g. pull_request, branch or release), which matches every event on that kind,
+// or an event name optionally followed by an action (e.g. pull_request.opened or issue_comment).
+// Filters are matched against the event payload, e.g. `label.name: bug`.
+type PadWorkflowTrigger struct {
+	Event   string         `mapstructure:"event"`
+	Filters map[string]any `mapstructure:"filters"`
+}
+
+// knownEvents maps the events that can trigger a workflow to their known actions.
+// Events with nil actions accept any action, e.g. the event types chosen by the sender of repository_dispatch events.
+var knownEvents = map[string][]string{
+	"check_run":                   {"completed", "created", "requested_action", "rerequested"},
+	"check_suite":                 {"completed", "requested", "rerequested"},
+	"discussion":                  {"answered", "category_changed", "closed", "created", "deleted", "edited", "labeled", "locked", "pinned", "reopened", "transferred", "unanswered", "unlabeled", "unlocked", "unpinned"},
+	"discussion_comment":          {"created", "deleted", "edited"},
+	"issue_comment":               {"created", "deleted", "edited"},
+	"issues":                      {"assigned", "closed", "deleted", "demilestoned", "edited", "labeled", "locked", "milestoned", "opened", "pinned", "reopened", "transferred", "unassigned", "unlabeled", "unlocked", "unpinned"},
+	"label":                       {"created", "deleted", "edited"},
+	"milestone":                   {"closed", "created", "deleted", "edited", "opened"},
+	"pull_request":                {"assigned", "auto_merge_disabled", "auto_merge_enabled", "closed", "converted_to_draft", "demilestoned", "dequeued", "edited", "enqueued", "labeled", "locked", "milestoned", "opened", "ready_for_review", "reopened", "review_request_removed", "review_requested", "synchronize", "unassigned", "unlabeled", "unlocked"},
+	"pull_request_review":         {"dismissed", "edited", "submitted"},
+	"pull_request_review_comment": {"created", "deleted", "edited"},
+	"pull_request_review_thread":  {"resolved", "unresolved"},
+	"push":                        {},
+	"release":                     {"created", "deleted", "edited", "prereleased", "published", "released", "unpublished"},
+	"repository_dispatch":         nil,
+	"schedule":                    {},
+	"status":                      {},
+	"workflow_dispatch":           {},
+	"workflow_run":                {"completed", "in_progress", "requested"},
+}
+
+// eventAliases are the event names that are handled as another event.
+// Reviewpad usually runs on pull_request_target to have access to secrets on forks.
+var eventAliases = map[string]string{
+	"pull_request_target": "pull_request",
+}
+
+func (t PadWorkflowTrigger) equals(o PadWorkflowTrigger) bool {
+	return t.Event == o.Event && reflect.DeepEqual(t.Filters, o.Filters)
+}
+
+func (t PadWorkflowTrigger) String() string {
+	if len(t.Filters) == 0 {
+		return t.Event
+	}
+
+	return fmt.Sprintf("%s %v", t.Event, t.Filters)
+}
+
+// EventName returns the name of the event without the action, e.g. pull_request for pull_request.opened.
+func (t PadWorkflowTrigger) EventName() string {
+	name, _, _ := strings.Cut(t.Event, ".")
+	return name
+}
+
+// EventAction returns the action of the event, e.g. opened for pull_request.opened.
+func (t PadWorkflowTrigger) EventAction() string {
+	_, action, _ := strings.Cut(t.Event, ".")
+	return action
+}
+
+// isTargetKind reports whether the trigger matches every event on a target kind.
+func (t PadWorkflowTrigger) isTargetKind() bool {
+	if t.EventAction() != "" || len(t.Filters) > 0 {
+		return false
+	}
+
+	kind := entities.TargetEntityKind(t.Event)
+	return kind == entities.PullRequest || kind == entities.Issue || kind == entities.Discussion || kind == codehost.Branch || kind == codehost.Release
+}
+
+// Matches reports whether the trigger matches the event on the target kind.
+func (t PadWorkflowTrigger) Matches(kind entities.TargetEntityKind, eventDetails *entities.EventDetails) bool {
+	if t.isTargetKind() {
+		return entities.TargetEntityKind(t.Event) == kind
+	}
+
+	// Backwards compatibility: `issue` was used as the event of issues.
+	eventName := t.EventName()
+	if eventName == entities.Issue.String() {
+		eventName = "issues"
+	}
+
+	if eventDetails == nil || normalizeEventName(eventDetails.EventName) != eventName {
+		return false
+	}
+
+	if action := t.EventAction(); action != "" && action != eventDetails.EventAction {
+		return false
+	}
+
+	return matchesPayloadFilters(t.Filters, eventDetails.Payload)
+}
+
+func (t PadWorkflowTrigger) validate() error {
+	if t.isTargetKind() {
+		return nil
+	}
+
+	eventName := t.EventName()
+	if eventName == entities.Issue.String() {
+		eventName = "issues"
+	}
+
+	actions, ok := knownEvents[normalizeEventName(eventName)]
+	if !ok {
+		return fmt.Errorf("unknown event `%v`", t.EventName())
+	}
+
+	action := t.EventAction()
+	if action == "" || actions == nil {
+		return nil
+	}
+
+	for _, knownAction := range actions {
+		if knownAction == action {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown action `%v` for event `%v`", action, t.EventName())
+}
+
+func normalizeEventName(eventName string) string {
+	if alias, ok := eventAliases[eventName]; ok {
+		return alias
+	}
+	return eventName
+}
+
+// matchesPayloadFilters checks every filter against the payload.
+// The filter keys are dot separated paths in the JSON representation of the payload
+// and the filter values are either a value or a list of accepted values.
+func matchesPayloadFilters(filters map[string]any, payload interface{}) bool {
+	if len(filters) == 0 {
+		return true
+	}
+
+	if payload == nil {
+		return false
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return false
+	}
+
+	var jsonPayload any
+	if err := json.Unmarshal(data, &jsonPayload); err != nil {
+		return false
+	}
+
+	for path, expected := range filters {
+		value, ok := lookupJSONPath(jsonPayload, path)
+		if !ok || !matchesFilterValue(value, expected) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// lookupJSONPath returns the value at the path.
+// When the path goes through a list, the rest of the path is looked up in each element of the list.
+func lookupJSONPath(value any, path string) (any, bool) {
+	key, rest, hasRest := strings.Cut(path, ".")
+
+	switch v := value.(type) {
+	case map[string]any:
+		field, ok := v[key]
+		if !ok {
+			return nil, false
+		}
+
+		if !hasRest {
+			return field, true
+		}
+
+		return lookupJSONPath(field, rest)
+	case []any:
+		values := make([]any, 0, len(v))
+		for _, element := range v {
+			if elementValue, ok := lookupJSONPath(element, path); ok {
+				values = append(values, elementValue)
+			}
+		}
+
+		return values, len(values) > 0
+	default:
+		return nil, false
+	}
+}
+
+func matchesFilterValue(value any, expected any) bool {
+	if expectedValues, ok := expected.([]any); ok {
+		for _, expectedValue := range expectedValues {
+			if matchesFilterValue(value, expectedValue) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Lists in the payload, e.g. labels, match when one of the elements matches.
+	if values, ok := value.([]any); ok {
+		for _, v := range values {
+			if matchesFilterValue(v, expected) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return fmt.Sprint(value) == fmt.Sprint(expected)
+}
+
+// normalizeOn converts the `on` property of a workflow into triggers.
+// Each trigger is either a string with the event or a map with the event and its filters.
+func normalizeOn(rawOn any) ([]PadWorkflowTrigger, error) {
+	if rawOn == nil {
+		return nil, nil
+	}
+
+	rawTriggers, ok := rawOn.([]any)
+	if !ok {
+		rawTriggers = []any{rawOn}
+	}
+
+	triggers := make([]PadWorkflowTrigger, 0, len(rawTriggers))
+	for _, rawTrigger := range rawTriggers {
+		switch trigger := rawTrigger.(type) {
+		case string:
+			triggers = append(triggers, PadWorkflowTrigger{Event: trigger})
+		case map[string]any:
+			padTrigger := PadWorkflowTrigger{}
+			if err := mapstructure.Decode(trigger, &padTrigger); err != nil {
+				return nil, err
+			}
+
+			if padTrigger.Event == "" {
+				return nil, fmt.Errorf("workflow trigger is missing the event")
+			}
+
+			triggers = append(triggers, padTrigger)
+		default:
+			return nil, fmt.Errorf("unknown workflow trigger type %T", rawTrigger)
+		}
+	}
+
+	return triggers, nil
+}
+
+func workflowShouldRun(workflow PadWorkflow, kind entities.TargetEntityKind, eventDetails *entities.EventDetails, batchedEventDetails []*entities.EventDetails) bool {
+	// Dispatch events can request a workflow to run regardless of its triggers.
+	if dispatchedWorkflow := utils.GetDispatchedWorkflow(eventDetails); dispatchedWorkflow != "" {
+		return workflow.Name == dispatchedWorkflow
+	}
+
+	for _, details := range append([]*entities.EventDetails{eventDetails}, batchedEventDetails...) {
+		for _, trigger := range workflow.On {
+			if trigger.Matches(kind, details) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,430 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeOn(t *testing.T) {
+	tests := map[string]struct {
+		on           any
+		wantTriggers []PadWorkflowTrigger
+		wantErr      error
+	}{
+		"when on is empty": {
+			on: nil,
+		},
+		"when on is a single event": {
+			on: "pull_request.opened",
+			wantTriggers: []PadWorkflowTrigger{
+				{Event: "pull_request.opened"},
+			},
+		},
+		"when on is a list of events with filters": {
+			on: []any{
+				"issue",
+				map[string]any{
+					"event": "issue_comment.created",
+					"filters": map[string]any{
+						"comment.user.login": "john",
+					},
+				},
+			},
+			wantTriggers: []PadWorkflowTrigger{
+				{Event: "issue"},
+				{
+					Event: "issue_comment.created",
+					Filters: map[string]any{
+						"comment.user.login": "john",
+					},
+				},
+			},
+		},
+		"when trigger has no event": {
+			on: []any{
+				map[string]any{
+					"filters": map[string]any{
+						"action": "opened",
+					},
+				},
+			},
+			wantErr: errors.New("workflow trigger is missing the event"),
+		},
+		"when trigger has an unknown type": {
+			on:      []any{1},
+			wantErr: errors.New("unknown workflow trigger type int"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotTriggers, gotErr := normalizeOn(test.on)
+
+			assert.Equal(t, test.wantErr, gotErr)
+			assert.Equal(t, test.wantTriggers, gotTriggers)
+		})
+	}
+}
+
+func TestPadWorkflowTrigger_Matches(t *testing.T) {
+	labeledEvent := &entities.EventDetails{
+		EventName:   "pull_request",
+		EventAction: "labeled",
+		Payload: &github.PullRequestEvent{
+			Label: &github.Label{
+				Name: github.String("bug"),
+			},
+			PullRequest: &github.PullRequest{
+				Labels: []*github.Label{
+					{Name: github.String("bug")},
+					{Name: github.String("critical")},
+				},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		trigger      PadWorkflowTrigger
+		kind         entities.TargetEntityKind
+		eventDetails *entities.EventDetails
+		wantMatch    bool
+	}{
+		"when trigger is the target kind": {
+			trigger:      PadWorkflowTrigger{Event: "pull_request"},
+			kind:         entities.PullRequest,
+			eventDetails: labeledEvent,
+			wantMatch:    true,
+		},
+		"when trigger is another target kind": {
+			trigger:      PadWorkflowTrigger{Event: "issue"},
+			kind:         entities.PullRequest,
+			eventDetails: labeledEvent,
+			wantMatch:    false,
+		},
+		"when trigger matches the event action": {
+			trigger:      PadWorkflowTrigger{Event: "pull_request.labeled"},
+			kind:         entities.PullRequest,
+			eventDetails: labeledEvent,
+			wantMatch:    true,
+		},
+		"when trigger does not match the event action": {
+			trigger:      PadWorkflowTrigger{Event: "pull_request.opened"},
+			kind:         entities.PullRequest,
+			eventDetails: labeledEvent,
+			wantMatch:    false,
+		},
+		"when trigger matches the cron of a schedule event": {
+			trigger: PadWorkflowTrigger{Event: "schedule", Filters: map[string]any{"schedule": "0 0 * * *"}},
+			kind:    entities.PullRequest,
+			eventDetails: &entities.EventDetails{
+				EventName: "schedule",
+				Payload:   map[string]any{"schedule": "0 0 * * *"},
+			},
+			wantMatch: true,
+		},
+		"when trigger does not match the cron of a schedule event": {
+			trigger: PadWorkflowTrigger{Event: "schedule", Filters: map[string]any{"schedule": "0 0 * * *"}},
+			kind:    entities.PullRequest,
+			eventDetails: &entities.EventDetails{
+				EventName: "schedule",
+				Payload:   map[string]any{"schedule": "*/15 * * * *"},
+			},
+			wantMatch: false,
+		},
+		"when trigger matches an aliased event": {
+			trigger: PadWorkflowTrigger{Event: "pull_request.synchronize"},
+			kind:    entities.PullRequest,
+			eventDetails: &entities.EventDetails{
+				EventName:   "pull_request_target",
+				EventAction: "synchronize",
+			},
+			wantMatch: true,
+		},
+		"when trigger is the issue event": {
+			trigger: PadWorkflowTrigger{Event: "issue.opened"},
+			kind:    entities.Issue,
+			eventDetails: &entities.EventDetails{
+				EventName:   "issues",
+				EventAction: "opened",
+			},
+			wantMatch: true,
+		},
+		"when trigger matches the event without action": {
+			trigger: PadWorkflowTrigger{Event: "issue_comment"},
+			kind:    entities.PullRequest,
+			eventDetails: &entities.EventDetails{
+				EventName:   "issue_comment",
+				EventAction: "edited",
+			},
+			wantMatch: true,
+		},
+		"when trigger is the branch target kind": {
+			trigger: PadWorkflowTrigger{Event: "branch"},
+			kind:    codehost.Branch,
+			eventDetails: &entities.EventDetails{
+				EventName: "push",
+				Payload:   &github.PushEvent{Ref: github.String("refs/heads/release")},
+			},
+			wantMatch: true,
+		},
+		"when trigger matches the ref of a push event": {
+			trigger: PadWorkflowTrigger{Event: "push", Filters: map[string]any{"ref": "refs/heads/release"}},
+			kind:    codehost.Branch,
+			eventDetails: &entities.EventDetails{
+				EventName: "push",
+				Payload:   &github.PushEvent{Ref: github.String("refs/heads/release")},
+			},
+			wantMatch: true,
+		},
+		"when trigger does not match the ref of a push event": {
+			trigger: PadWorkflowTrigger{Event: "push", Filters: map[string]any{"ref": "refs/heads/release"}},
+			kind:    codehost.Branch,
+			eventDetails: &entities.EventDetails{
+				EventName: "push",
+				Payload:   &github.PushEvent{Ref: github.String("refs/heads/feat")},
+			},
+			wantMatch: false,
+		},
+		"when trigger is the discussion target kind": {
+			trigger: PadWorkflowTrigger{Event: "discussion"},
+			kind:    entities.Discussion,
+			eventDetails: &entities.EventDetails{
+				EventName:   "discussion_comment",
+				EventAction: "created",
+			},
+			wantMatch: true,
+		},
+		"when trigger is the release target kind": {
+			trigger: PadWorkflowTrigger{Event: "release"},
+			kind:    codehost.Release,
+			eventDetails: &entities.EventDetails{
+				EventName:   "release",
+				EventAction: "published",
+			},
+			wantMatch: true,
+		},
+		"when trigger matches the action of a release event": {
+			trigger: PadWorkflowTrigger{Event: "release.published"},
+			kind:    codehost.Release,
+			eventDetails: &entities.EventDetails{
+				EventName:   "release",
+				EventAction: "published",
+			},
+			wantMatch: true,
+		},
+		"when there are no event details": {
+			trigger:   PadWorkflowTrigger{Event: "pull_request.opened"},
+			kind:      entities.PullRequest,
+			wantMatch: false,
+		},
+		"when filter matches the payload": {
+			trigger: PadWorkflowTrigger{
+				Event: "pull_request.labeled",
+				Filters: map[string]any{
+					"label.name": "bug",
+				},
+			},
+			kind:         entities.PullRequest,
+			eventDetails: labeledEvent,
+			wantMatch:    true,
+		},
+		"when filter matches one of the values": {
+			trigger: PadWorkflowTrigger{
+				Event: "pull_request",
+				Filters: map[string]any{
+					"label.name": []any{"enhancement", "bug"},
+				},
+			},
+			kind:         entities.PullRequest,
+			eventDetails: labeledEvent,
+			wantMatch:    true,
+		},
+		"when filter matches an element of a list in the payload": {
+			trigger: PadWorkflowTrigger{
+				Event: "pull_request.labeled",
+				Filters: map[string]any{
+					"pull_request.labels.name": "critical",
+				},
+			},
+			kind:         entities.PullRequest,
+			eventDetails: labeledEvent,
+			wantMatch:    true,
+		},
+		"when filter does not match the payload": {
+			trigger: PadWorkflowTrigger{
+				Event: "pull_request.labeled",
+				Filters: map[string]any{
+					"label.name": "enhancement",
+				},
+			},
+			kind:         entities.PullRequest,
+			eventDetails: labeledEvent,
+			wantMatch:    false,
+		},
+		"when filter path is not in the payload": {
+			trigger: PadWorkflowTrigger{
+				Event: "pull_request.labeled",
+				Filters: map[string]any{
+					"label.color": "ff0000",
+				},
+			},
+			kind:         entities.PullRequest,
+			eventDetails: labeledEvent,
+			wantMatch:    false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotMatch := test.trigger.Matches(test.kind, test.eventDetails)
+
+			assert.Equal(t, test.wantMatch, gotMatch)
+		})
+	}
+}
+
+func TestWorkflowShouldRun(t *testing.T) {
+	dispatchEvent := &entities.EventDetails{
+		EventName: "workflow_dispatch",
+		Payload: &github.WorkflowDispatchEvent{
+			Inputs: json.RawMessage(`{"number": "1", "workflow": "release"}`),
+		},
+	}
+
+	tests := map[string]struct {
+		workflow            PadWorkflow
+		eventDetails        *entities.EventDetails
+		batchedEventDetails []*entities.EventDetails
+		wantRun             bool
+	}{
+		"when workflow is dispatched": {
+			workflow: PadWorkflow{
+				Name: "release",
+				On:   []PadWorkflowTrigger{{Event: "pull_request.closed"}},
+			},
+			eventDetails: dispatchEvent,
+			wantRun:      true,
+		},
+		"when another workflow is dispatched": {
+			workflow: PadWorkflow{
+				Name: "label",
+				On:   []PadWorkflowTrigger{{Event: "pull_request"}},
+			},
+			eventDetails: dispatchEvent,
+			wantRun:      false,
+		},
+		"when dispatch has no workflow": {
+			workflow: PadWorkflow{
+				Name: "label",
+				On:   []PadWorkflowTrigger{{Event: "workflow_dispatch"}},
+			},
+			eventDetails: &entities.EventDetails{
+				EventName: "workflow_dispatch",
+				Payload: &github.WorkflowDispatchEvent{
+					Inputs: json.RawMessage(`{"number": "1"}`),
+				},
+			},
+			wantRun: true,
+		},
+		"when a batched event triggers the workflow": {
+			workflow: PadWorkflow{
+				Name: "label",
+				On:   []PadWorkflowTrigger{{Event: "pull_request.synchronize"}},
+			},
+			eventDetails: &entities.EventDetails{EventName: "check_run", EventAction: "completed"},
+			batchedEventDetails: []*entities.EventDetails{
+				{EventName: "status"},
+				{EventName: "pull_request", EventAction: "synchronize"},
+			},
+			wantRun: true,
+		},
+		"when no batched event triggers the workflow": {
+			workflow: PadWorkflow{
+				Name: "label",
+				On:   []PadWorkflowTrigger{{Event: "pull_request.synchronize"}},
+			},
+			eventDetails: &entities.EventDetails{EventName: "check_run", EventAction: "completed"},
+			batchedEventDetails: []*entities.EventDetails{
+				{EventName: "status"},
+			},
+			wantRun: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotRun := workflowShouldRun(test.workflow, entities.PullRequest, test.eventDetails, test.batchedEventDetails)
+
+			assert.Equal(t, test.wantRun, gotRun)
+		})
+	}
+}
+
+func TestLintWorkflows_WithTriggers(t *testing.T) {
+	tests := map[string]struct {
+		triggers []PadWorkflowTrigger
+		wantErr  error
+	}{
+		"when triggers are valid": {
+			triggers: []PadWorkflowTrigger{
+				{Event: "pull_request"},
+				{Event: "issue"},
+				{Event: "pull_request.synchronize"},
+				{Event: "pull_request_review.submitted"},
+				{Event: "pull_request_review_thread.resolved"},
+				{Event: "issue_comment.created"},
+				{Event: "issue.labeled"},
+				{Event: "repository_dispatch.deploy"},
+				{Event: "workflow_dispatch"},
+				{Event: "branch"},
+				{Event: "push"},
+				{Event: "release"},
+				{Event: "release.published"},
+			},
+		},
+		"when event is unknown": {
+			triggers: []PadWorkflowTrigger{
+				{Event: "pull_requests.opened"},
+			},
+			wantErr: errors.New("workflow `test` has an invalid trigger: unknown event `pull_requests`"),
+		},
+		"when event has no actions": {
+			triggers: []PadWorkflowTrigger{
+				{Event: "workflow_dispatch.created"},
+			},
+			wantErr: errors.New("workflow `test` has an invalid trigger: unknown action `created` for event `workflow_dispatch`"),
+		},
+		"when action is unknown": {
+			triggers: []PadWorkflowTrigger{
+				{Event: "pull_request_review.created"},
+			},
+			wantErr: errors.New("workflow `test` has an invalid trigger: unknown action `created` for event `pull_request_review`"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			workflows := []PadWorkflow{
+				{
+					Name: "test",
+					On:   test.triggers,
+				},
+			}
+
+			gotErr := lintWorkflows(logrus.NewEntry(logrus.New()), []PadRule{}, workflows)
+
+			assert.Equal(t, test.wantErr, gotErr)
+		})
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/sirupsen/logrus"
+)
+
+// GitLab webhooks are identified by the X-Gitlab-Event header.
+// For more information, visit: https://docs.gitlab.com/ee/user/project/integrations/webhook_events.html
+const (
+	GITLAB_MERGE_REQUEST_HOOK = "Merge Request Hook"
+	GITLAB_ISSUE_HOOK         = "Issue Hook"
+	GITLAB_NOTE_HOOK          = "Note Hook"
+	GITLAB_PIPELINE_HOOK      = "Pipeline Hook"
+)
+
+// gitlabObjectKindEvents are the events of the object kinds of the GitLab webhook payloads.
+var gitlabObjectKindEvents = map[string]string{
+	"merge_request": GITLAB_MERGE_REQUEST_HOOK,
+	"issue":         GITLAB_ISSUE_HOOK,
+	"note":          GITLAB_NOTE_HOOK,
+	"pipeline":      GITLAB_PIPELINE_HOOK,
+}
+
+// GitLab projects are public when their visibility level is 20.
+const gitlabPublicVisibilityLevel = 20
+
+// gitlabMergeRequestActions are the GitHub events and actions of the actions of merge requests.
+// The GitHub names are used so that the same triggers work on both code hosts.
+var gitlabMergeRequestActions = map[string][2]string{
+	"open":       {"pull_request", "opened"},
+	"reopen":     {"pull_request", "reopened"},
+	"close":      {"pull_request", "closed"},
+	"merge":      {"pull_request", "closed"},
+	"update":     {"pull_request", "edited"},
+	"approved":   {"pull_request_review", "submitted"},
+	"unapproved": {"pull_request_review", "dismissed"},
+}
+
+var gitlabIssueActions = map[string]string{
+	"open":   "opened",
+	"reopen": "reopened",
+	"close":  "closed",
+	"update": "edited",
+}
+
+// gitlabPipelineActions are the workflow_run actions of the statuses of pipelines.
+var gitlabPipelineActions = map[string]string{
+	"created":  "requested",
+	"pending":  "requested",
+	"running":  "in_progress",
+	"success":  "completed",
+	"failed":   "completed",
+	"canceled": "completed",
+	"skipped":  "completed",
+}
+
+type gitlabEvent struct {
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+		VisibilityLevel   int    `json:"visibility_level"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID          int    `json:"iid"`
+		Action       string `json:"action"`
+		NoteableType string `json:"noteable_type"`
+		OldRev       string `json:"oldrev"`
+		Status       string `json:"status"`
+	} `json:"object_attributes"`
+	MergeRequest *struct {
+		IID int `json:"iid"`
+	} `json:"merge_request"`
+	Issue *struct {
+		IID int `json:"iid"`
+	} `json:"issue"`
+}
+
+// ProcessGitlabEvent returns the targets and details of a GitLab webhook event.
+// Merge requests are pull request targets and issues are issue targets, with the IID as number.
+// The events and actions are named after their GitHub equivalents, e.g. a note on a merge request is an issue_comment created.
+func ProcessGitlabEvent(log *logrus.Entry, eventType string, rawPayload []byte) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+	log.Infof("processing gitlab event %v", eventType)
+
+	event := &gitlabEvent{}
+	if err := json.Unmarshal(rawPayload, event); err != nil {
+		return nil, nil, fmt.Errorf("parse gitlab webhook: %w", err)
+	}
+
+	// The payload is kept as it is so that the trigger filters use the GitLab field names.
+	var payload map[string]interface{}
+	if err := json.Unmarshal(rawPayload, &payload); err != nil {
+		return nil, nil, fmt.Errorf("parse gitlab webhook: %w", err)
+	}
+
+	sepIndex := strings.LastIndex(event.Project.PathWithNamespace, "/")
+	if sepIndex < 0 {
+		return nil, nil, fmt.Errorf("invalid gitlab project path %v", event.Project.PathWithNamespace)
+	}
+
+	newTarget := func(kind entities.TargetEntityKind, iid int) *entities.TargetEntity {
+		visibility := "private"
+		if event.Project.VisibilityLevel == gitlabPublicVisibilityLevel {
+			visibility = "public"
+		}
+
+		return &entities.TargetEntity{
+			Kind:       kind,
+			Number:     iid,
+			Owner:      event.Project.PathWithNamespace[:sepIndex],
+			Repo:       event.Project.PathWithNamespace[sepIndex+1:],
+			Visibility: visibility,
+		}
+	}
+
+	attributes := event.ObjectAttributes
+
+	switch eventType {
+	case GITLAB_MERGE_REQUEST_HOOK:
+		names, ok := gitlabMergeRequestActions[attributes.Action]
+		if !ok {
+			return nil, nil, fmt.Errorf("unsupported gitlab merge request action: %v", attributes.Action)
+		}
+
+		eventName, eventAction := names[0], names[1]
+		// Updates with a previous revision are pushes to the source branch.
+		if attributes.Action == "update" && attributes.OldRev != "" {
+			eventAction = "synchronize"
+		}
+
+		return []*entities.TargetEntity{newTarget(entities.PullRequest, attributes.IID)}, &entities.EventDetails{
+			EventName:   eventName,
+			EventAction: eventAction,
+			Payload:     payload,
+		}, nil
+	case GITLAB_ISSUE_HOOK:
+		eventAction, ok := gitlabIssueActions[attributes.Action]
+		if !ok {
+			return nil, nil, fmt.Errorf("unsupported gitlab issue action: %v", attributes.Action)
+		}
+
+		return []*entities.TargetEntity{newTarget(entities.Issue, attributes.IID)}, &entities.EventDetails{
+			EventName:   "issues",
+			EventAction: eventAction,
+			Payload:     payload,
+		}, nil
+	case GITLAB_NOTE_HOOK:
+		var target *entities.TargetEntity
+
+		switch {
+		case attributes.NoteableType == "MergeRequest" && event.MergeRequest != nil:
+			target = newTarget(entities.PullRequest, event.MergeRequest.IID)
+		case attributes.NoteableType == "Issue" && event.Issue != nil:
+			target = newTarget(entities.Issue, event.Issue.IID)
+		default:
+			return nil, nil, fmt.Errorf("unsupported gitlab note on %v", attributes.NoteableType)
+		}
+
+		return []*entities.TargetEntity{target}, &entities.EventDetails{
+			EventName:   "issue_comment",
+			EventAction: "created",
+			Payload:     payload,
+		}, nil
+	case GITLAB_PIPELINE_HOOK:
+		eventAction, ok := gitlabPipelineActions[attributes.Status]
+		if !ok {
+			return nil, nil, fmt.Errorf("unsupported gitlab pipeline status: %v", attributes.Status)
+		}
+
+		targets := make([]*entities.TargetEntity, 0)
+
+		// Pipelines of branches without a merge request have no target.
+		if event.MergeRequest != nil {
+			targets = append(targets, newTarget(entities.PullRequest, event.MergeRequest.IID))
+		}
+
+		return targets, &entities.EventDetails{
+			EventName:   "workflow_run",
+			EventAction: eventAction,
+			Payload:     payload,
+		}, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported gitlab event: %v", eventType)
+	}
+}
+
+// IsGitlabEvent reports whether the event name is the name of a GitLab webhook event, e.g. Merge Request Hook,
+// rather than the name of a GitHub event.
+func IsGitlabEvent(eventName string) bool {
+	return strings.HasSuffix(eventName, " Hook")
+}
+
+// ProcessWebhookEvent is like ProcessEvent for the webhook events of both GitHub and GitLab.
+// The events of GitLab have the X-Gitlab-Event header as name and the webhook body as payload.
+func ProcessWebhookEvent(log *logrus.Entry, event *ActionEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+	if event.EventName != nil && event.EventPayload != nil && IsGitlabEvent(*event.EventName) {
+		return ProcessGitlabEvent(log, *event.EventName, *event.EventPayload)
+	}
+
+	return ProcessEvent(log, event)
+}
+
+// GetGitlabEventName returns the event of a GitLab webhook payload, i.e. the X-Gitlab-Event header of its delivery,
+// from the object kind of the payload.
+func GetGitlabEventName(rawPayload []byte) (string, error) {
+	event := struct {
+		ObjectKind string `json:"object_kind"`
+	}{}
+
+	if err := json.Unmarshal(rawPayload, &event); err != nil {
+		return "", fmt.Errorf("parse gitlab webhook: %w", err)
+	}
+
+	eventName, ok := gitlabObjectKindEvents[event.ObjectKind]
+	if !ok {
+		return "", fmt.Errorf("unsupported gitlab object kind: %v", event.ObjectKind)
+	}
+
+	return eventName, nil
+}
@@ -0,0 +1,197 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package handler_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/reviewpad/go-lib/entities"
+	log "github.com/reviewpad/go-lib/logrus"
+	"github.com/reviewpad/reviewpad/v4/handler"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessGitlabEvent(t *testing.T) {
+	log := log.NewLogger(logrus.DebugLevel)
+
+	mergeRequestTarget := &entities.TargetEntity{
+		Kind:       entities.PullRequest,
+		Number:     6,
+		Owner:      "foobar/sub",
+		Repo:       "default-mock-repo",
+		Visibility: "public",
+	}
+
+	issueTarget := &entities.TargetEntity{
+		Kind:       entities.Issue,
+		Number:     3,
+		Owner:      "foobar/sub",
+		Repo:       "default-mock-repo",
+		Visibility: "public",
+	}
+
+	project := `"project": {"path_with_namespace": "foobar/sub/default-mock-repo", "visibility_level": 20}`
+
+	tests := map[string]struct {
+		eventType       string
+		payload         string
+		wantTargets     []*entities.TargetEntity
+		wantEventName   string
+		wantEventAction string
+		wantErr         string
+	}{
+		"merge request opened": {
+			eventType:       handler.GITLAB_MERGE_REQUEST_HOOK,
+			payload:         `{` + project + `, "object_attributes": {"iid": 6, "action": "open"}}`,
+			wantTargets:     []*entities.TargetEntity{mergeRequestTarget},
+			wantEventName:   "pull_request",
+			wantEventAction: "opened",
+		},
+		"merge request pushed": {
+			eventType:       handler.GITLAB_MERGE_REQUEST_HOOK,
+			payload:         `{` + project + `, "object_attributes": {"iid": 6, "action": "update", "oldrev": "abc"}}`,
+			wantTargets:     []*entities.TargetEntity{mergeRequestTarget},
+			wantEventName:   "pull_request",
+			wantEventAction: "synchronize",
+		},
+		"merge request approved": {
+			eventType:       handler.GITLAB_MERGE_REQUEST_HOOK,
+			payload:         `{` + project + `, "object_attributes": {"iid": 6, "action": "approved"}}`,
+			wantTargets:     []*entities.TargetEntity{mergeRequestTarget},
+			wantEventName:   "pull_request_review",
+			wantEventAction: "submitted",
+		},
+		"issue closed": {
+			eventType:       handler.GITLAB_ISSUE_HOOK,
+			payload:         `{` + project + `, "object_attributes": {"iid": 3, "action": "close"}}`,
+			wantTargets:     []*entities.TargetEntity{issueTarget},
+			wantEventName:   "issues",
+			wantEventAction: "closed",
+		},
+		"note on merge request": {
+			eventType:       handler.GITLAB_NOTE_HOOK,
+			payload:         `{` + project + `, "object_attributes": {"noteable_type": "MergeRequest"}, "merge_request": {"iid": 6}}`,
+			wantTargets:     []*entities.TargetEntity{mergeRequestTarget},
+			wantEventName:   "issue_comment",
+			wantEventAction: "created",
+		},
+		"note on issue": {
+			eventType:       handler.GITLAB_NOTE_HOOK,
+			payload:         `{` + project + `, "object_attributes": {"noteable_type": "Issue"}, "issue": {"iid": 3}}`,
+			wantTargets:     []*entities.TargetEntity{issueTarget},
+			wantEventName:   "issue_comment",
+			wantEventAction: "created",
+		},
+		"note on commit": {
+			eventType: handler.GITLAB_NOTE_HOOK,
+			payload:   `{` + project + `, "object_attributes": {"noteable_type": "Commit"}}`,
+			wantErr:   "unsupported gitlab note on Commit",
+		},
+		"pipeline of merge request": {
+			eventType:       handler.GITLAB_PIPELINE_HOOK,
+			payload:         `{` + project + `, "object_attributes": {"status": "success"}, "merge_request": {"iid": 6}}`,
+			wantTargets:     []*entities.TargetEntity{mergeRequestTarget},
+			wantEventName:   "workflow_run",
+			wantEventAction: "completed",
+		},
+		"pipeline of branch": {
+			eventType:       handler.GITLAB_PIPELINE_HOOK,
+			payload:         `{` + project + `, "object_attributes": {"status": "running"}}`,
+			wantTargets:     []*entities.TargetEntity{},
+			wantEventName:   "workflow_run",
+			wantEventAction: "in_progress",
+		},
+		"unsupported event": {
+			eventType: "Push Hook",
+			payload:   `{` + project + `}`,
+			wantErr:   "unsupported gitlab event: Push Hook",
+		},
+		"invalid project": {
+			eventType: handler.GITLAB_ISSUE_HOOK,
+			payload:   `{"project": {"path_with_namespace": "foobar"}}`,
+			wantErr:   "invalid gitlab project path foobar",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotTargets, gotEventDetails, err := handler.ProcessGitlabEvent(log, test.eventType, []byte(test.payload))
+
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				assert.Nil(t, gotTargets)
+				assert.Nil(t, gotEventDetails)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantTargets, gotTargets)
+			assert.Equal(t, test.wantEventName, gotEventDetails.EventName)
+			assert.Equal(t, test.wantEventAction, gotEventDetails.EventAction)
+			assert.NotNil(t, gotEventDetails.Payload)
+		})
+	}
+}
+
+func TestProcessWebhookEvent_WhenEventIsFromGitlab(t *testing.T) {
+	log := log.NewLogger(logrus.DebugLevel)
+
+	eventName := handler.GITLAB_ISSUE_HOOK
+	eventPayload := json.RawMessage(`{"project": {"path_with_namespace": "foobar/default-mock-repo"}, "object_attributes": {"iid": 3, "action": "open"}}`)
+
+	gotTargets, gotEventDetails, err := handler.ProcessWebhookEvent(log, &handler.ActionEvent{
+		EventName:    &eventName,
+		EventPayload: &eventPayload,
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*entities.TargetEntity{
+		{
+			Kind:       entities.Issue,
+			Number:     3,
+			Owner:      "foobar",
+			Repo:       "default-mock-repo",
+			Visibility: "private",
+		},
+	}, gotTargets)
+	assert.Equal(t, "issues", gotEventDetails.EventName)
+	assert.Equal(t, "opened", gotEventDetails.EventAction)
+}
+
+func TestGetGitlabEventName(t *testing.T) {
+	tests := map[string]struct {
+		payload       string
+		wantEventName string
+		wantErr       string
+	}{
+		"merge request": {
+			payload:       `{"object_kind": "merge_request"}`,
+			wantEventName: handler.GITLAB_MERGE_REQUEST_HOOK,
+		},
+		"note": {
+			payload:       `{"object_kind": "note"}`,
+			wantEventName: handler.GITLAB_NOTE_HOOK,
+		},
+		"unsupported object kind": {
+			payload: `{"object_kind": "push"}`,
+			wantErr: "unsupported gitlab object kind: push",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotEventName, err := handler.GetGitlabEventName([]byte(test.payload))
+
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, test.wantEventName, gotEventName)
+		})
+	}
+}
@@ -14,7 +14,9 @@ import (
 
 	"github.com/google/go-github/v52/github"
 	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	reviewpad_gh "github.com/reviewpad/reviewpad/v4/codehost/github"
+	"github.com/reviewpad/reviewpad/v4/utils"
 	"github.com/sirupsen/logrus"
 )
 
@@ -35,14 +37,12 @@ func processUnsupportedEvent(eventPayload interface{}) ([]*entities.TargetEntity
 	return nil, nil, fmt.Errorf("unsupported event payload type: %T", eventPayload)
 }
 
-func processCronEvent(log *logrus.Entry, token string, e *ActionEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+func processCronEvent(log *logrus.Entry, ghClient *reviewpad_gh.GithubClient, e *ActionEvent, scope *ScheduleScope) ([]*entities.TargetEntity, *entities.EventDetails, error) {
 	log.Info("processing schedule event")
 
 	ctx, canc := context.WithTimeout(context.Background(), time.Minute*10)
 	defer canc()
 
-	ghClient := reviewpad_gh.NewGithubClientFromToken(ctx, token)
-
 	repoParts := strings.SplitN(*e.Repository, "/", 2)
 
 	owner := repoParts[0]
@@ -53,34 +53,76 @@ func processCronEvent(log *logrus.Entry, token string, e *ActionEvent) ([]*entit
 		return nil, nil, fmt.Errorf("get repository failed: %w", err)
 	}
 
-	issues, _, err := ghClient.ListIssuesByRepo(ctx, owner, repo, nil)
-	if err != nil {
-		return nil, nil, fmt.Errorf("get pull requests: %w", err)
+	if scope == nil {
+		scope = &ScheduleScope{}
 	}
 
-	log.Infof("fetched %d issues", len(issues))
-
+	// The least recently updated issues come first so that they are the ones kept when the targets are limited.
+	// This also means that the remaining pages are not fetched once an issue updated after UpdatedBefore is found.
 	targets := make([]*entities.TargetEntity, 0)
-	for _, issue := range issues {
-		kind := entities.Issue
-		if issue.IsPullRequest() {
-			kind = entities.PullRequest
+	fetched := 0
+	err = ghClient.ListIssuesByRepoPages(ctx, owner, repo, &github.IssueListByRepoOptions{
+		State:     "open",
+		Labels:    scope.Labels,
+		Sort:      "updated",
+		Direction: "asc",
+	}, func(issues []*github.Issue) bool {
+		fetched += len(issues)
+
+		for _, issue := range issues {
+			if !scope.UpdatedBefore.IsZero() && !issue.GetUpdatedAt().Before(scope.UpdatedBefore) {
+				return false
+			}
+
+			kind := entities.Issue
+			if issue.IsPullRequest() {
+				kind = entities.PullRequest
+			}
+
+			if scope.Kind != "" && scope.Kind != kind {
+				continue
+			}
+
+			targets = append(targets, &entities.TargetEntity{
+				Kind:        kind,
+				Number:      *issue.Number,
+				Owner:       owner,
+				Repo:        repo,
+				AccountType: repository.GetOwner().GetType(),
+				Visibility:  repository.GetVisibility(),
+			})
+
+			if scope.MaxTargets > 0 && len(targets) == scope.MaxTargets {
+				log.Infof("limiting schedule event to %d targets", scope.MaxTargets)
+				return false
+			}
 		}
-		targets = append(targets, &entities.TargetEntity{
-			Kind:        kind,
-			Number:      *issue.Number,
-			Owner:       owner,
-			Repo:        repo,
-			AccountType: repository.GetOwner().GetType(),
-			Visibility:  repository.GetVisibility(),
-		})
+
+		return true
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("get pull requests: %w", err)
 	}
 
+	log.Infof("fetched %d issues", fetched)
+
 	log.Infof("found events %v", targets)
 
-	return targets, &entities.EventDetails{
+	eventDetails := &entities.EventDetails{
 		EventName: *e.EventName,
-	}, nil
+	}
+
+	// The payload of schedule events has the cron expression that triggered the run,
+	// which lets workflows filter on it, e.g. `schedule: "0 0 * * *"`.
+	if e.EventPayload != nil {
+		var payload map[string]any
+		if err := json.Unmarshal(*e.EventPayload, &payload); err != nil {
+			return nil, nil, fmt.Errorf("parse schedule event payload: %w", err)
+		}
+		eventDetails.Payload = payload
+	}
+
+	return targets, eventDetails, nil
 }
 
 func processIssuesEvent(log *logrus.Entry, e *github.IssuesEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
@@ -228,6 +270,26 @@ func processPullRequestReviewCommentEvent(log *logrus.Entry, e *github.PullReque
 		}, nil
 }
 
+func processPullRequestReviewThreadEvent(log *logrus.Entry, e *github.PullRequestReviewThreadEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+	log.Info("processing pull_request_review_thread event")
+	log.Infof("found pull request %v", *e.PullRequest.Number)
+
+	return []*entities.TargetEntity{
+			{
+				Kind:        entities.PullRequest,
+				Number:      *e.PullRequest.Number,
+				Owner:       *e.Repo.Owner.Login,
+				Repo:        *e.Repo.Name,
+				AccountType: e.GetRepo().GetOwner().GetType(),
+				Visibility:  e.GetRepo().GetVisibility(),
+			},
+		}, &entities.EventDetails{
+			EventName:   "pull_request_review_thread",
+			EventAction: *e.Action,
+			Payload:     e,
+		}, nil
+}
+
 func processPullRequestTargetEvent(log *logrus.Entry, e *github.PullRequestTargetEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
 	log.Infof(`processing "pull_request_target" event`)
 	log.Infof("found pull request %v", *e.PullRequest.Number)
@@ -248,14 +310,12 @@ func processPullRequestTargetEvent(log *logrus.Entry, e *github.PullRequestTarge
 		}, nil
 }
 
-func processStatusEvent(log *logrus.Entry, token string, e *github.StatusEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+func processStatusEvent(log *logrus.Entry, ghClient *reviewpad_gh.GithubClient, e *github.StatusEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
 	log.Info("processing status event")
 
 	ctx, canc := context.WithTimeout(context.Background(), time.Minute*10)
 	defer canc()
 
-	ghClient := reviewpad_gh.NewGithubClientFromToken(ctx, token)
-
 	prs, err := ghClient.GetPullRequests(ctx, *e.Repo.Owner.Login, *e.Repo.Name)
 	if err != nil {
 		return nil, nil, fmt.Errorf("get pull requests: %w", err)
@@ -289,12 +349,11 @@ func processStatusEvent(log *logrus.Entry, token string, e *github.StatusEvent)
 	return []*entities.TargetEntity{}, eventDetails, nil
 }
 
-func processWorkflowRunEvent(log *logrus.Entry, token string, e *github.WorkflowRunEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+func processWorkflowRunEvent(log *logrus.Entry, ghClient *reviewpad_gh.GithubClient, e *github.WorkflowRunEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
 	log.Info("processing workflow_run event")
 
 	ctx, canc := context.WithTimeout(context.Background(), time.Minute*10)
 	defer canc()
-	ghClient := reviewpad_gh.NewGithubClientFromToken(ctx, token)
 
 	prs, err := ghClient.GetPullRequests(ctx, *e.Repo.Owner.Login, *e.Repo.Name)
 	if err != nil {
@@ -330,14 +389,12 @@ func processWorkflowRunEvent(log *logrus.Entry, token string, e *github.Workflow
 	return []*entities.TargetEntity{}, eventDetail, nil
 }
 
-func processPushEvent(log *logrus.Entry, token string, e *github.PushEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+func processPushEvent(log *logrus.Entry, ghClient *reviewpad_gh.GithubClient, e *github.PushEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
 	log.Info("processing push event")
 
 	ctx, canc := context.WithTimeout(context.Background(), time.Minute*10)
 	defer canc()
 
-	ghClient := reviewpad_gh.NewGithubClientFromToken(ctx, token)
-
 	repoParts := strings.SplitN(*e.GetRepo().FullName, "/", 2)
 
 	owner := repoParts[0]
@@ -365,29 +422,228 @@ func processPushEvent(log *logrus.Entry, token string, e *github.PushEvent) ([]*
 	}
 
 	// since the push event is not necessarily tied to a pull request, for example when you push into a default branch
-	// we also need to add the repo as a target so we can handle push events that are not tied to a pull request
-	repoTarget := &entities.TargetEntity{
-		Kind:        entities.Repository,
-		Owner:       e.GetRepo().GetOwner().GetLogin(),
-		Repo:        e.GetRepo().GetName(),
-		AccountType: e.GetRepo().GetOwner().GetType(),
-		Visibility:  "public",
+	// we also need to add the pushed branch as a target so we can handle push events that are not tied to a pull request
+	if strings.HasPrefix(e.GetRef(), "refs/heads/") && !e.GetDeleted() {
+		// The repository of push events has no visibility, which can also be internal.
+		repository, _, err := ghClient.GetClientREST().Repositories.Get(ctx, owner, repo)
+		if err != nil {
+			return nil, nil, fmt.Errorf("get repository failed: %w", err)
+		}
+
+		targets = append(targets, &entities.TargetEntity{
+			Kind:        codehost.Branch,
+			Owner:       e.GetRepo().GetOwner().GetLogin(),
+			Repo:        e.GetRepo().GetName(),
+			AccountType: e.GetRepo().GetOwner().GetType(),
+			Visibility:  repository.GetVisibility(),
+		})
+	}
+
+	log.Infof("found events %v", targets)
+
+	return targets, &entities.EventDetails{
+		EventName: "push",
+		Payload:   e,
+	}, nil
+}
+
+func processLabelEvent(log *logrus.Entry, ghClient *reviewpad_gh.GithubClient, e *github.LabelEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+	log.Info("processing label event")
+
+	eventDetails := &entities.EventDetails{
+		EventName:   "label",
+		EventAction: e.GetAction(),
+		Payload:     e,
 	}
 
-	if e.GetRepo().GetPrivate() {
-		repoTarget.Visibility = "private"
+	// a deleted label is removed from every issue and pull request so there are no affected targets left to find
+	if e.GetAction() == "deleted" {
+		return []*entities.TargetEntity{}, eventDetails, nil
 	}
 
-	targets = append(targets, repoTarget)
+	targets, err := getOpenTargetsByRepo(log, ghClient, e.GetRepo(), &github.IssueListByRepoOptions{
+		State:  "open",
+		Labels: []string{e.GetLabel().GetName()},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return targets, eventDetails, nil
+}
+
+func processMilestoneEvent(log *logrus.Entry, ghClient *reviewpad_gh.GithubClient, e *github.MilestoneEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+	log.Info("processing milestone event")
+
+	eventDetails := &entities.EventDetails{
+		EventName:   "milestone",
+		EventAction: e.GetAction(),
+		Payload:     e,
+	}
+
+	if e.GetAction() == "deleted" {
+		return []*entities.TargetEntity{}, eventDetails, nil
+	}
+
+	targets, err := getOpenTargetsByRepo(log, ghClient, e.GetRepo(), &github.IssueListByRepoOptions{
+		State:     "open",
+		Milestone: strconv.Itoa(e.GetMilestone().GetNumber()),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return targets, eventDetails, nil
+}
+
+// getOpenTargetsByRepo lists the issues and pull requests of the repository matching the options.
+func getOpenTargetsByRepo(log *logrus.Entry, ghClient *reviewpad_gh.GithubClient, repository *github.Repository, opts *github.IssueListByRepoOptions) ([]*entities.TargetEntity, error) {
+	ctx, canc := context.WithTimeout(context.Background(), time.Minute*10)
+	defer canc()
+
+	owner := repository.GetOwner().GetLogin()
+	repo := repository.GetName()
+
+	issues, _, err := ghClient.ListIssuesByRepo(ctx, owner, repo, opts)
+	if err != nil {
+		return nil, fmt.Errorf("get issues: %w", err)
+	}
+
+	log.Infof("fetched %d issues", len(issues))
+
+	targets := make([]*entities.TargetEntity, 0, len(issues))
+	for _, issue := range issues {
+		kind := entities.Issue
+		if issue.IsPullRequest() {
+			kind = entities.PullRequest
+		}
+
+		targets = append(targets, &entities.TargetEntity{
+			Kind:        kind,
+			Number:      issue.GetNumber(),
+			Owner:       owner,
+			Repo:        repo,
+			AccountType: repository.GetOwner().GetType(),
+			Visibility:  repository.GetVisibility(),
+		})
+	}
 
 	log.Infof("found events %v", targets)
 
+	return targets, nil
+}
+
+func processReleaseEvent(log *logrus.Entry, e *github.ReleaseEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+	log.Info("processing release event")
+	log.Infof("found release %v", e.GetRelease().GetTagName())
+
+	return []*entities.TargetEntity{
+			{
+				Kind:        codehost.Release,
+				Number:      int(e.GetRelease().GetID()),
+				Owner:       e.GetRepo().GetOwner().GetLogin(),
+				Repo:        e.GetRepo().GetName(),
+				AccountType: e.GetRepo().GetOwner().GetType(),
+				Visibility:  e.GetRepo().GetVisibility(),
+			},
+		}, &entities.EventDetails{
+			EventName:   "release",
+			EventAction: e.GetAction(),
+			Payload:     e,
+		}, nil
+}
+
+func processWorkflowDispatchEvent(log *logrus.Entry, ghClient *reviewpad_gh.GithubClient, e *github.WorkflowDispatchEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+	log.Info("processing workflow_dispatch event")
+
+	targets, err := getDispatchTargets(log, ghClient, e.GetRepo(), e)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	return targets, &entities.EventDetails{
-		EventName: "push",
+		EventName: "workflow_dispatch",
 		Payload:   e,
 	}, nil
 }
 
+func processRepositoryDispatchEvent(log *logrus.Entry, ghClient *reviewpad_gh.GithubClient, e *github.RepositoryDispatchEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+	log.Info("processing repository_dispatch event")
+
+	targets, err := getDispatchTargets(log, ghClient, e.GetRepo(), e)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return targets, &entities.EventDetails{
+		EventName:   "repository_dispatch",
+		EventAction: e.GetAction(),
+		Payload:     e,
+	}, nil
+}
+
+// getDispatchTargets finds the pull requests and issues to run on from the dispatch inputs.
+// The inputs specify either the number of a pull request or issue or a search query.
+func getDispatchTargets(log *logrus.Entry, ghClient *reviewpad_gh.GithubClient, repository *github.Repository, eventPayload interface{}) ([]*entities.TargetEntity, error) {
+	inputs, err := utils.GetDispatchInputs(eventPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	number, hasNumber, err := utils.GetDispatchNumber(inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	query, _ := inputs[utils.DISPATCH_INPUT_QUERY].(string)
+
+	if !hasNumber && query == "" {
+		return nil, fmt.Errorf("dispatch event is missing the target: provide the `%s` or the `%s` input", utils.DISPATCH_INPUT_NUMBER, utils.DISPATCH_INPUT_QUERY)
+	}
+
+	ctx, canc := context.WithTimeout(context.Background(), time.Minute*10)
+	defer canc()
+
+	owner := repository.GetOwner().GetLogin()
+	repo := repository.GetName()
+
+	var issues []*github.Issue
+	if hasNumber {
+		issue, _, err := ghClient.GetIssue(ctx, owner, repo, number)
+		if err != nil {
+			return nil, fmt.Errorf("get issue: %w", err)
+		}
+
+		issues = []*github.Issue{issue}
+	} else {
+		issues, err = ghClient.SearchIssues(ctx, fmt.Sprintf("repo:%s/%s %s", owner, repo, query))
+		if err != nil {
+			return nil, fmt.Errorf("search issues: %w", err)
+		}
+	}
+
+	targets := make([]*entities.TargetEntity, 0, len(issues))
+	for _, issue := range issues {
+		kind := entities.Issue
+		if issue.IsPullRequest() {
+			kind = entities.PullRequest
+		}
+
+		targets = append(targets, &entities.TargetEntity{
+			Kind:        kind,
+			Number:      issue.GetNumber(),
+			Owner:       owner,
+			Repo:        repo,
+			AccountType: repository.GetOwner().GetType(),
+			Visibility:  repository.GetVisibility(),
+		})
+	}
+
+	log.Infof("found events %v", targets)
+
+	return targets, nil
+}
+
 func processInstallationEvent(log *logrus.Entry, event *github.InstallationEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
 	log.Info("processing installation event")
 
@@ -426,7 +682,7 @@ func processInstallationRepositoriesEvent(event *github.InstallationRepositories
 	}, nil
 }
 
-func processCheckRunEvent(log *logrus.Entry, token string, event *github.CheckRunEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+func processCheckRunEvent(log *logrus.Entry, ghClient *reviewpad_gh.GithubClient, event *github.CheckRunEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
 	log.Info("processing check_run event")
 
 	targetEntities := []*entities.TargetEntity{}
@@ -439,7 +695,7 @@ func processCheckRunEvent(log *logrus.Entry, token string, event *github.CheckRu
 
 	//  if the head is from a forked repository the pull_requests array will be empty on check run events
 	if len(event.CheckRun.PullRequests) == 0 {
-		prs, err := getPullRequests(token, event.GetRepo().GetFullName())
+		prs, err := getPullRequests(ghClient, event.GetRepo().GetFullName())
 		if err != nil {
 			return nil, nil, err
 		}
@@ -481,7 +737,7 @@ func processCheckRunEvent(log *logrus.Entry, token string, event *github.CheckRu
 	return targetEntities, eventDetails, nil
 }
 
-func processCheckSuiteEvent(log *logrus.Entry, token string, event *github.CheckSuiteEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+func processCheckSuiteEvent(log *logrus.Entry, ghClient *reviewpad_gh.GithubClient, event *github.CheckSuiteEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
 	log.Info("processing check_suite event")
 
 	targetEntities := []*entities.TargetEntity{}
@@ -493,7 +749,7 @@ func processCheckSuiteEvent(log *logrus.Entry, token string, event *github.Check
 	}
 
 	if event.Sender.GetLogin() == "github-merge-queue[bot]" && event.GetAction() == "requested" {
-		return processCheckSuiteEventForMergeQueue(log, token, event, eventDetails)
+		return processCheckSuiteEventForMergeQueue(log, ghClient, event, eventDetails)
 	}
 
 	// When the check suite is from a head of a forked repository the pull_requests array will be empty.
@@ -501,7 +757,7 @@ func processCheckSuiteEvent(log *logrus.Entry, token string, event *github.Check
 	if len(event.CheckSuite.PullRequests) == 0 {
 		log.Infof("no pull requests found in check suite event. fetching all pull requests for repository %v", event.GetRepo().GetFullName())
 
-		prs, err := getPullRequests(token, event.GetRepo().GetFullName())
+		prs, err := getPullRequests(ghClient, event.GetRepo().GetFullName())
 		if err != nil {
 			return nil, nil, err
 		}
@@ -543,7 +799,7 @@ func processCheckSuiteEvent(log *logrus.Entry, token string, event *github.Check
 	return targetEntities, eventDetails, nil
 }
 
-func processCheckSuiteEventForMergeQueue(log *logrus.Entry, token string, event *github.CheckSuiteEvent, eventDetails *entities.EventDetails) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+func processCheckSuiteEventForMergeQueue(log *logrus.Entry, ghClient *reviewpad_gh.GithubClient, event *github.CheckSuiteEvent, eventDetails *entities.EventDetails) ([]*entities.TargetEntity, *entities.EventDetails, error) {
 	// The GitHub Merge Queue creates a temporary branch where the head SHA of the check suite is from the temporary branch.
 	// In order to find the pull request associated with the event, we can use the temporary branch name created by the merge queue.
 	// The format of a GitHub Merge Queue temporary branch is: gh-readonly-queue/{target_branch}/pr-{pr_number}-{head_SHA_source_branch}
@@ -554,7 +810,7 @@ func processCheckSuiteEventForMergeQueue(log *logrus.Entry, token string, event
 		return nil, nil, fmt.Errorf("error converting pr number to int: %w", err)
 	}
 
-	pr, err := getPullRequest(token, event.GetRepo().GetFullName(), prNumber)
+	pr, err := getPullRequest(ghClient, event.GetRepo().GetFullName(), prNumber)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -571,12 +827,10 @@ func processCheckSuiteEventForMergeQueue(log *logrus.Entry, token string, event
 	}, eventDetails, nil
 }
 
-func getPullRequest(token, fullName string, prNumber int) (*github.PullRequest, error) {
+func getPullRequest(ghClient *reviewpad_gh.GithubClient, fullName string, prNumber int) (*github.PullRequest, error) {
 	ctx, canc := context.WithTimeout(context.Background(), time.Minute*10)
 	defer canc()
 
-	ghClient := reviewpad_gh.NewGithubClientFromToken(ctx, token)
-
 	repoParts := strings.SplitN(fullName, "/", 2)
 
 	owner := repoParts[0]
@@ -590,12 +844,10 @@ func getPullRequest(token, fullName string, prNumber int) (*github.PullRequest,
 	return pr, nil
 }
 
-func getPullRequests(token, fullName string) ([]*github.PullRequest, error) {
+func getPullRequests(ghClient *reviewpad_gh.GithubClient, fullName string) ([]*github.PullRequest, error) {
 	ctx, canc := context.WithTimeout(context.Background(), time.Minute*10)
 	defer canc()
 
-	ghClient := reviewpad_gh.NewGithubClientFromToken(ctx, token)
-
 	repoParts := strings.SplitN(fullName, "/", 2)
 
 	owner := repoParts[0]
@@ -637,14 +889,44 @@ func extractTargetEntitiesFromRepositories(repos []*github.Repository, accountTy
 
 // reviewpad-an: critical
 // output: the list of pull requests/issues that are affected by the event.
+// newGithubClient creates the client used to find the targets of the event.
+// Events from GitHub Enterprise Server have the URL of its API.
+func newGithubClient(event *ActionEvent) (*reviewpad_gh.GithubClient, error) {
+	token := ""
+	if event.Token != nil {
+		token = *event.Token
+	}
+
+	apiURL := ""
+	if event.ApiUrl != nil {
+		apiURL = *event.ApiUrl
+	}
+
+	return reviewpad_gh.NewGithubClientFromTokenWithBaseURL(context.Background(), token, apiURL)
+}
+
 func ProcessEvent(log *logrus.Entry, event *ActionEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+	return ProcessEventWithOptions(log, event, nil)
+}
+
+// ProcessEventWithOptions is like ProcessEvent with options that change the targets of some events.
+func ProcessEventWithOptions(log *logrus.Entry, event *ActionEvent, options *ProcessEventOptions) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+	if options == nil {
+		options = &ProcessEventOptions{}
+	}
+
+	ghClient, err := newGithubClient(event)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// These events do not have an equivalent in the GitHub webhooks, thus
 	// parsing them with github.ParseWebhook would return an error.
 	// These are the webhook events: https://docs.github.com/en/developers/webhooks-and-events/webhooks/webhook-events-and-payloads
 	// And these are the "workflow events": https://docs.github.com/en/actions/using-workflows/events-that-trigger-workflows
 	switch *event.EventName {
 	case "schedule":
-		return processCronEvent(log, *event.Token, event)
+		return processCronEvent(log, ghClient, event, options.ScheduleScope)
 	}
 
 	eventPayload, err := github.ParseWebHook(*event.EventName, *event.EventPayload)
@@ -658,9 +940,9 @@ func ProcessEvent(log *logrus.Entry, event *ActionEvent) ([]*entities.TargetEnti
 	case *github.BranchProtectionRuleEvent:
 		return processUnsupportedEvent(payload)
 	case *github.CheckRunEvent:
-		return processCheckRunEvent(log, *event.Token, payload)
+		return processCheckRunEvent(log, ghClient, payload)
 	case *github.CheckSuiteEvent:
-		return processCheckSuiteEvent(log, *event.Token, payload)
+		return processCheckSuiteEvent(log, ghClient, payload)
 	case *github.CommitCommentEvent:
 		return processUnsupportedEvent(payload)
 	case *github.ContentReferenceEvent:
@@ -694,7 +976,7 @@ func ProcessEvent(log *logrus.Entry, event *ActionEvent) ([]*entities.TargetEnti
 	case *github.IssuesEvent:
 		return processIssuesEvent(log, payload)
 	case *github.LabelEvent:
-		return processUnsupportedEvent(payload)
+		return processLabelEvent(log, ghClient, payload)
 	case *github.MarketplacePurchaseEvent:
 		return processUnsupportedEvent(payload)
 	case *github.MemberEvent:
@@ -704,7 +986,7 @@ func ProcessEvent(log *logrus.Entry, event *ActionEvent) ([]*entities.TargetEnti
 	case *github.MetaEvent:
 		return processUnsupportedEvent(payload)
 	case *github.MilestoneEvent:
-		return processUnsupportedEvent(payload)
+		return processMilestoneEvent(log, ghClient, payload)
 	case *github.OrganizationEvent:
 		return processUnsupportedEvent(payload)
 	case *github.OrgBlockEvent:
@@ -730,17 +1012,17 @@ func ProcessEvent(log *logrus.Entry, event *ActionEvent) ([]*entities.TargetEnti
 	case *github.PullRequestReviewCommentEvent:
 		return processPullRequestReviewCommentEvent(log, payload)
 	case *github.PullRequestReviewThreadEvent:
-		return processUnsupportedEvent(payload)
+		return processPullRequestReviewThreadEvent(log, payload)
 	case *github.PullRequestTargetEvent:
 		return processPullRequestTargetEvent(log, payload)
 	case *github.PushEvent:
-		return processPushEvent(log, *event.Token, payload)
+		return processPushEvent(log, ghClient, payload)
 	case *github.ReleaseEvent:
-		return processUnsupportedEvent(payload)
+		return processReleaseEvent(log, payload)
 	case *github.RepositoryEvent:
 		return processUnsupportedEvent(payload)
 	case *github.RepositoryDispatchEvent:
-		return processUnsupportedEvent(payload)
+		return processRepositoryDispatchEvent(log, ghClient, payload)
 	case *github.RepositoryImportEvent:
 		return processUnsupportedEvent(payload)
 	case *github.RepositoryVulnerabilityAlertEvent:
@@ -750,7 +1032,7 @@ func ProcessEvent(log *logrus.Entry, event *ActionEvent) ([]*entities.TargetEnti
 	case *github.StarEvent:
 		return processUnsupportedEvent(payload)
 	case *github.StatusEvent:
-		return processStatusEvent(log, *event.Token, payload)
+		return processStatusEvent(log, ghClient, payload)
 	case *github.TeamEvent:
 		return processUnsupportedEvent(payload)
 	case *github.TeamAddEvent:
@@ -760,11 +1042,11 @@ func ProcessEvent(log *logrus.Entry, event *ActionEvent) ([]*entities.TargetEnti
 	case *github.WatchEvent:
 		return processUnsupportedEvent(payload)
 	case *github.WorkflowDispatchEvent:
-		return processUnsupportedEvent(payload)
+		return processWorkflowDispatchEvent(log, ghClient, payload)
 	case *github.WorkflowJobEvent:
 		return processUnsupportedEvent(payload)
 	case *github.WorkflowRunEvent:
-		return processWorkflowRunEvent(log, *event.Token, payload)
+		return processWorkflowRunEvent(log, ghClient, payload)
 	}
 
 	return processUnsupportedEvent(eventPayload)
@@ -10,11 +10,13 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-github/v52/github"
 	"github.com/jarcoal/httpmock"
 	"github.com/reviewpad/go-lib/entities"
 	log "github.com/reviewpad/go-lib/logrus"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/handler"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 	"github.com/sirupsen/logrus"
@@ -128,6 +130,23 @@ func TestProcessEvent_Failure(t *testing.T) {
 				}`)),
 			},
 		},
+		"workflow_dispatch_without_target": {
+			event: &handler.ActionEvent{
+				EventName: github.String("workflow_dispatch"),
+				Token:     github.String("test-token"),
+				EventPayload: buildPayload([]byte(`{
+					"inputs": {
+						"workflow": "release"
+					},
+					"repository": {
+						"name": "reviewpad",
+						"owner": {
+							"login": "reviewpad"
+						}
+					}
+				}`)),
+			},
+		},
 		"push": {
 			event: &handler.ActionEvent{
 				EventName: github.String("push"),
@@ -248,6 +267,54 @@ func TestProcessEvent(t *testing.T) {
 		},
 	)
 
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://api.github.com/repos/%v/%v/issues/%v", owner, repo, 130),
+		func(req *http.Request) (*http.Response, error) {
+			b, err := json.Marshal(&github.Issue{
+				Number: github.Int(130),
+				PullRequestLinks: &github.PullRequestLinks{
+					HTMLURL: github.String(fmt.Sprintf("https://api.github.com/repos/%v/%v/pull/%v", owner, repo, 130)),
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			resp := httpmock.NewBytesResponse(200, b)
+
+			return resp, nil
+		},
+	)
+
+	httpmock.RegisterResponder("GET", "https://api.github.com/search/issues",
+		func(req *http.Request) (*http.Response, error) {
+			if req.URL.Query().Get("q") != fmt.Sprintf("repo:%v/%v is:open label:release", owner, repo) {
+				return httpmock.NewStringResponse(422, ""), nil
+			}
+
+			b, err := json.Marshal(&github.IssuesSearchResult{
+				Total: github.Int(2),
+				Issues: []*github.Issue{
+					{
+						Number: github.Int(130),
+						PullRequestLinks: &github.PullRequestLinks{
+							HTMLURL: github.String(fmt.Sprintf("https://api.github.com/repos/%v/%v/pull/%v", owner, repo, 130)),
+						},
+					},
+					{
+						Number: github.Int(131),
+					},
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			resp := httpmock.NewBytesResponse(200, b)
+
+			return resp, nil
+		},
+	)
+
 	httpmock.RegisterResponder("GET", fmt.Sprintf("https://api.github.com/repos/%v/%v", owner, repo),
 		func(req *http.Request) (*http.Response, error) {
 			b, err := json.Marshal(github.Repository{
@@ -340,9 +407,378 @@ func TestProcessEvent(t *testing.T) {
 						},
 						"visibility": "public"
 					},
-					"pull_request": {
-						"body": "## Description",
-						"number": 130
+					"pull_request": {
+						"body": "## Description",
+						"number": 130
+					}
+				}`)),
+			},
+			wantTargets: []*entities.TargetEntity{
+				{
+					Kind:        entities.PullRequest,
+					Number:      130,
+					Owner:       owner,
+					Repo:        repo,
+					AccountType: "Organization",
+					Visibility:  "public",
+				},
+			},
+			wantEventDetails: &entities.EventDetails{
+				EventName:   "pull_request_target",
+				EventAction: "opened",
+				Payload: &github.PullRequestTargetEvent{
+					Action: github.String("opened"),
+					Number: github.Int(130),
+					Repo: &github.Repository{
+						Name: github.String("reviewpad"),
+						Owner: &github.User{
+							Login: github.String("reviewpad"),
+							Type:  github.String("Organization"),
+						},
+						Visibility: github.String("public"),
+					},
+					PullRequest: &github.PullRequest{
+						Body:   github.String("## Description"),
+						Number: github.Int(130),
+					},
+				},
+			},
+		},
+		"pull_request_review": {
+			event: &handler.ActionEvent{
+				EventName: github.String("pull_request_review"),
+				Token:     github.String("test-token"),
+				EventPayload: buildPayload([]byte(`{
+					"action": "opened",
+					"number": 130,
+					"repository": {
+						"name": "reviewpad",
+						"owner": {
+							"login": "reviewpad",
+							"type": "Organization"
+						},
+						"visibility": "public"
+					},
+					"pull_request": {
+						"body": "## Description",
+						"number": 130
+					}
+				}`)),
+			},
+			wantTargets: []*entities.TargetEntity{
+				{
+					Kind:        entities.PullRequest,
+					Number:      130,
+					Owner:       owner,
+					Repo:        repo,
+					AccountType: "Organization",
+					Visibility:  "public",
+				},
+			},
+			wantEventDetails: &entities.EventDetails{
+				EventName:   "pull_request_review",
+				EventAction: "opened",
+				Payload: &github.PullRequestReviewEvent{
+					Action: github.String("opened"),
+					Repo: &github.Repository{
+						Name: github.String("reviewpad"),
+						Owner: &github.User{
+							Login: github.String("reviewpad"),
+							Type:  github.String("Organization"),
+						},
+						Visibility: github.String("public"),
+					},
+					PullRequest: &github.PullRequest{
+						Body:   github.String("## Description"),
+						Number: github.Int(130),
+					},
+				},
+			},
+		},
+		"label": {
+			event: &handler.ActionEvent{
+				EventName: github.String("label"),
+				Token:     github.String("test-token"),
+				EventPayload: buildPayload([]byte(`{
+					"action": "edited",
+					"label": {
+						"name": "ship-it"
+					},
+					"repository": {
+						"name": "reviewpad",
+						"owner": {
+							"login": "reviewpad",
+							"type": "Organization"
+						},
+						"visibility": "public"
+					},
+					"sender": {
+						"login": "john"
+					}
+				}`)),
+			},
+			wantTargets: []*entities.TargetEntity{
+				{
+					Kind:        entities.PullRequest,
+					Number:      aladino.DefaultMockPrNum,
+					Owner:       owner,
+					Repo:        repo,
+					AccountType: "Organization",
+					Visibility:  "public",
+				},
+				{
+					Kind:        entities.PullRequest,
+					Number:      130,
+					Owner:       owner,
+					Repo:        repo,
+					AccountType: "Organization",
+					Visibility:  "public",
+				},
+			},
+			wantEventDetails: &entities.EventDetails{
+				EventName:   "label",
+				EventAction: "edited",
+				Payload: &github.LabelEvent{
+					Action: github.String("edited"),
+					Label: &github.Label{
+						Name: github.String("ship-it"),
+					},
+					Repo: &github.Repository{
+						Name: github.String("reviewpad"),
+						Owner: &github.User{
+							Login: github.String("reviewpad"),
+							Type:  github.String("Organization"),
+						},
+						Visibility: github.String("public"),
+					},
+					Sender: &github.User{
+						Login: github.String("john"),
+					},
+				},
+			},
+		},
+		"label deleted": {
+			event: &handler.ActionEvent{
+				EventName: github.String("label"),
+				Token:     github.String("test-token"),
+				EventPayload: buildPayload([]byte(`{
+					"action": "deleted",
+					"label": {
+						"name": "ship-it"
+					},
+					"repository": {
+						"name": "reviewpad",
+						"owner": {
+							"login": "reviewpad",
+							"type": "Organization"
+						},
+						"visibility": "public"
+					}
+				}`)),
+			},
+			wantTargets: []*entities.TargetEntity{},
+			wantEventDetails: &entities.EventDetails{
+				EventName:   "label",
+				EventAction: "deleted",
+				Payload: &github.LabelEvent{
+					Action: github.String("deleted"),
+					Label: &github.Label{
+						Name: github.String("ship-it"),
+					},
+					Repo: &github.Repository{
+						Name: github.String("reviewpad"),
+						Owner: &github.User{
+							Login: github.String("reviewpad"),
+							Type:  github.String("Organization"),
+						},
+						Visibility: github.String("public"),
+					},
+				},
+			},
+		},
+		"milestone": {
+			event: &handler.ActionEvent{
+				EventName: github.String("milestone"),
+				Token:     github.String("test-token"),
+				EventPayload: buildPayload([]byte(`{
+					"action": "closed",
+					"milestone": {
+						"number": 3,
+						"title": "v1.0"
+					},
+					"repository": {
+						"name": "reviewpad",
+						"owner": {
+							"login": "reviewpad",
+							"type": "Organization"
+						},
+						"visibility": "public"
+					}
+				}`)),
+			},
+			wantTargets: []*entities.TargetEntity{
+				{
+					Kind:        entities.PullRequest,
+					Number:      aladino.DefaultMockPrNum,
+					Owner:       owner,
+					Repo:        repo,
+					AccountType: "Organization",
+					Visibility:  "public",
+				},
+				{
+					Kind:        entities.PullRequest,
+					Number:      130,
+					Owner:       owner,
+					Repo:        repo,
+					AccountType: "Organization",
+					Visibility:  "public",
+				},
+			},
+			wantEventDetails: &entities.EventDetails{
+				EventName:   "milestone",
+				EventAction: "closed",
+				Payload: &github.MilestoneEvent{
+					Action: github.String("closed"),
+					Milestone: &github.Milestone{
+						Number: github.Int(3),
+						Title:  github.String("v1.0"),
+					},
+					Repo: &github.Repository{
+						Name: github.String("reviewpad"),
+						Owner: &github.User{
+							Login: github.String("reviewpad"),
+							Type:  github.String("Organization"),
+						},
+						Visibility: github.String("public"),
+					},
+				},
+			},
+		},
+		"workflow_dispatch": {
+			event: &handler.ActionEvent{
+				EventName: github.String("workflow_dispatch"),
+				Token:     github.String("test-token"),
+				EventPayload: buildPayload([]byte(`{
+					"inputs": {"number": "130", "workflow": "release"},
+					"ref": "refs/heads/main",
+					"repository": {
+						"name": "reviewpad",
+						"owner": {
+							"login": "reviewpad",
+							"type": "Organization"
+						},
+						"visibility": "public"
+					}
+				}`)),
+			},
+			wantTargets: []*entities.TargetEntity{
+				{
+					Kind:        entities.PullRequest,
+					Number:      130,
+					Owner:       owner,
+					Repo:        repo,
+					AccountType: "Organization",
+					Visibility:  "public",
+				},
+			},
+			wantEventDetails: &entities.EventDetails{
+				EventName: "workflow_dispatch",
+				Payload: &github.WorkflowDispatchEvent{
+					Inputs: json.RawMessage(`{"number": "130", "workflow": "release"}`),
+					Ref:    github.String("refs/heads/main"),
+					Repo: &github.Repository{
+						Name: github.String("reviewpad"),
+						Owner: &github.User{
+							Login: github.String("reviewpad"),
+							Type:  github.String("Organization"),
+						},
+						Visibility: github.String("public"),
+					},
+				},
+			},
+		},
+		"repository_dispatch": {
+			event: &handler.ActionEvent{
+				EventName: github.String("repository_dispatch"),
+				Token:     github.String("test-token"),
+				EventPayload: buildPayload([]byte(`{
+					"action": "release",
+					"client_payload": {"query": "is:open label:release"},
+					"repository": {
+						"name": "reviewpad",
+						"owner": {
+							"login": "reviewpad",
+							"type": "Organization"
+						},
+						"visibility": "public"
+					}
+				}`)),
+			},
+			wantTargets: []*entities.TargetEntity{
+				{
+					Kind:        entities.PullRequest,
+					Number:      130,
+					Owner:       owner,
+					Repo:        repo,
+					AccountType: "Organization",
+					Visibility:  "public",
+				},
+				{
+					Kind:        entities.Issue,
+					Number:      131,
+					Owner:       owner,
+					Repo:        repo,
+					AccountType: "Organization",
+					Visibility:  "public",
+				},
+			},
+			wantEventDetails: &entities.EventDetails{
+				EventName:   "repository_dispatch",
+				EventAction: "release",
+				Payload: &github.RepositoryDispatchEvent{
+					Action:        github.String("release"),
+					ClientPayload: json.RawMessage(`{"query": "is:open label:release"}`),
+					Repo: &github.Repository{
+						Name: github.String("reviewpad"),
+						Owner: &github.User{
+							Login: github.String("reviewpad"),
+							Type:  github.String("Organization"),
+						},
+						Visibility: github.String("public"),
+					},
+				},
+			},
+		},
+		"pull_request_review_thread resolved": {
+			event: &handler.ActionEvent{
+				EventName: github.String("pull_request_review_thread"),
+				Token:     github.String("test-token"),
+				EventPayload: buildPayload([]byte(`{
+					"action": "resolved",
+					"pull_request": {
+						"body": "## Description",
+						"number": 130
+					},
+					"thread": {
+						"node_id": "PRRT_kwDOHTXgW85RNvYU",
+						"comments": [
+							{
+								"id": 1182423931,
+								"body": "Please rename this variable.",
+								"path": "main.go"
+							}
+						]
+					},
+					"repository": {
+						"name": "reviewpad",
+						"owner": {
+							"login": "reviewpad",
+							"type": "Organization"
+						},
+						"visibility": "public"
+					},
+					"sender": {
+						"login": "john"
 					}
 				}`)),
 			},
@@ -357,11 +793,24 @@ func TestProcessEvent(t *testing.T) {
 				},
 			},
 			wantEventDetails: &entities.EventDetails{
-				EventName:   "pull_request_target",
-				EventAction: "opened",
-				Payload: &github.PullRequestTargetEvent{
-					Action: github.String("opened"),
-					Number: github.Int(130),
+				EventName:   "pull_request_review_thread",
+				EventAction: "resolved",
+				Payload: &github.PullRequestReviewThreadEvent{
+					Action: github.String("resolved"),
+					PullRequest: &github.PullRequest{
+						Body:   github.String("## Description"),
+						Number: github.Int(130),
+					},
+					Thread: &github.PullRequestThread{
+						NodeID: github.String("PRRT_kwDOHTXgW85RNvYU"),
+						Comments: []*github.PullRequestComment{
+							{
+								ID:   github.Int64(1182423931),
+								Body: github.String("Please rename this variable."),
+								Path: github.String("main.go"),
+							},
+						},
+					},
 					Repo: &github.Repository{
 						Name: github.String("reviewpad"),
 						Owner: &github.User{
@@ -370,20 +819,21 @@ func TestProcessEvent(t *testing.T) {
 						},
 						Visibility: github.String("public"),
 					},
-					PullRequest: &github.PullRequest{
-						Body:   github.String("## Description"),
-						Number: github.Int(130),
+					Sender: &github.User{
+						Login: github.String("john"),
 					},
 				},
 			},
 		},
-		"pull_request_review": {
+		"pull_request_review_thread unresolved": {
 			event: &handler.ActionEvent{
-				EventName: github.String("pull_request_review"),
+				EventName: github.String("pull_request_review_thread"),
 				Token:     github.String("test-token"),
 				EventPayload: buildPayload([]byte(`{
-					"action": "opened",
-					"number": 130,
+					"action": "unresolved",
+					"pull_request": {
+						"number": 130
+					},
 					"repository": {
 						"name": "reviewpad",
 						"owner": {
@@ -391,10 +841,6 @@ func TestProcessEvent(t *testing.T) {
 							"type": "Organization"
 						},
 						"visibility": "public"
-					},
-					"pull_request": {
-						"body": "## Description",
-						"number": 130
 					}
 				}`)),
 			},
@@ -409,10 +855,13 @@ func TestProcessEvent(t *testing.T) {
 				},
 			},
 			wantEventDetails: &entities.EventDetails{
-				EventName:   "pull_request_review",
-				EventAction: "opened",
-				Payload: &github.PullRequestReviewEvent{
-					Action: github.String("opened"),
+				EventName:   "pull_request_review_thread",
+				EventAction: "unresolved",
+				Payload: &github.PullRequestReviewThreadEvent{
+					Action: github.String("unresolved"),
+					PullRequest: &github.PullRequest{
+						Number: github.Int(130),
+					},
 					Repo: &github.Repository{
 						Name: github.String("reviewpad"),
 						Owner: &github.User{
@@ -421,10 +870,6 @@ func TestProcessEvent(t *testing.T) {
 						},
 						Visibility: github.String("public"),
 					},
-					PullRequest: &github.PullRequest{
-						Body:   github.String("## Description"),
-						Number: github.Int(130),
-					},
 				},
 			},
 		},
@@ -775,7 +1220,7 @@ func TestProcessEvent(t *testing.T) {
 					Visibility:  "public",
 				},
 				{
-					Kind:        entities.Repository,
+					Kind:        codehost.Branch,
 					Owner:       owner,
 					Repo:        repo,
 					AccountType: "Organization",
@@ -816,11 +1261,11 @@ func TestProcessEvent(t *testing.T) {
 			},
 			wantTargets: []*entities.TargetEntity{
 				{
-					Kind:        entities.Repository,
+					Kind:        codehost.Branch,
 					Owner:       owner,
 					Repo:        repo,
 					AccountType: "Organization",
-					Visibility:  "private",
+					Visibility:  "public",
 				},
 			},
 			wantEventDetails: &entities.EventDetails{
@@ -839,6 +1284,122 @@ func TestProcessEvent(t *testing.T) {
 				},
 			},
 		},
+		"push_tag": {
+			event: &handler.ActionEvent{
+				EventName: github.String("push"),
+				Token:     github.String("test-token"),
+				EventPayload: buildPayload([]byte(`{
+					"repository": {
+						"full_name": "reviewpad/reviewpad",
+						"owner": {
+							"login": "reviewpad",
+							"type": "Organization"
+						},
+						"name": "reviewpad"
+					},
+					"ref": "refs/tags/v1.0.0"
+				}`)),
+			},
+			wantTargets: []*entities.TargetEntity{},
+			wantEventDetails: &entities.EventDetails{
+				EventName: "push",
+				Payload: &github.PushEvent{
+					Repo: &github.PushEventRepository{
+						FullName: github.String("reviewpad/reviewpad"),
+						Name:     github.String("reviewpad"),
+						Owner: &github.User{
+							Login: github.String("reviewpad"),
+							Type:  github.String("Organization"),
+						},
+					},
+					Ref: github.String("refs/tags/v1.0.0"),
+				},
+			},
+		},
+		"release": {
+			event: &handler.ActionEvent{
+				EventName: github.String("release"),
+				Token:     github.String("test-token"),
+				EventPayload: buildPayload([]byte(`{
+					"action": "published",
+					"release": {
+						"id": 1234,
+						"tag_name": "v1.0.0"
+					},
+					"repository": {
+						"owner": {
+							"login": "reviewpad",
+							"type": "Organization"
+						},
+						"name": "reviewpad",
+						"visibility": "public"
+					}
+				}`)),
+			},
+			wantTargets: []*entities.TargetEntity{
+				{
+					Kind:        codehost.Release,
+					Number:      1234,
+					Owner:       "reviewpad",
+					Repo:        "reviewpad",
+					AccountType: "Organization",
+					Visibility:  "public",
+				},
+			},
+			wantEventDetails: &entities.EventDetails{
+				EventName:   "release",
+				EventAction: "published",
+				Payload: &github.ReleaseEvent{
+					Action: github.String("published"),
+					Release: &github.RepositoryRelease{
+						ID:      github.Int64(1234),
+						TagName: github.String("v1.0.0"),
+					},
+					Repo: &github.Repository{
+						Owner: &github.User{
+							Login: github.String("reviewpad"),
+							Type:  github.String("Organization"),
+						},
+						Name:       github.String("reviewpad"),
+						Visibility: github.String("public"),
+					},
+				},
+			},
+		},
+		"push_deleted_branch": {
+			event: &handler.ActionEvent{
+				EventName: github.String("push"),
+				Token:     github.String("test-token"),
+				EventPayload: buildPayload([]byte(`{
+					"repository": {
+						"full_name": "reviewpad/reviewpad",
+						"owner": {
+							"login": "reviewpad",
+							"type": "Organization"
+						},
+						"name": "reviewpad"
+					},
+					"ref": "refs/heads/docs",
+					"deleted": true
+				}`)),
+			},
+			wantTargets: []*entities.TargetEntity{},
+			wantEventDetails: &entities.EventDetails{
+				EventName: "push",
+				Payload: &github.PushEvent{
+					Repo: &github.PushEventRepository{
+						FullName: github.String("reviewpad/reviewpad"),
+						Name:     github.String("reviewpad"),
+						Owner: &github.User{
+							Login: github.String("reviewpad"),
+							Type:  github.String("Organization"),
+						},
+					},
+					Ref:     github.String("refs/heads/docs"),
+					Deleted: github.Bool(true),
+				},
+			},
+		},
 		"installation": {
 			event: &handler.ActionEvent{
 				EventName: github.String("installation"),
@@ -1261,3 +1822,220 @@ func TestProcessEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessEventWithOptions_Schedule(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	log := log.NewLogger(logrus.DebugLevel)
+
+	owner := "reviewpad"
+	repo := "reviewpad"
+	now := time.Now()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://api.github.com/repos/%v/%v", owner, repo),
+		func(req *http.Request) (*http.Response, error) {
+			b, err := json.Marshal(github.Repository{
+				Owner: &github.User{
+					Type: github.String("Organization"),
+				},
+				Visibility: github.String("public"),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return httpmock.NewBytesResponse(200, b), nil
+		},
+	)
+
+	issuesPages := map[string][]*github.Issue{
+		"1": {
+			{
+				Number:    github.Int(1),
+				UpdatedAt: &github.Timestamp{Time: now.AddDate(0, 0, -60)},
+				PullRequestLinks: &github.PullRequestLinks{
+					HTMLURL: github.String(fmt.Sprintf("https://github.com/%v/%v/pull/1", owner, repo)),
+				},
+			},
+			{
+				Number:    github.Int(2),
+				UpdatedAt: &github.Timestamp{Time: now.AddDate(0, 0, -50)},
+			},
+		},
+		"2": {
+			{
+				Number:    github.Int(3),
+				UpdatedAt: &github.Timestamp{Time: now.AddDate(0, 0, -40)},
+				PullRequestLinks: &github.PullRequestLinks{
+					HTMLURL: github.String(fmt.Sprintf("https://github.com/%v/%v/pull/3", owner, repo)),
+				},
+			},
+			{
+				Number:    github.Int(4),
+				UpdatedAt: &github.Timestamp{Time: now.AddDate(0, 0, -1)},
+				PullRequestLinks: &github.PullRequestLinks{
+					HTMLURL: github.String(fmt.Sprintf("https://github.com/%v/%v/pull/4", owner, repo)),
+				},
+			},
+		},
+	}
+
+	var requestedPages []string
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://api.github.com/repos/%v/%v/issues", owner, repo),
+		func(req *http.Request) (*http.Response, error) {
+			query := req.URL.Query()
+			if query.Get("state") != "open" || query.Get("sort") != "updated" || query.Get("direction") != "asc" {
+				return httpmock.NewStringResponse(422, ""), nil
+			}
+
+			requestedPages = append(requestedPages, query.Get("page"))
+
+			b, err := json.Marshal(issuesPages[query.Get("page")])
+			if err != nil {
+				return nil, err
+			}
+
+			resp := httpmock.NewBytesResponse(200, b)
+			if query.Get("page") == "1" {
+				resp.Header.Add("Link", fmt.Sprintf(`<https://api.github.com/repos/%v/%v/issues?page=2>; rel="next", <https://api.github.com/repos/%v/%v/issues?page=2>; rel="last"`, owner, repo, owner, repo))
+			}
+
+			return resp, nil
+		},
+	)
+
+	target := func(kind entities.TargetEntityKind, number int) *entities.TargetEntity {
+		return &entities.TargetEntity{
+			Kind:        kind,
+			Number:      number,
+			Owner:       owner,
+			Repo:        repo,
+			AccountType: "Organization",
+			Visibility:  "public",
+		}
+	}
+
+	tests := map[string]struct {
+		scope            *handler.ScheduleScope
+		payload          *json.RawMessage
+		wantTargets      []*entities.TargetEntity
+		wantEventDetails *entities.EventDetails
+		wantPages        []string
+	}{
+		"without scope": {
+			wantTargets: []*entities.TargetEntity{
+				target(entities.PullRequest, 1),
+				target(entities.Issue, 2),
+				target(entities.PullRequest, 3),
+				target(entities.PullRequest, 4),
+			},
+			wantEventDetails: &entities.EventDetails{
+				EventName: "schedule",
+			},
+			wantPages: []string{"1", "2"},
+		},
+		"with kind": {
+			scope: &handler.ScheduleScope{
+				Kind: entities.Issue,
+			},
+			wantTargets: []*entities.TargetEntity{
+				target(entities.Issue, 2),
+			},
+			wantEventDetails: &entities.EventDetails{
+				EventName: "schedule",
+			},
+			wantPages: []string{"1", "2"},
+		},
+		"with updated before": {
+			scope: &handler.ScheduleScope{
+				Kind:          entities.PullRequest,
+				UpdatedBefore: now.AddDate(0, 0, -30),
+			},
+			wantTargets: []*entities.TargetEntity{
+				target(entities.PullRequest, 1),
+				target(entities.PullRequest, 3),
+			},
+			wantEventDetails: &entities.EventDetails{
+				EventName: "schedule",
+			},
+			wantPages: []string{"1", "2"},
+		},
+		"with updated before reached in first page": {
+			scope: &handler.ScheduleScope{
+				UpdatedBefore: now.AddDate(0, 0, -55),
+			},
+			wantTargets: []*entities.TargetEntity{
+				target(entities.PullRequest, 1),
+			},
+			wantEventDetails: &entities.EventDetails{
+				EventName: "schedule",
+			},
+			wantPages: []string{"1"},
+		},
+		"with max targets": {
+			scope: &handler.ScheduleScope{
+				MaxTargets: 3,
+			},
+			wantTargets: []*entities.TargetEntity{
+				target(entities.PullRequest, 1),
+				target(entities.Issue, 2),
+				target(entities.PullRequest, 3),
+			},
+			wantEventDetails: &entities.EventDetails{
+				EventName: "schedule",
+			},
+			wantPages: []string{"1", "2"},
+		},
+		"with max targets reached in first page": {
+			scope: &handler.ScheduleScope{
+				MaxTargets: 2,
+			},
+			wantTargets: []*entities.TargetEntity{
+				target(entities.PullRequest, 1),
+				target(entities.Issue, 2),
+			},
+			wantEventDetails: &entities.EventDetails{
+				EventName: "schedule",
+			},
+			wantPages: []string{"1"},
+		},
+		"with payload": {
+			payload: buildPayload([]byte(`{"schedule": "0 0 * * *"}`)),
+			scope: &handler.ScheduleScope{
+				MaxTargets: 1,
+			},
+			wantTargets: []*entities.TargetEntity{
+				target(entities.PullRequest, 1),
+			},
+			wantEventDetails: &entities.EventDetails{
+				EventName: "schedule",
+				Payload: map[string]any{
+					"schedule": "0 0 * * *",
+				},
+			},
+			wantPages: []string{"1"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			requestedPages = nil
+
+			event := &handler.ActionEvent{
+				EventName:    github.String("schedule"),
+				EventPayload: test.payload,
+				Token:        github.String("test-token"),
+				Repository:   github.String(fmt.Sprintf("%v/%v", owner, repo)),
+			}
+
+			gotTargets, gotEventDetails, err := handler.ProcessEventWithOptions(log, event, &handler.ProcessEventOptions{
+				ScheduleScope: test.scope,
+			})
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantEventDetails, gotEventDetails)
+			assert.Equal(t, test.wantTargets, gotTargets)
+			assert.Equal(t, test.wantPages, requestedPages)
+		})
+	}
+}
@@ -4,7 +4,12 @@
 
 package handler
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/reviewpad/go-lib/entities"
+)
 
 // ActionEvent contains information about the workflow run and the event that triggered the run.
 // For more information, visit: https://docs.github.com/en/actions/learn-github-actions/contexts#github-context
@@ -43,3 +48,20 @@ type ActionEvent struct {
 	WorkflowRef      *string          `json:"workflow_ref,omitempty"`
 	Workspace        *string          `json:"workspace,omitempty"`
 }
+
+type ProcessEventOptions struct {
+	// ScheduleScope limits the targets of schedule events.
+	ScheduleScope *ScheduleScope
+}
+
+// ScheduleScope limits the open pull requests and issues that are the targets of a schedule event.
+type ScheduleScope struct {
+	// Kind is the kind of the targets. Both pull requests and issues are targeted when empty.
+	Kind entities.TargetEntityKind
+	// Labels are the labels that the targets must all have.
+	Labels []string
+	// UpdatedBefore excludes the targets updated after it, unless it is zero.
+	UpdatedBefore time.Time
+	// MaxTargets is the maximum number of targets, the least recently updated first, unless it is zero.
+	MaxTargets int
+}
@@ -0,0 +1,167 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"fmt"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/engine"
+)
+
+// MAX_CHECK_RUN_ANNOTATIONS is the maximum number of annotations GitHub accepts in a single request.
+const MAX_CHECK_RUN_ANNOTATIONS = 50
+
+// ReportedAnnotation is a message reported on a line of a file.
+type ReportedAnnotation struct {
+	Severity Severity
+	Path     string
+	Line     int
+	Message  string
+}
+
+// ReportMessage reports the message with the severity.
+// When the path is not empty, the message is also an annotation of the line of the file in the check run of the report.
+func ReportMessage(env Env, severity Severity, message, path string, line int) error {
+	if path != "" && line < 1 {
+		return fmt.Errorf("invalid line %d of %s: lines start at 1", line, path)
+	}
+
+	reportedMessages := env.GetBuiltInsReportedMessages()
+	reportedMessages[severity] = append(reportedMessages[severity], message)
+
+	if path != "" {
+		env.AddBuiltInsReportedAnnotation(&ReportedAnnotation{
+			Severity: severity,
+			Path:     path,
+			Line:     line,
+			Message:  message,
+		})
+	}
+
+	return nil
+}
+
+// ReportCheckRun publishes a check run on the head of the pull request whose summary is the report
+// and whose annotations are the messages reported on lines of files.
+func (i *Interpreter) ReportCheckRun(mode string, safeMode bool, checkRun *engine.PadCheckRun) error {
+	env := i.Env
+
+	t, ok := env.GetTarget().(codehost.PullRequest)
+	if !ok {
+		return nil
+	}
+
+	// Check runs are a GitHub feature.
+	if env.GetGithubClient() == nil {
+		env.GetLogger().Infof("skipping check run %s because the code host is not GitHub", checkRun.GetName())
+		return nil
+	}
+
+	if mode == "" {
+		mode = engine.SILENT_MODE
+	}
+
+	reportedMessages := make(map[Severity][]string)
+	for severity, messages := range env.GetBuiltInsReportedMessages() {
+		// Fail messages are reported in the check run of failCheckStatus.
+		if severity != SEVERITY_FAIL {
+			reportedMessages[severity] = messages
+		}
+	}
+
+	targetEntity := t.GetTargetEntity()
+	title := github.String("Reviewpad report")
+	summary := github.String(buildReport(mode, safeMode, reportedMessages, env.GetReport()))
+	annotations := buildCheckRunAnnotations(env.GetBuiltInsReportedAnnotations())
+
+	env.GetLogger().Infof("creating check run %s with %d annotations", checkRun.GetName(), len(annotations))
+
+	firstAnnotations := annotations
+	if len(firstAnnotations) > MAX_CHECK_RUN_ANNOTATIONS {
+		firstAnnotations = annotations[:MAX_CHECK_RUN_ANNOTATIONS]
+	}
+
+	createdCheckRun, _, err := env.GetGithubClient().GetClientREST().Checks.CreateCheckRun(env.GetCtx(), targetEntity.Owner, targetEntity.Repo, github.CreateCheckRunOptions{
+		Name:       checkRun.GetName(),
+		HeadSHA:    t.GetPullRequest().GetHead().GetSha(),
+		Status:     github.String("completed"),
+		Conclusion: github.String(buildCheckRunConclusion(checkRun, reportedMessages)),
+		Output: &github.CheckRunOutput{
+			Title:       title,
+			Summary:     summary,
+			Annotations: firstAnnotations,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// The annotations beyond the limit of a request are added to the check run in batches.
+	for start := MAX_CHECK_RUN_ANNOTATIONS; start < len(annotations); start += MAX_CHECK_RUN_ANNOTATIONS {
+		end := start + MAX_CHECK_RUN_ANNOTATIONS
+		if end > len(annotations) {
+			end = len(annotations)
+		}
+
+		_, _, err := env.GetGithubClient().GetClientREST().Checks.UpdateCheckRun(env.GetCtx(), targetEntity.Owner, targetEntity.Repo, createdCheckRun.GetID(), github.UpdateCheckRunOptions{
+			Name: checkRun.GetName(),
+			Output: &github.CheckRunOutput{
+				Title:       title,
+				Summary:     summary,
+				Annotations: annotations[start:end],
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// buildCheckRunConclusion returns the conclusion of the most severe messages reported.
+func buildCheckRunConclusion(checkRun *engine.PadCheckRun, reportedMessages map[Severity][]string) string {
+	if len(reportedMessages[SEVERITY_FATAL]) > 0 || len(reportedMessages[SEVERITY_ERROR]) > 0 {
+		return checkRun.GetConclusion(engine.CHECK_RUN_SEVERITY_ERROR)
+	}
+
+	if len(reportedMessages[SEVERITY_WARNING]) > 0 {
+		return checkRun.GetConclusion(engine.CHECK_RUN_SEVERITY_WARNING)
+	}
+
+	if len(reportedMessages[SEVERITY_INFO]) > 0 {
+		return checkRun.GetConclusion(engine.CHECK_RUN_SEVERITY_INFO)
+	}
+
+	return "success"
+}
+
+func buildCheckRunAnnotations(reportedAnnotations []*ReportedAnnotation) []*github.CheckRunAnnotation {
+	annotations := make([]*github.CheckRunAnnotation, len(reportedAnnotations))
+	for i, annotation := range reportedAnnotations {
+		annotations[i] = &github.CheckRunAnnotation{
+			Path:            github.String(annotation.Path),
+			StartLine:       github.Int(annotation.Line),
+			EndLine:         github.Int(annotation.Line),
+			AnnotationLevel: github.String(severityToAnnotationLevel(annotation.Severity)),
+			Message:         github.String(annotation.Message),
+		}
+	}
+
+	return annotations
+}
+
+func severityToAnnotationLevel(severity Severity) string {
+	switch severity {
+	case SEVERITY_FATAL, SEVERITY_ERROR:
+		return "failure"
+	case SEVERITY_WARNING:
+		return "warning"
+	default:
+		return "notice"
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	pbc "github.com/reviewpad/api/go/codehost"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/engine"
+	"github.com/reviewpad/reviewpad/v4/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockCheckRunEnv(t *testing.T, gotCreateRequest *github.CreateCheckRunOptions, gotUpdateRequests *[]*github.UpdateCheckRunOptions) Env {
+	return MockDefaultEnvWithPullRequestAndFiles(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.PostReposCheckRunsByOwnerByRepo,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					rawBody, _ := io.ReadAll(r.Body)
+					utils.MustUnmarshal(rawBody, gotCreateRequest)
+					utils.MustWriteBytes(w, mock.MustMarshal(github.CheckRun{ID: github.Int64(1)}))
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PatchReposCheckRunsByOwnerByRepoByCheckRunId,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "/repos/foobar/default-mock-repo/check-runs/1", r.URL.Path)
+					rawBody, _ := io.ReadAll(r.Body)
+					gotUpdateRequest := &github.UpdateCheckRunOptions{}
+					utils.MustUnmarshal(rawBody, gotUpdateRequest)
+					*gotUpdateRequests = append(*gotUpdateRequests, gotUpdateRequest)
+					utils.MustWriteBytes(w, mock.MustMarshal(github.CheckRun{ID: github.Int64(1)}))
+				}),
+			),
+		},
+		nil,
+		GetDefaultMockPullRequestDetailsWith(&pbc.PullRequest{
+			Head: &pbc.Branch{
+				Repo: &pbc.Repository{
+					Owner: DefaultMockPrOwner,
+					Name:  DefaultMockPrRepoName,
+				},
+				Name: "new-topic",
+				Sha:  "headSha",
+			},
+		}),
+		GetDefaultPullRequestFileList(),
+		MockBuiltIns(),
+		nil,
+	)
+}
+
+func TestReportCheckRun(t *testing.T) {
+	gotCreateRequest := &github.CreateCheckRunOptions{}
+	gotUpdateRequests := []*github.UpdateCheckRunOptions{}
+	mockedEnv := mockCheckRunEnv(t, gotCreateRequest, &gotUpdateRequests)
+
+	assert.Nil(t, ReportMessage(mockedEnv, SEVERITY_WARNING, "This function is too long.", "main.go", 10))
+	assert.Nil(t, ReportMessage(mockedEnv, SEVERITY_WARNING, "Add a test for it.", "", 0))
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err := mockedInterpreter.ReportCheckRun(engine.SILENT_MODE, false, &engine.PadCheckRun{})
+
+	wantCreateRequest := &github.CreateCheckRunOptions{
+		Name:       "reviewpad-report",
+		HeadSHA:    "headSha",
+		Status:     github.String("completed"),
+		Conclusion: github.String("neutral"),
+		Output: &github.CheckRunOutput{
+			Title:   github.String("Reviewpad report"),
+			Summary: github.String(buildReport(engine.SILENT_MODE, false, mockedEnv.GetBuiltInsReportedMessages(), mockedEnv.GetReport())),
+			Annotations: []*github.CheckRunAnnotation{
+				{
+					Path:            github.String("main.go"),
+					StartLine:       github.Int(10),
+					EndLine:         github.Int(10),
+					AnnotationLevel: github.String("warning"),
+					Message:         github.String("This function is too long."),
+				},
+			},
+		},
+	}
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantCreateRequest, gotCreateRequest)
+	assert.Empty(t, gotUpdateRequests)
+}
+
+func TestReportCheckRun_WhenThereAreMoreAnnotationsThanTheLimit(t *testing.T) {
+	gotCreateRequest := &github.CreateCheckRunOptions{}
+	gotUpdateRequests := []*github.UpdateCheckRunOptions{}
+	mockedEnv := mockCheckRunEnv(t, gotCreateRequest, &gotUpdateRequests)
+
+	for i := 1; i <= 120; i++ {
+		assert.Nil(t, ReportMessage(mockedEnv, SEVERITY_ERROR, fmt.Sprintf("Error %d.", i), "main.go", i))
+	}
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err := mockedInterpreter.ReportCheckRun(engine.SILENT_MODE, false, &engine.PadCheckRun{Name: "reviewpad-findings"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "failure", gotCreateRequest.GetConclusion())
+	assert.Equal(t, 50, len(gotCreateRequest.Output.Annotations))
+	assert.Equal(t, 2, len(gotUpdateRequests))
+	assert.Equal(t, "reviewpad-findings", gotUpdateRequests[0].Name)
+	assert.Equal(t, 50, len(gotUpdateRequests[0].Output.Annotations))
+	assert.Equal(t, 20, len(gotUpdateRequests[1].Output.Annotations))
+	assert.Equal(t, "Error 120.", gotUpdateRequests[1].Output.Annotations[19].GetMessage())
+}
+
+func TestReportCheckRun_WhenTargetIsNotPullRequest(t *testing.T) {
+	mockedEnv := MockDefaultEnvWithTargetEntity(t, nil, nil, MockBuiltIns(), nil, &entities.TargetEntity{
+		Owner:  DefaultMockPrOwner,
+		Repo:   DefaultMockPrRepoName,
+		Number: DefaultMockPrNum,
+		Kind:   entities.Issue,
+	})
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err := mockedInterpreter.ReportCheckRun(engine.SILENT_MODE, false, &engine.PadCheckRun{})
+
+	assert.Nil(t, err)
+}
+
+func TestBuildCheckRunConclusion(t *testing.T) {
+	checkRun := &engine.PadCheckRun{
+		Conclusions: map[string]string{
+			"info": "neutral",
+		},
+	}
+
+	tests := map[string]struct {
+		reportedMessages map[Severity][]string
+		wantConclusion   string
+	}{
+		"when there are no messages": {
+			reportedMessages: map[Severity][]string{},
+			wantConclusion:   "success",
+		},
+		"when there are fatal messages": {
+			reportedMessages: map[Severity][]string{SEVERITY_FATAL: {"fatal"}},
+			wantConclusion:   "failure",
+		},
+		"when errors are the most severe": {
+			reportedMessages: map[Severity][]string{SEVERITY_ERROR: {"error"}, SEVERITY_INFO: {"info"}},
+			wantConclusion:   "failure",
+		},
+		"when warnings are the most severe": {
+			reportedMessages: map[Severity][]string{SEVERITY_WARNING: {"warning"}, SEVERITY_INFO: {"info"}},
+			wantConclusion:   "neutral",
+		},
+		"when infos are the most severe": {
+			reportedMessages: map[Severity][]string{SEVERITY_INFO: {"info"}},
+			wantConclusion:   "neutral",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.wantConclusion, buildCheckRunConclusion(checkRun, test.reportedMessages))
+		})
+	}
+}
@@ -9,11 +9,14 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/google/go-github/v52/github"
 	"github.com/reviewpad/go-lib/entities"
 	"github.com/reviewpad/reviewpad/v4/codehost"
 	gh "github.com/reviewpad/reviewpad/v4/codehost/github"
 	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	gitlabTarget "github.com/reviewpad/reviewpad/v4/codehost/gitlab/target"
 	"github.com/reviewpad/reviewpad/v4/collector"
+	"github.com/reviewpad/reviewpad/v4/engine"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/sirupsen/logrus"
 )
@@ -51,26 +54,35 @@ type Env interface {
 	GetCheckRunID() *int64
 	SetCheckRunConclusion(string)
 	GetCheckRunConclusion() string
+	GetCheckRun() *engine.PadCheckRun
+	SetCheckRun(*engine.PadCheckRun)
+	GetPendingReviewComments() []*codehost.ReviewComment
+	AddPendingReviewComment(*codehost.ReviewComment)
+	GetBuiltInsReportedAnnotations() []*ReportedAnnotation
+	AddBuiltInsReportedAnnotation(*ReportedAnnotation)
 }
 
 type BaseEnv struct {
-	BuiltIns                 *BuiltIns
-	BuiltInsReportedMessages map[Severity][]string
-	GithubClient             *gh.GithubClient
-	CodeHostClient           *codehost.CodeHostClient
-	Collector                collector.Collector
-	Ctx                      context.Context
-	DryRun                   bool
-	EventPayload             interface{}
-	RegisterMap              RegisterMap
-	Report                   *Report
-	Target                   codehost.Target
-	Logger                   *logrus.Entry
-	ExecWaitGroup            *sync.WaitGroup
-	ExecMutex                *sync.Mutex
-	ExecFatalErrorOccurred   error
-	CheckRunID               *int64
-	CheckRunConclusion       string
+	BuiltIns                    *BuiltIns
+	BuiltInsReportedMessages    map[Severity][]string
+	GithubClient                *gh.GithubClient
+	CodeHostClient              *codehost.CodeHostClient
+	Collector                   collector.Collector
+	Ctx                         context.Context
+	DryRun                      bool
+	EventPayload                interface{}
+	RegisterMap                 RegisterMap
+	Report                      *Report
+	Target                      codehost.Target
+	Logger                      *logrus.Entry
+	ExecWaitGroup               *sync.WaitGroup
+	ExecMutex                   *sync.Mutex
+	ExecFatalErrorOccurred      error
+	CheckRunID                  *int64
+	CheckRunConclusion          string
+	CheckRun                    *engine.PadCheckRun
+	PendingReviewComments       []*codehost.ReviewComment
+	BuiltInsReportedAnnotations []*ReportedAnnotation
 }
 
 func (e *BaseEnv) GetBuiltIns() *BuiltIns {
@@ -149,6 +161,44 @@ func (e *BaseEnv) GetCheckRunID() *int64 {
 	return e.CheckRunID
 }
 
+// GetCheckRun returns the check run configured in the reviewpad file, if any.
+func (e *BaseEnv) GetCheckRun() *engine.PadCheckRun {
+	return e.CheckRun
+}
+
+func (e *BaseEnv) SetCheckRun(checkRun *engine.PadCheckRun) {
+	e.CheckRun = checkRun
+}
+
+// GetPendingReviewComments returns the review comments added by the actions of the run
+// that are yet to be submitted.
+func (e *BaseEnv) GetPendingReviewComments() []*codehost.ReviewComment {
+	e.ExecMutex.Lock()
+	defer e.ExecMutex.Unlock()
+	return e.PendingReviewComments
+}
+
+// AddPendingReviewComment adds a review comment to the review submitted at the end of the run.
+func (e *BaseEnv) AddPendingReviewComment(comment *codehost.ReviewComment) {
+	e.ExecMutex.Lock()
+	defer e.ExecMutex.Unlock()
+	e.PendingReviewComments = append(e.PendingReviewComments, comment)
+}
+
+// GetBuiltInsReportedAnnotations returns the messages reported on lines of files during the run.
+func (e *BaseEnv) GetBuiltInsReportedAnnotations() []*ReportedAnnotation {
+	e.ExecMutex.Lock()
+	defer e.ExecMutex.Unlock()
+	return e.BuiltInsReportedAnnotations
+}
+
+// AddBuiltInsReportedAnnotation adds a message reported on a line of a file to the annotations of the check run.
+func (e *BaseEnv) AddBuiltInsReportedAnnotation(annotation *ReportedAnnotation) {
+	e.ExecMutex.Lock()
+	defer e.ExecMutex.Unlock()
+	e.BuiltInsReportedAnnotations = append(e.BuiltInsReportedAnnotations, annotation)
+}
+
 func NewTypeEnv(e Env) TypeEnv {
 	builtInsType := make(map[string]lang.Type)
 	for builtInName, builtInFunction := range e.GetBuiltIns().Functions {
@@ -201,6 +251,23 @@ func NewEvalEnv(
 		CheckRunID:               checkRunID,
 	}
 
+	t, err := loadTarget(ctx, githubClient, codeHostClient, targetEntity, eventPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	input.Target = t
+
+	return input, nil
+}
+
+// loadTarget fetches the target of the target entity from its code host.
+// It returns nil when the kind of the target entity is not supported.
+func loadTarget(ctx context.Context, githubClient *gh.GithubClient, codeHostClient *codehost.CodeHostClient, targetEntity *entities.TargetEntity, eventPayload interface{}) (codehost.Target, error) {
+	if codeHostClient != nil && codeHostClient.GitlabClient != nil {
+		return loadGitlabTarget(ctx, codeHostClient, targetEntity)
+	}
+
 	switch targetEntity.Kind {
 	case entities.Issue:
 		issue, _, err := githubClient.GetIssue(ctx, targetEntity.Owner, targetEntity.Repo, targetEntity.Number)
@@ -208,19 +275,48 @@ func NewEvalEnv(
 			return nil, err
 		}
 
-		input.Target = target.NewIssueTarget(ctx, targetEntity, githubClient, issue)
+		return target.NewIssueTarget(ctx, targetEntity, githubClient, issue), nil
 	case entities.PullRequest:
 		pullRequest, err := codeHostClient.GetPullRequest(ctx, fmt.Sprintf("%s/%s", targetEntity.Owner, targetEntity.Repo), int64(targetEntity.Number))
 		if err != nil {
 			return nil, err
 		}
 
-		pullRequestTarget, err := target.NewPullRequestTarget(ctx, targetEntity, githubClient, codeHostClient, pullRequest)
+		return target.NewPullRequestTarget(ctx, targetEntity, githubClient, codeHostClient, pullRequest)
+	case codehost.Branch:
+		pushEvent, ok := eventPayload.(*github.PushEvent)
+		if !ok {
+			return nil, fmt.Errorf("branch target requires a push event but got %T", eventPayload)
+		}
+
+		return target.NewBranchTarget(ctx, targetEntity, githubClient, pushEvent), nil
+	case entities.Discussion:
+		discussion, err := githubClient.GetDiscussion(ctx, targetEntity.Owner, targetEntity.Repo, targetEntity.Number)
+		if err != nil {
+			return nil, err
+		}
+
+		return target.NewDiscussionTarget(ctx, targetEntity, githubClient, discussion), nil
+	case codehost.Release:
+		release, _, err := githubClient.GetRelease(ctx, targetEntity.Owner, targetEntity.Repo, int64(targetEntity.Number))
 		if err != nil {
 			return nil, err
 		}
-		input.Target = pullRequestTarget
+
+		return target.NewReleaseTarget(ctx, targetEntity, githubClient, release), nil
+	default:
+		return nil, nil
 	}
+}
 
-	return input, nil
+// loadGitlabTarget fetches the merge request or issue of the target entity.
+func loadGitlabTarget(ctx context.Context, codeHostClient *codehost.CodeHostClient, targetEntity *entities.TargetEntity) (codehost.Target, error) {
+	switch targetEntity.Kind {
+	case entities.Issue:
+		return gitlabTarget.LoadIssueTarget(ctx, targetEntity, codeHostClient.GitlabClient)
+	case entities.PullRequest:
+		return gitlabTarget.LoadMergeRequestTarget(ctx, targetEntity, codeHostClient.GitlabClient)
+	default:
+		return nil, fmt.Errorf("unsupported gitlab target kind %v", targetEntity.Kind)
+	}
 }
@@ -7,8 +7,11 @@ package aladino_test
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
 
+	"github.com/google/go-github/v52/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
 	pbc "github.com/reviewpad/api/go/codehost"
 	"github.com/reviewpad/go-lib/entities"
 	gh "github.com/reviewpad/reviewpad/v4/codehost/github"
@@ -63,6 +66,55 @@ func TestNewEvalEnv_WhenGetPullRequestFilesFails(t *testing.T) {
 	assert.Equal(t, err.Error(), failMessage)
 }
 
+func TestNewEvalEnv_WhenBranchEventIsNotPush(t *testing.T) {
+	env, err := aladino.NewEvalEnv(
+		context.Background(),
+		aladino.DefaultMockLogger,
+		false,
+		nil,
+		nil,
+		aladino.DefaultMockCollector,
+		aladino.DefaultMockBranchTargetEntity,
+		&github.IssuesEvent{},
+		aladino.MockBuiltIns(),
+		nil,
+	)
+
+	assert.Nil(t, env)
+	assert.EqualError(t, err, "branch target requires a push event but got *github.IssuesEvent")
+}
+
+func TestNewEvalEnv_WhenGetReleaseFails(t *testing.T) {
+	failMessage := "GetReleaseRequestFail"
+	mockedGithubClient := aladino.MockDefaultGithubClient(
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.GetReposReleasesByOwnerByRepoByReleaseId,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					mock.WriteError(w, http.StatusInternalServerError, failMessage)
+				}),
+			),
+		},
+		nil,
+	)
+
+	env, err := aladino.NewEvalEnv(
+		context.Background(),
+		aladino.DefaultMockLogger,
+		false,
+		mockedGithubClient,
+		nil,
+		aladino.DefaultMockCollector,
+		aladino.DefaultMockReleaseTargetEntity,
+		nil,
+		aladino.MockBuiltIns(),
+		nil,
+	)
+
+	assert.Nil(t, env)
+	assert.Equal(t, err.(*github.ErrorResponse).Message, failMessage)
+}
+
 func TestNewEvalEnv_WhenNewFileFails(t *testing.T) {
 	codehostClient := aladino.GetDefaultCodeHostClientWithFiles(t, []*pbc.File{
 		{
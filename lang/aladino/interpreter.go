@@ -196,6 +196,11 @@ func (i *Interpreter) ExecProgram(program *engine.Program) (engine.ExitStatus, e
 		return engine.ExitStatusFailure, i.Env.GetExecFatalErrorOccurred()
 	}
 
+	err := submitPendingReview(i.Env)
+	if err != nil {
+		return engine.ExitStatusFailure, err
+	}
+
 	return retStatus, retErr
 }
 
@@ -218,29 +223,14 @@ func (i *Interpreter) ExecStatement(statement *engine.Statement) error {
 		}
 
 		// update the target
-		targetEntity := i.Env.GetTarget().GetTargetEntity()
-		githubClient := i.Env.GetGithubClient()
-		codeHostClient := i.Env.GetCodeHostClient()
-		ctx := i.Env.GetCtx()
-		switch targetEntity.Kind {
-		case entities.Issue:
-			issue, _, err := githubClient.GetIssue(ctx, targetEntity.Owner, targetEntity.Repo, targetEntity.Number)
-			if err != nil {
-				return err
-			}
-
-			i.Env.(*BaseEnv).Target = target.NewIssueTarget(ctx, targetEntity, githubClient, issue)
-		case entities.PullRequest:
-			pullRequest, err := codeHostClient.GetPullRequest(ctx, fmt.Sprintf("%s/%s", targetEntity.Owner, targetEntity.Repo), int64(targetEntity.Number))
-			if err != nil {
-				return err
-			}
+		env := i.Env
+		t, err := loadTarget(env.GetCtx(), env.GetGithubClient(), env.GetCodeHostClient(), env.GetTarget().GetTargetEntity(), env.GetEventPayload())
+		if err != nil {
+			return err
+		}
 
-			pullRequestTarget, err := target.NewPullRequestTarget(ctx, targetEntity, githubClient, codeHostClient, pullRequest)
-			if err != nil {
-				return err
-			}
-			i.Env.(*BaseEnv).Target = pullRequestTarget
+		if t != nil {
+			env.(*BaseEnv).Target = t
 		}
 	}
 
@@ -260,6 +250,31 @@ func (i *Interpreter) Report(mode string, safeMode bool) error {
 
 	env := i.Env
 
+	// Releases have no conversation where the report can be added.
+	if env.GetTarget().GetTargetEntity().Kind == codehost.Release {
+		return nil
+	}
+
+	// Pushes are only evaluated once, so the report is a new comment on the pushed commit.
+	if env.GetTarget().GetTargetEntity().Kind == codehost.Branch {
+		reportComments := env.GetBuiltInsReportedMessages()
+		delete(reportComments, SEVERITY_FAIL)
+
+		if mode == engine.SILENT_MODE && len(reportComments) == 0 && !safeMode {
+			return nil
+		}
+
+		return env.GetTarget().Comment(buildReport(mode, safeMode, reportComments, env.GetReport()))
+	}
+
+	if discussionTarget, ok := env.GetTarget().(*target.DiscussionTarget); ok {
+		return reportOnDiscussion(env, discussionTarget, mode, safeMode)
+	}
+
+	if editor, ok := env.GetTarget().(commentEditor); ok {
+		return reportWithCommentEditor(env, editor, mode, safeMode)
+	}
+
 	var err error
 
 	comment, err := FindReportCommentByAnnotation(env, ReviewpadReportCommentAnnotation)
@@ -289,12 +304,8 @@ func (i *Interpreter) Report(mode string, safeMode bool) error {
 }
 
 func (i *Interpreter) ReportMetrics() error {
-	targetEntity := i.Env.GetTarget().GetTargetEntity()
-	owner := targetEntity.Owner
-	prNum := targetEntity.Number
-	repo := targetEntity.Repo
-	ctx := i.Env.GetCtx()
-	pr := i.Env.GetTarget().(*target.PullRequestTarget).PullRequest
+	t := i.Env.GetTarget().(codehost.PullRequest)
+	pr := t.GetPullRequest()
 
 	if !pr.IsMerged {
 		return nil
@@ -302,7 +313,7 @@ func (i *Interpreter) ReportMetrics() error {
 
 	report := strings.Builder{}
 
-	firstCommitDate, firstReviewDate, err := i.Env.GetGithubClient().GetFirstCommitAndReviewDate(ctx, owner, repo, prNum)
+	firstCommitDate, firstReviewDate, err := t.GetFirstCommitAndReviewDate()
 	if err != nil {
 		return err
 	}
@@ -346,6 +357,10 @@ func (i *Interpreter) GetCheckRunConclusion() string {
 	return i.Env.GetCheckRunConclusion()
 }
 
+func (i *Interpreter) SetCheckRun(checkRun *engine.PadCheckRun) {
+	i.Env.SetCheckRun(checkRun)
+}
+
 func NewInterpreter(
 	ctx context.Context,
 	logger *logrus.Entry,
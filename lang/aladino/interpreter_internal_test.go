@@ -716,6 +716,160 @@ func TestReport_OnVerboseMode_WhenNoReviewpadCommentIsFound(t *testing.T) {
 	assert.Equal(t, commentToBeAdded, addedComment)
 }
 
+func TestReport_OnBranch(t *testing.T) {
+	pushEvent := GetDefaultMockPushEvent()
+	var addedComment string
+	mockedEnv := MockDefaultEnvWithTargetEntity(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.PostReposCommitsCommentsByOwnerByRepoByCommitSha,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, fmt.Sprintf("/repos/%v/%v/commits/%v/comments", DefaultMockPrOwner, DefaultMockPrRepoName, pushEvent.GetAfter()), r.URL.Path)
+
+					rawBody, _ := io.ReadAll(r.Body)
+					body := github.RepositoryComment{}
+
+					utils.MustUnmarshal(rawBody, &body)
+
+					addedComment = *body.Body
+				}),
+			),
+		},
+		nil,
+		MockBuiltIns(),
+		pushEvent,
+		DefaultMockBranchTargetEntity,
+	)
+
+	mockedEnv.GetBuiltInsReportedMessages()[SEVERITY_ERROR] = []string{"CHANGELOG.md was not updated"}
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err := mockedInterpreter.Report(engine.SILENT_MODE, false)
+
+	assert.Nil(t, err)
+	assert.Contains(t, addedComment, ReviewpadReportCommentAnnotation)
+	assert.Contains(t, addedComment, "CHANGELOG.md was not updated")
+}
+
+func TestReport_OnBranch_OnSilentMode_WhenThereAreNoMessages(t *testing.T) {
+	mockedEnv := MockDefaultEnvWithTargetEntity(t, nil, nil, MockBuiltIns(), GetDefaultMockPushEvent(), DefaultMockBranchTargetEntity)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	// Without mocked endpoints any request fails.
+	err := mockedInterpreter.Report(engine.SILENT_MODE, false)
+
+	assert.Nil(t, err)
+}
+
+func TestReport_OnRelease(t *testing.T) {
+	mockedEnv := MockDefaultEnvWithTargetEntity(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatch(
+				mock.GetReposReleasesByOwnerByRepoByReleaseId,
+				GetDefaultMockRelease(),
+			),
+		},
+		nil,
+		MockBuiltIns(),
+		nil,
+		DefaultMockReleaseTargetEntity,
+	)
+
+	mockedEnv.GetBuiltInsReportedMessages()[SEVERITY_ERROR] = []string{"release notes are missing"}
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	// Only the release is mocked, so the report fails if it makes any other request.
+	err := mockedInterpreter.Report(engine.VERBOSE_MODE, false)
+
+	assert.Nil(t, err)
+}
+
+func TestReport_OnDiscussion(t *testing.T) {
+	var gotMutation string
+	mockedEnv := MockDefaultEnvWithTargetEntity(
+		t,
+		nil,
+		MockDiscussionGraphQLHandler("[]", func(w http.ResponseWriter, query string) {
+			gotMutation = query
+			utils.MustWrite(w, `{"data": {"addDiscussionComment": {"clientMutationId": ""}}}`)
+		}),
+		MockBuiltIns(),
+		nil,
+		DefaultMockDiscussionTargetEntity,
+	)
+
+	mockedEnv.GetBuiltInsReportedMessages()[SEVERITY_WARNING] = []string{"discussion has no category"}
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err := mockedInterpreter.Report(engine.SILENT_MODE, false)
+
+	assert.Nil(t, err)
+	assert.Contains(t, gotMutation, "addDiscussionComment")
+	assert.Contains(t, gotMutation, "discussionhasnocategory")
+}
+
+func TestReport_OnDiscussion_WhenThereIsAlreadyAReport(t *testing.T) {
+	tests := map[string]struct {
+		reportedMessages map[Severity][]string
+		wantMutation     string
+	}{
+		"when there are messages": {
+			reportedMessages: map[Severity][]string{SEVERITY_WARNING: {"discussion has no category"}},
+			wantMutation:     "updateDiscussionComment",
+		},
+		"when there are no messages": {
+			reportedMessages: map[Severity][]string{},
+			wantMutation:     "deleteDiscussionComment",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotMutation string
+			comments := fmt.Sprintf(`[{"id": "DC_report", "body": "%v\\n**Reviewpad Report**", "author": {"login": "reviewpad-bot"}}]`, ReviewpadReportCommentAnnotation)
+			mockedEnv := MockDefaultEnvWithTargetEntity(
+				t,
+				nil,
+				MockDiscussionGraphQLHandler(comments, func(w http.ResponseWriter, query string) {
+					gotMutation = query
+					utils.MustWrite(w, `{"data": {}}`)
+				}),
+				MockBuiltIns(),
+				nil,
+				DefaultMockDiscussionTargetEntity,
+			)
+
+			for severity, messages := range test.reportedMessages {
+				mockedEnv.GetBuiltInsReportedMessages()[severity] = messages
+			}
+
+			mockedInterpreter := &Interpreter{
+				Env: mockedEnv,
+			}
+
+			err := mockedInterpreter.Report(engine.SILENT_MODE, false)
+
+			assert.Nil(t, err)
+			assert.Contains(t, gotMutation, test.wantMutation)
+			assert.Contains(t, gotMutation, `"DC_report"`)
+		})
+	}
+}
+
 func TestReport_OnVerboseMode_WhenThereIsAlreadyAReviewpadComment(t *testing.T) {
 	var updatedComment string
 	commentUpdated := fmt.Sprintf("%s\n**Reviewpad Report**\n\n:scroll: **Executed actions**\n```yaml\nNo actions executed\n```\n", ReviewpadReportCommentAnnotation)
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -38,6 +39,9 @@ const DefaultMockPrRepoName = "default-mock-repo"
 const DefaultMockEventName = "pull_request"
 const DefaultMockEventAction = "opened"
 const DefaultMockEntityNodeID = "test"
+const DefaultMockReleaseID = 42
+const DefaultMockDiscussionNum = 82
+const DefaultMockDiscussionNodeID = "D_kwDOJRdjrs4AT7xD"
 
 var DefaultMockPrDate = time.Date(2009, 11, 17, 20, 34, 58, 651387237, time.UTC)
 var DefaultMockContext = context.Background()
@@ -49,6 +53,23 @@ var DefaultMockTargetEntity = &entities.TargetEntity{
 	Number: DefaultMockPrNum,
 	Kind:   entities.PullRequest,
 }
+var DefaultMockBranchTargetEntity = &entities.TargetEntity{
+	Owner: DefaultMockPrOwner,
+	Repo:  DefaultMockPrRepoName,
+	Kind:  codehost.Branch,
+}
+var DefaultMockReleaseTargetEntity = &entities.TargetEntity{
+	Owner:  DefaultMockPrOwner,
+	Repo:   DefaultMockPrRepoName,
+	Number: DefaultMockReleaseID,
+	Kind:   codehost.Release,
+}
+var DefaultMockDiscussionTargetEntity = &entities.TargetEntity{
+	Owner:  DefaultMockPrOwner,
+	Repo:   DefaultMockPrRepoName,
+	Number: DefaultMockDiscussionNum,
+	Kind:   entities.Discussion,
+}
 var DefaultMockEventDetails = &entities.EventDetails{
 	EventName:   DefaultMockEventName,
 	EventAction: DefaultMockEventAction,
@@ -83,6 +104,92 @@ func GetDefaultMockIssueDetails() *github.Issue {
 	}
 }
 
+// GetDefaultMockPushEvent returns a push of two commits to the release branch.
+func GetDefaultMockPushEvent() *github.PushEvent {
+	return &github.PushEvent{
+		Ref:    github.String("refs/heads/release"),
+		Before: github.String("4bf24cc72f3a62423927a0ac8d70febad7c78e0a"),
+		After:  github.String("6e1e8a8a5bd3c2bd6b2a0f6c2de4fd0dcae1c9e2"),
+		Repo: &github.PushEventRepository{
+			Name:          github.String(DefaultMockPrRepoName),
+			DefaultBranch: github.String("main"),
+			Owner: &github.User{
+				Login: github.String(DefaultMockPrOwner),
+			},
+		},
+		Pusher: &github.User{
+			Name: github.String("john"),
+		},
+		Sender: &github.User{
+			Login: github.String("john"),
+		},
+	}
+}
+
+// GetDefaultMockRelease returns the release of the v1.1.0 tag.
+func GetDefaultMockRelease() *github.RepositoryRelease {
+	return &github.RepositoryRelease{
+		ID:        github.Int64(DefaultMockReleaseID),
+		TagName:   github.String("v1.1.0"),
+		Name:      github.String("v1.1.0"),
+		Body:      github.String(""),
+		CreatedAt: &github.Timestamp{Time: DefaultMockPrDate},
+		Author:    &github.User{Login: github.String("john")},
+	}
+}
+
+// MockDiscussionGraphQLHandler answers the queries of the default mock discussion with the comments nodes
+// and passes the minified body of any other request, e.g. a mutation, to the handler.
+func MockDiscussionGraphQLHandler(comments string, handler func(w http.ResponseWriter, query string)) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, req *http.Request) {
+		query := utils.MinifyQuery(utils.MustRead(req.Body))
+
+		switch {
+		case strings.Contains(query, "comments(first:50,after:$afterCursor)"):
+			utils.MustWrite(w, fmt.Sprintf(`{
+				"data": {
+					"repository": {
+						"discussion": {
+							"id": "%v",
+							"body": "How can I lint the commits of a push?",
+							"author": {"login": "john"},
+							"comments": {
+								"pageInfo": {"hasNextPage": false},
+								"nodes": %v
+							}
+						}
+					}
+				}
+			}`, DefaultMockDiscussionNodeID, comments))
+		case strings.Contains(query, "discussion(number:$number)"):
+			utils.MustWrite(w, fmt.Sprintf(`{
+				"data": {
+					"repository": {
+						"discussion": {
+							"id": "%v",
+							"number": %v,
+							"title": "Linting pushes",
+							"body": "How can I lint the commits of a push?",
+							"url": "https://github.com/foobar/default-mock-repo/discussions/82",
+							"isAnswered": false,
+							"closed": false,
+							"locked": false,
+							"createdAt": "2009-11-17T20:34:58Z",
+							"updatedAt": "2009-11-17T20:34:58Z",
+							"author": {"login": "john"},
+							"category": {"name": "Q&A"},
+							"labels": {"nodes": [{"id": "LA_question", "name": "question"}]},
+							"comments": {"totalCount": 1}
+						}
+					}
+				}
+			}`, DefaultMockDiscussionNodeID, DefaultMockDiscussionNum))
+		default:
+			handler(w, query)
+		}
+	}
+}
+
 func MockBuiltIns() *BuiltIns {
 	return &BuiltIns{
 		Functions: map[string]*BuiltInFunction{
@@ -10,6 +10,9 @@ import (
 	"strings"
 
 	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	gh "github.com/reviewpad/reviewpad/v4/codehost/github"
+	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
 	"github.com/reviewpad/reviewpad/v4/engine"
 )
 
@@ -127,6 +130,10 @@ func BuildDryRunExecutionReport(program *engine.Program) string {
 }
 
 func DeleteReportComment(env Env, commentId int64) error {
+	if editor, ok := env.GetTarget().(commentEditor); ok {
+		return editor.DeleteComment(commentId)
+	}
+
 	t := env.GetTarget().GetTargetEntity()
 	owner := t.Owner
 	repo := t.Repo
@@ -140,6 +147,10 @@ func DeleteReportComment(env Env, commentId int64) error {
 }
 
 func UpdateReportComment(env Env, commentId int64, report string) error {
+	if editor, ok := env.GetTarget().(commentEditor); ok {
+		return editor.EditComment(commentId, report)
+	}
+
 	gitHubComment := github.IssueComment{
 		Body: &report,
 	}
@@ -158,6 +169,10 @@ func UpdateReportComment(env Env, commentId int64, report string) error {
 }
 
 func AddReportComment(env Env, report string) error {
+	if editor, ok := env.GetTarget().(commentEditor); ok {
+		return editor.Comment(report)
+	}
+
 	gitHubComment := github.IssueComment{
 		Body: &report,
 	}
@@ -177,6 +192,26 @@ func AddReportComment(env Env, report string) error {
 }
 
 func FindReportCommentByAnnotation(env Env, annotation string) (*github.IssueComment, error) {
+	reviewpadCommentAnnotationRegex := regexp.MustCompile(fmt.Sprintf("^%v", annotation))
+
+	if editor, ok := env.GetTarget().(commentEditor); ok {
+		comments, err := editor.GetComments()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, comment := range comments {
+			if reviewpadCommentAnnotationRegex.MatchString(comment.Body) {
+				return &github.IssueComment{
+					ID:   github.Int64(comment.ID),
+					Body: github.String(comment.Body),
+				}, nil
+			}
+		}
+
+		return nil, nil
+	}
+
 	t := env.GetTarget().GetTargetEntity()
 	owner := t.Owner
 	repo := t.Repo
@@ -190,8 +225,6 @@ func FindReportCommentByAnnotation(env Env, annotation string) (*github.IssueCom
 		return nil, fmt.Errorf("error getting issues %v", err.(*github.ErrorResponse).Message)
 	}
 
-	reviewpadCommentAnnotationRegex := regexp.MustCompile(fmt.Sprintf("^%v", annotation))
-
 	var reviewpadExistingComment *github.IssueComment
 
 	for _, comment := range comments {
@@ -204,3 +237,87 @@ func FindReportCommentByAnnotation(env Env, annotation string) (*github.IssueCom
 
 	return reviewpadExistingComment, nil
 }
+
+// commentEditor is a target whose comments are edited and deleted through the target,
+// e.g. GitLab merge requests and issues, whose comments are notes rather than GitHub issue comments.
+type commentEditor interface {
+	GetComments() ([]*codehost.Comment, error)
+	Comment(comment string) error
+	EditComment(commentID int64, comment string) error
+	DeleteComment(commentID int64) error
+}
+
+// reportWithCommentEditor adds, updates or deletes the report comment of the target.
+func reportWithCommentEditor(env Env, t commentEditor, mode string, safeMode bool) error {
+	comments, err := t.GetComments()
+	if err != nil {
+		return err
+	}
+
+	var comment *codehost.Comment
+
+	for _, c := range comments {
+		if strings.HasPrefix(c.Body, ReviewpadReportCommentAnnotation) {
+			comment = c
+			break
+		}
+	}
+
+	reportComments := env.GetBuiltInsReportedMessages()
+
+	// Since fail messages aren't supposed to be reported, we remove them from the report
+	delete(reportComments, SEVERITY_FAIL)
+
+	if mode == engine.SILENT_MODE && len(reportComments) == 0 && !safeMode {
+		if comment != nil {
+			return t.DeleteComment(comment.ID)
+		}
+		return nil
+	}
+
+	report := buildReport(mode, safeMode, reportComments, env.GetReport())
+
+	if comment == nil {
+		return t.Comment(report)
+	}
+
+	return t.EditComment(comment.ID, report)
+}
+
+// reportOnDiscussion adds, updates or deletes the report comment of the discussion.
+// Discussions have no issue comments, so the report is a discussion comment.
+func reportOnDiscussion(env Env, t *target.DiscussionTarget, mode string, safeMode bool) error {
+	comments, err := t.GetDiscussionComments()
+	if err != nil {
+		return err
+	}
+
+	var comment *gh.DiscussionComment
+
+	for i := range comments {
+		if strings.HasPrefix(comments[i].Body, ReviewpadReportCommentAnnotation) {
+			comment = &comments[i]
+			break
+		}
+	}
+
+	reportComments := env.GetBuiltInsReportedMessages()
+
+	// Since fail messages aren't supposed to be reported, we remove them from the report
+	delete(reportComments, SEVERITY_FAIL)
+
+	if mode == engine.SILENT_MODE && len(reportComments) == 0 && !safeMode {
+		if comment != nil {
+			return env.GetGithubClient().DeleteDiscussionComment(env.GetCtx(), comment.ID)
+		}
+		return nil
+	}
+
+	report := buildReport(mode, safeMode, reportComments, env.GetReport())
+
+	if comment == nil {
+		return t.Comment(report)
+	}
+
+	return env.GetGithubClient().UpdateDiscussionComment(env.GetCtx(), comment.ID, report)
+}
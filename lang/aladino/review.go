@@ -0,0 +1,59 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"fmt"
+
+	"github.com/reviewpad/reviewpad/v4/codehost"
+)
+
+// submitPendingReview submits the review comments added during the run in a single review.
+// The comments that are already on the pull request are not added again.
+func submitPendingReview(env Env) error {
+	pendingComments := env.GetPendingReviewComments()
+	if len(pendingComments) == 0 {
+		return nil
+	}
+
+	t, ok := env.GetTarget().(codehost.PullRequest)
+	if !ok {
+		return nil
+	}
+
+	existingComments, err := t.GetReviewComments()
+	if err != nil {
+		return err
+	}
+
+	added := make(map[string]bool)
+	for _, comment := range existingComments {
+		added[reviewCommentKey(comment)] = true
+	}
+
+	comments := make([]*codehost.ReviewComment, 0, len(pendingComments))
+	for _, comment := range pendingComments {
+		key := reviewCommentKey(comment)
+		if added[key] {
+			continue
+		}
+
+		added[key] = true
+		comments = append(comments, comment)
+	}
+
+	if len(comments) == 0 {
+		env.GetLogger().Info("skipping review because all the review comments were already added")
+		return nil
+	}
+
+	env.GetLogger().Infof("creating review with %d comments", len(comments))
+
+	return t.ReviewWithComments("COMMENT", fmt.Sprintf("%d new review comment(s).", len(comments)), comments)
+}
+
+func reviewCommentKey(comment *codehost.ReviewComment) string {
+	return fmt.Sprintf("%s:%d:%s", comment.Path, comment.Line, comment.Body)
+}
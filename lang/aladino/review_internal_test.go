@@ -0,0 +1,184 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/engine"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmitPendingReview_WhenThereAreNoPendingComments(t *testing.T) {
+	mockedEnv := MockDefaultEnv(t, nil, nil, MockBuiltIns(), nil)
+
+	err := submitPendingReview(mockedEnv)
+
+	assert.Nil(t, err)
+}
+
+func TestSubmitPendingReview(t *testing.T) {
+	var gotReview *github.PullRequestReviewRequest
+	mockedEnv := MockDefaultEnv(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatch(
+				mock.GetReposPullsCommentsByOwnerByRepoByPullNumber,
+				[]*github.PullRequestComment{
+					{
+						Path: github.String("default-mock-repo/file1.ts"),
+						Line: github.Int(2),
+						Body: github.String("Please rename this function."),
+					},
+				},
+			),
+			mock.WithRequestMatchHandler(
+				mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					rawBody, _ := io.ReadAll(r.Body)
+					utils.MustUnmarshal(rawBody, &gotReview)
+					utils.MustWriteBytes(w, mock.MustMarshal(github.PullRequestReview{}))
+				}),
+			),
+		},
+		nil,
+		MockBuiltIns(),
+		nil,
+	)
+
+	mockedEnv.AddPendingReviewComment(&codehost.ReviewComment{
+		Path: "default-mock-repo/file1.ts",
+		Line: 2,
+		Body: "Please rename this function.",
+	})
+	mockedEnv.AddPendingReviewComment(&codehost.ReviewComment{
+		Path: "default-mock-repo/file2.ts",
+		Line: 3,
+		Body: "Please remove this line.",
+	})
+	mockedEnv.AddPendingReviewComment(&codehost.ReviewComment{
+		Path: "default-mock-repo/file2.ts",
+		Line: 3,
+		Body: "Please remove this line.",
+	})
+
+	err := submitPendingReview(mockedEnv)
+
+	wantReview := &github.PullRequestReviewRequest{
+		Body:  github.String("1 new review comment(s)."),
+		Event: github.String("COMMENT"),
+		Comments: []*github.DraftReviewComment{
+			{
+				Path: github.String("default-mock-repo/file2.ts"),
+				Line: github.Int(3),
+				Side: github.String("RIGHT"),
+				Body: github.String("Please remove this line."),
+			},
+		},
+	}
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantReview, gotReview)
+}
+
+func TestSubmitPendingReview_WhenAllCommentsWereAlreadyAdded(t *testing.T) {
+	isReviewCreated := false
+	mockedEnv := MockDefaultEnv(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatch(
+				mock.GetReposPullsCommentsByOwnerByRepoByPullNumber,
+				[]*github.PullRequestComment{
+					{
+						Path: github.String("default-mock-repo/file1.ts"),
+						Line: github.Int(2),
+						Body: github.String("Please rename this function."),
+					},
+				},
+			),
+			mock.WithRequestMatchHandler(
+				mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					isReviewCreated = true
+				}),
+			),
+		},
+		nil,
+		MockBuiltIns(),
+		nil,
+	)
+
+	mockedEnv.AddPendingReviewComment(&codehost.ReviewComment{
+		Path: "default-mock-repo/file1.ts",
+		Line: 2,
+		Body: "Please rename this function.",
+	})
+
+	err := submitPendingReview(mockedEnv)
+
+	assert.Nil(t, err)
+	assert.False(t, isReviewCreated)
+}
+
+func TestExecProgram_WhenThereArePendingReviewComments(t *testing.T) {
+	var gotReview *github.PullRequestReviewRequest
+	builtIns := MockBuiltIns()
+	builtIns.Actions["addReviewComment"] = &BuiltInAction{
+		Type: lang.BuildFunctionType([]lang.Type{lang.BuildStringType()}, nil),
+		Code: func(e Env, args []lang.Value) error {
+			e.AddPendingReviewComment(&codehost.ReviewComment{
+				Path: "default-mock-repo/file1.ts",
+				Line: 2,
+				Body: args[0].(*lang.StringValue).Val,
+			})
+			return nil
+		},
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest},
+	}
+
+	mockedEnv := MockDefaultEnv(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatch(
+				mock.GetReposPullsCommentsByOwnerByRepoByPullNumber,
+				[]*github.PullRequestComment{},
+			),
+			mock.WithRequestMatchHandler(
+				mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					rawBody, _ := io.ReadAll(r.Body)
+					utils.MustUnmarshal(rawBody, &gotReview)
+					utils.MustWriteBytes(w, mock.MustMarshal(github.PullRequestReview{}))
+				}),
+			),
+		},
+		nil,
+		builtIns,
+		nil,
+	)
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	program := engine.BuildProgram([]*engine.Statement{
+		engine.BuildStatement(`$addReviewComment("first")`),
+		engine.BuildStatement(`$addReviewComment("second")`),
+	})
+
+	exitStatus, err := mockedInterpreter.ExecProgram(program)
+
+	assert.Nil(t, err)
+	assert.Equal(t, engine.ExitStatusSuccess, exitStatus)
+	assert.Equal(t, 2, len(gotReview.Comments))
+}
@@ -14,7 +14,7 @@ func AddLabel() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
 		Type:           lang.BuildFunctionType([]lang.Type{lang.BuildStringType()}, nil),
 		Code:           addLabelCode,
-		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue},
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue, entities.Discussion},
 	}
 }
 
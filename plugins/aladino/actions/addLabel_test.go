@@ -129,3 +129,44 @@ func TestAddLabel_WhenLabelIsNotInEnvironment(t *testing.T) {
 	assert.Nil(t, err)
 	assert.ElementsMatch(t, wantLabels, gotLabels)
 }
+
+func TestAddLabel_OnDiscussion(t *testing.T) {
+	var gotMutation string
+	mockedAddLabelsToLabelableMutation := `{
+		"query": "mutation($input:AddLabelsToLabelableInput!) {
+			addLabelsToLabelable(input: $input) {
+				clientMutationId
+			}
+		}",
+		"variables": {
+			"input": {
+				"labelableId": "D_kwDOJRdjrs4AT7xD",
+				"labelIds": ["LA_bug"]
+			}
+		}
+	}`
+	mockedEnv := aladino.MockDefaultEnvWithTargetEntity(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatch(
+				mock.GetReposLabelsByOwnerByRepoByName,
+				&github.Label{
+					Name:   github.String("bug"),
+					NodeID: github.String("LA_bug"),
+				},
+			),
+		},
+		aladino.MockDiscussionGraphQLHandler("[]", func(w http.ResponseWriter, query string) {
+			gotMutation = query
+			utils.MustWrite(w, `{"data": {"addLabelsToLabelable": {"clientMutationId": ""}}}`)
+		}),
+		aladino.MockBuiltIns(),
+		nil,
+		aladino.DefaultMockDiscussionTargetEntity,
+	)
+
+	err := addLabel(mockedEnv, []lang.Value{lang.BuildStringValue("bug")})
+
+	assert.Nil(t, err)
+	assert.Equal(t, utils.MinifyQuery(mockedAddLabelsToLabelableMutation), gotMutation)
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	gh "github.com/reviewpad/reviewpad/v4/codehost/github"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
@@ -22,6 +23,10 @@ func AddToProject() *aladino.BuiltInAction {
 }
 
 func addToProjectCode(e aladino.Env, args []lang.Value) error {
+	if e.GetGithubClient() == nil {
+		return codehost.ErrNotSupported
+	}
+
 	target := e.GetTarget()
 	entity := target.GetTargetEntity()
 	owner := entity.Owner
@@ -5,7 +5,6 @@
 package plugins_aladino_actions
 
 import (
-	"context"
 	"fmt"
 	"math/rand"
 	"strings"
@@ -13,8 +12,6 @@ import (
 	pbc "github.com/reviewpad/api/go/codehost"
 	"github.com/reviewpad/go-lib/entities"
 	"github.com/reviewpad/reviewpad/v4/codehost"
-	"github.com/reviewpad/reviewpad/v4/codehost/github"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 	"golang.org/x/exp/slices"
@@ -38,7 +35,11 @@ func assignCodeAuthorReviewersCode(env aladino.Env, args []lang.Value) error {
 	maxAllowedAssignedReviews := args[2].(*lang.IntValue).Val
 
 	gitHubClient := env.GetGithubClient()
-	pr := env.GetTarget().(*target.PullRequestTarget)
+	if gitHubClient == nil {
+		return codehost.ErrNotSupported
+	}
+
+	pr := env.GetTarget().(codehost.PullRequest)
 
 	reviewers, err := pr.GetReviewers()
 	if err != nil {
@@ -56,12 +57,12 @@ func assignCodeAuthorReviewersCode(env aladino.Env, args []lang.Value) error {
 	// we can end up in a situation where there are no changes in the pull request
 	// in those cases it's not possible to fetch the authors of the changed files
 	// so we just assign a random reviewer.
-	if len(pr.Patch) == 0 {
+	if len(pr.GetPatch()) == 0 {
 		return assignRandomReviewerCode(env, nil)
 	}
 
 	// Fetch all users that have authored the changed files in the pull request.
-	authors, err := getAuthorsFromGitBlame(env.GetCtx(), gitHubClient, pr)
+	authors, err := getAuthorsFromGitBlame(pr)
 	if err != nil {
 		return fmt.Errorf("error getting authors from git blame: %s", err)
 	}
@@ -97,7 +98,7 @@ func assignCodeAuthorReviewersCode(env aladino.Env, args []lang.Value) error {
 
 	selectedReviewers := []string{}
 	for _, author := range authors {
-		if isUserEligibleToReview(author, pr.PullRequest, reviewersToExclude, availableAssignees, totalOpenPRsAsReviewerByUser[author], maxAllowedAssignedReviews) {
+		if isUserEligibleToReview(author, pr.GetPullRequest(), reviewersToExclude, availableAssignees, totalOpenPRsAsReviewerByUser[author], maxAllowedAssignedReviews) {
 			selectedReviewers = append(selectedReviewers, author)
 		}
 	}
@@ -106,7 +107,7 @@ func assignCodeAuthorReviewersCode(env aladino.Env, args []lang.Value) error {
 	// find eligible reviewers from the available assignees.
 	if len(selectedReviewers) == 0 {
 		for _, assignee := range nonAuthorAvailableAssignees {
-			if isUserEligibleToReview(assignee, pr.PullRequest, reviewersToExclude, availableAssignees, totalOpenPRsAsReviewerByUser[assignee], maxAllowedAssignedReviews) {
+			if isUserEligibleToReview(assignee, pr.GetPullRequest(), reviewersToExclude, availableAssignees, totalOpenPRsAsReviewerByUser[assignee], maxAllowedAssignedReviews) {
 				selectedReviewers = append(selectedReviewers, assignee)
 			}
 		}
@@ -142,9 +143,7 @@ func filterAuthorsFromAssignees(availableAssignees []*codehost.User, authors []s
 	return assignees
 }
 
-func getAuthorsFromGitBlame(ctx context.Context, gitHubClient *github.GithubClient, pullRequest *target.PullRequestTarget) ([]string, error) {
-	authors := []string{}
-
+func getAuthorsFromGitBlame(pullRequest codehost.PullRequest) ([]string, error) {
 	changedFilesPath := []string{}
 
 	// we are excluding yarn.lock files
@@ -153,7 +152,7 @@ func getAuthorsFromGitBlame(ctx context.Context, gitHubClient *github.GithubClie
 		"yarn.lock": true,
 	}
 
-	for _, patch := range pullRequest.Patch {
+	for _, patch := range pullRequest.GetPatch() {
 		if excludedFiles[patch.Repr.GetFilename()] {
 			continue
 		}
@@ -161,17 +160,7 @@ func getAuthorsFromGitBlame(ctx context.Context, gitHubClient *github.GithubClie
 		changedFilesPath = append(changedFilesPath, patch.Repr.GetFilename())
 	}
 
-	gitBlame, err := gitHubClient.GetGitBlame(ctx, pullRequest.GetTargetEntity().Owner, pullRequest.GetTargetEntity().Repo, pullRequest.PullRequest.GetBase().GetSha(), changedFilesPath)
-	if err != nil {
-		return nil, err
-	}
-
-	authorsRank := gitHubClient.ComputeGitBlameRank(gitBlame)
-	for _, reviewerRank := range authorsRank {
-		authors = append(authors, reviewerRank.Username)
-	}
-
-	return authors, nil
+	return pullRequest.GetBlameAuthors(pullRequest.GetPullRequest().GetBase().GetSha(), changedFilesPath)
 }
 
 func isUserEligibleToReview(
@@ -6,7 +6,7 @@ package plugins_aladino_actions
 
 import (
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 	"github.com/reviewpad/reviewpad/v4/utils"
@@ -21,7 +21,7 @@ func AssignRandomReviewer() *aladino.BuiltInAction {
 }
 
 func assignRandomReviewerCode(e aladino.Env, _ []lang.Value) error {
-	t := e.GetTarget().(*target.PullRequestTarget)
+	t := e.GetTarget().(codehost.PullRequest)
 	log := e.GetLogger().WithField("builtin", "assignRandomReviewer")
 
 	reviewers, err := t.GetReviewers()
@@ -10,7 +10,6 @@ import (
 
 	"github.com/reviewpad/go-lib/entities"
 	"github.com/reviewpad/reviewpad/v4/codehost"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 	"github.com/reviewpad/reviewpad/v4/utils"
@@ -35,7 +34,7 @@ func assignReviewerCode(e aladino.Env, args []lang.Value) error {
 	availableReviewers := args[0].(*lang.ArrayValue).Vals
 	totalRequiredReviewers := args[1].(*lang.IntValue).Val
 	policy := args[2].(*lang.StringValue).Val
-	target := e.GetTarget().(*target.PullRequestTarget)
+	target := e.GetTarget().(codehost.PullRequest)
 	log := e.GetLogger().WithField("builtin", "assignReviewer")
 
 	allowedPolicies := map[string]bool{"random": true, "round-robin": true, "reviewpad": true}
@@ -152,7 +151,7 @@ func getReviewersUsingPolicyRoundRobin(e aladino.Env, availableReviewers []lang.
 	reviewers := []string{}
 
 	// Use pull request number as a starting point to select reviewers
-	pullRequest := e.GetTarget().(*target.PullRequestTarget).PullRequest
+	pullRequest := e.GetTarget().(codehost.PullRequest).GetPullRequest()
 	prNum := int(pullRequest.GetNumber())
 	startPos := (prNum - 1) * totalRequiredReviewers
 
@@ -170,6 +169,10 @@ func getReviewersUsingPolicyRoundRobin(e aladino.Env, availableReviewers []lang.
 }
 
 func getReviewersUsingPolicyReviewpad(e aladino.Env, availableReviewers []lang.Value, totalRequiredReviewers int) ([]string, error) {
+	if e.GetGithubClient() == nil {
+		return nil, codehost.ErrNotSupported
+	}
+
 	reviewers := []string{}
 	reviewersMap := map[int][]string{}
 
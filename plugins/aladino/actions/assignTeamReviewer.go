@@ -8,7 +8,7 @@ import (
 	"fmt"
 
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -22,7 +22,7 @@ func AssignTeamReviewer() *aladino.BuiltInAction {
 }
 
 func assignTeamReviewerCode(e aladino.Env, args []lang.Value) error {
-	t := e.GetTarget().(*target.PullRequestTarget)
+	t := e.GetTarget().(codehost.PullRequest)
 
 	teamReviewers := args[0].(*lang.ArrayValue).Vals
 
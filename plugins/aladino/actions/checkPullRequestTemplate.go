@@ -0,0 +1,62 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	prtemplate "github.com/reviewpad/reviewpad/v4/plugins/aladino/prtemplate"
+)
+
+func CheckPullRequestTemplate() *aladino.BuiltInAction {
+	return &aladino.BuiltInAction{
+		Type:           lang.BuildFunctionType([]lang.Type{lang.BuildStringType()}, nil),
+		Code:           checkPullRequestTemplateCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest},
+	}
+}
+
+func checkPullRequestTemplateCode(e aladino.Env, args []lang.Value) error {
+	templatePath := args[0].(*lang.StringValue).Val
+
+	template, err := prtemplate.LoadTemplate(e, templatePath)
+	if err != nil {
+		return err
+	}
+
+	compliance := prtemplate.CheckCompliance(template, e.GetTarget().GetDescription())
+
+	reportedMessages := e.GetBuiltInsReportedMessages()
+
+	if len(compliance.MissingSections) > 0 {
+		reportedMessages[aladino.SEVERITY_ERROR] = append(reportedMessages[aladino.SEVERITY_ERROR], fmt.Sprintf("The description is missing the sections: %s", formatSectionTitles(compliance.MissingSections)))
+	}
+
+	if len(compliance.EmptySections) > 0 {
+		reportedMessages[aladino.SEVERITY_ERROR] = append(reportedMessages[aladino.SEVERITY_ERROR], fmt.Sprintf("The description has empty sections: %s", formatSectionTitles(compliance.EmptySections)))
+	}
+
+	if len(compliance.PlaceholderSections) > 0 {
+		reportedMessages[aladino.SEVERITY_ERROR] = append(reportedMessages[aladino.SEVERITY_ERROR], fmt.Sprintf("The description has sections with the template placeholder text: %s", formatSectionTitles(compliance.PlaceholderSections)))
+	}
+
+	if compliance.UncheckedItems > 0 {
+		reportedMessages[aladino.SEVERITY_WARNING] = append(reportedMessages[aladino.SEVERITY_WARNING], fmt.Sprintf("The description has %d unchecked checklist item(s) out of %d.", compliance.UncheckedItems, compliance.CheckedItems+compliance.UncheckedItems))
+	}
+
+	return nil
+}
+
+func formatSectionTitles(titles []string) string {
+	quoted := make([]string, len(titles))
+	for i, title := range titles {
+		quoted[i] = fmt.Sprintf("`%s`", title)
+	}
+	return strings.Join(quoted, ", ")
+}
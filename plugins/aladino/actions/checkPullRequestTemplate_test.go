@@ -0,0 +1,90 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	pbc "github.com/reviewpad/api/go/codehost"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/reviewpad/reviewpad/v4/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var checkPullRequestTemplate = plugins_aladino.PluginBuiltIns().Actions["checkPullRequestTemplate"].Code
+
+func TestCheckPullRequestTemplate(t *testing.T) {
+	mockedTemplateLocation := fmt.Sprintf("/%v/%v/docs/template.md", aladino.DefaultMockPrOwner, aladino.DefaultMockPrRepoName)
+	mockedTemplate := "## Description\n<!-- Describe your changes. -->\n\n## Related issues\nCloses #\n\n## Testing\n\n## Checklist\n- [ ] Tests were added\n- [ ] Documentation was updated\n"
+
+	tests := map[string]struct {
+		description  string
+		wantErrors   []string
+		wantWarnings []string
+	}{
+		"when the description follows the template": {
+			description: "## Description\nAdds the new feature.\n\n## Related issues\nCloses #42\n\n## Testing\nUnit tests.\n\n## Checklist\n- [x] Tests were added\n- [x] Documentation was updated",
+		},
+		"when the description does not follow the template": {
+			description: "## Description\n<!-- Describe your changes. -->\n\n## Related issues\nCloses #\n\n## Checklist\n- [x] Tests were added\n- [ ] Documentation was updated",
+			wantErrors: []string{
+				"The description is missing the sections: `Testing`",
+				"The description has empty sections: `Description`",
+				"The description has sections with the template placeholder text: `Related issues`",
+			},
+			wantWarnings: []string{
+				"The description has 1 unchecked checklist item(s) out of 2.",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockedEnv := aladino.MockDefaultEnvWithPullRequestAndFiles(
+				t,
+				[]mock.MockBackendOption{
+					mock.WithRequestMatch(
+						mock.GetReposContentsByOwnerByRepoByPath,
+						[]github.RepositoryContent{
+							{
+								Name:        github.String("template.md"),
+								Path:        github.String("docs/template.md"),
+								DownloadURL: github.String(fmt.Sprintf("https://raw.githubusercontent.com%v", mockedTemplateLocation)),
+							},
+						},
+					),
+					mock.WithRequestMatchHandler(
+						mock.EndpointPattern{
+							Pattern: mockedTemplateLocation,
+							Method:  "GET",
+						},
+						http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+							utils.MustWrite(w, mockedTemplate)
+						}),
+					),
+				},
+				nil,
+				aladino.GetDefaultMockPullRequestDetailsWith(&pbc.PullRequest{
+					Description: test.description,
+				}),
+				aladino.GetDefaultPullRequestFileList(),
+				aladino.MockBuiltIns(),
+				nil,
+			)
+
+			err := checkPullRequestTemplate(mockedEnv, []lang.Value{lang.BuildStringValue("docs/template.md")})
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantErrors, mockedEnv.GetBuiltInsReportedMessages()[aladino.SEVERITY_ERROR])
+			assert.Equal(t, test.wantWarnings, mockedEnv.GetBuiltInsReportedMessages()[aladino.SEVERITY_WARNING])
+		})
+	}
+}
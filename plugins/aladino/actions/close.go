@@ -14,7 +14,7 @@ func Close() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
 		Type:           lang.BuildFunctionType([]lang.Type{lang.BuildStringType(), lang.BuildStringType()}, nil),
 		Code:           closeCode,
-		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue},
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue, entities.Discussion},
 	}
 }
 
@@ -545,3 +545,52 @@ func TestClose(t *testing.T) {
 		})
 	}
 }
+
+func TestClose_OnDiscussion(t *testing.T) {
+	gotMutations := []string{}
+	mockedCloseDiscussionMutation := `{
+		"query": "mutation($input:CloseDiscussionInput!) {
+			closeDiscussion(input: $input) {
+				clientMutationId
+			}
+		}",
+		"variables": {
+			"input": {
+				"discussionId": "D_kwDOJRdjrs4AT7xD",
+				"reason": "RESOLVED"
+			}
+		}
+	}`
+	mockedAddDiscussionCommentMutation := `{
+		"query": "mutation($input:AddDiscussionCommentInput!) {
+			addDiscussionComment(input: $input) {
+				clientMutationId
+			}
+		}",
+		"variables": {
+			"input": {
+				"discussionId": "D_kwDOJRdjrs4AT7xD",
+				"body": "Answered in the docs"
+			}
+		}
+	}`
+	mockedEnv := aladino.MockDefaultEnvWithTargetEntity(
+		t,
+		nil,
+		aladino.MockDiscussionGraphQLHandler("[]", func(w http.ResponseWriter, query string) {
+			gotMutations = append(gotMutations, query)
+			utils.MustWrite(w, `{"data": {}}`)
+		}),
+		aladino.MockBuiltIns(),
+		nil,
+		aladino.DefaultMockDiscussionTargetEntity,
+	)
+
+	err := close(mockedEnv, []lang.Value{lang.BuildStringValue("Answered in the docs"), lang.BuildStringValue("completed")})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		utils.MinifyQuery(mockedCloseDiscussionMutation),
+		utils.MinifyQuery(mockedAddDiscussionCommentMutation),
+	}, gotMutations)
+}
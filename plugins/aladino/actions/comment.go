@@ -6,6 +6,7 @@ package plugins_aladino_actions
 
 import (
 	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -14,7 +15,7 @@ func Comment() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
 		Type:           lang.BuildFunctionType([]lang.Type{lang.BuildStringType()}, nil),
 		Code:           commentCode,
-		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue},
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue, entities.Discussion, codehost.Branch},
 	}
 }
 
@@ -0,0 +1,42 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions
+
+import (
+	"regexp"
+
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+)
+
+func CommentDelete() *aladino.BuiltInAction {
+	return &aladino.BuiltInAction{
+		Type:           lang.BuildFunctionType([]lang.Type{lang.BuildStringType()}, nil),
+		Code:           commentDeleteCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue},
+	}
+}
+
+// commentDeleteCode deletes the comment added by commentUpsert with the key, if any.
+func commentDeleteCode(e aladino.Env, args []lang.Value) error {
+	key := args[0].(*lang.StringValue).Val
+
+	annotation, err := commentKeyAnnotation("commentDelete", key)
+	if err != nil {
+		return err
+	}
+
+	existingComment, err := aladino.FindReportCommentByAnnotation(e, regexp.QuoteMeta(annotation))
+	if err != nil {
+		return err
+	}
+
+	if existingComment == nil {
+		return nil
+	}
+
+	return aladino.DeleteReportComment(e, existingComment.GetID())
+}
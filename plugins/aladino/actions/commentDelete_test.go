@@ -0,0 +1,87 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var commentDelete = plugins_aladino.PluginBuiltIns().Actions["commentDelete"].Code
+
+func TestCommentDelete(t *testing.T) {
+	tests := map[string]struct {
+		comments        []*github.IssueComment
+		wantDeletedPath string
+	}{
+		"when comment exists": {
+			comments: []*github.IssueComment{
+				{
+					ID:   github.Int64(1),
+					Body: github.String("Lorem Ipsum"),
+				},
+				{
+					ID:   github.Int64(2),
+					Body: github.String("<!--@annotation-reviewpad-comment-coverage-->\nCoverage: 80%"),
+				},
+			},
+			wantDeletedPath: "/repos/foobar/default-mock-repo/issues/comments/2",
+		},
+		"when comment does not exist": {
+			comments: []*github.IssueComment{
+				{
+					ID:   github.Int64(1),
+					Body: github.String("<!--@annotation-reviewpad-comment-other-->\nLorem Ipsum"),
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var deletedPath string
+
+			mockedEnv := aladino.MockDefaultEnv(
+				t,
+				[]mock.MockBackendOption{
+					mock.WithRequestMatch(
+						mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber,
+						test.comments,
+					),
+					mock.WithRequestMatchHandler(
+						mock.DeleteReposIssuesCommentsByOwnerByRepoByCommentId,
+						http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+							deletedPath = r.URL.Path
+							w.WriteHeader(http.StatusNoContent)
+						}),
+					),
+				},
+				nil,
+				aladino.MockBuiltIns(),
+				nil,
+			)
+
+			err := commentDelete(mockedEnv, []lang.Value{lang.BuildStringValue("coverage")})
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantDeletedPath, deletedPath)
+		})
+	}
+}
+
+func TestCommentDelete_WhenKeyIsEmpty(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	err := commentDelete(mockedEnv, []lang.Value{lang.BuildStringValue("")})
+
+	assert.EqualError(t, err, "commentDelete: key must not be empty")
+}
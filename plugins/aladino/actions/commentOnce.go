@@ -19,7 +19,7 @@ func CommentOnce() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
 		Type:           lang.BuildFunctionType([]lang.Type{lang.BuildStringType()}, nil),
 		Code:           commentOnceCode,
-		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue},
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue, entities.Discussion},
 	}
 }
 
@@ -0,0 +1,69 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+)
+
+func CommentUpsert() *aladino.BuiltInAction {
+	return &aladino.BuiltInAction{
+		Type:           lang.BuildFunctionType([]lang.Type{lang.BuildStringType(), lang.BuildStringType()}, nil),
+		Code:           commentUpsertCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue},
+	}
+}
+
+// commentUpsertCode adds the comment with the key or, when a comment with the key already exists, updates it.
+func commentUpsertCode(e aladino.Env, args []lang.Value) error {
+	key := args[0].(*lang.StringValue).Val
+	body := args[1].(*lang.StringValue).Val
+
+	annotation, err := commentKeyAnnotation("commentUpsert", key)
+	if err != nil {
+		return err
+	}
+
+	existingComment, err := aladino.FindReportCommentByAnnotation(e, regexp.QuoteMeta(annotation))
+	if err != nil {
+		return err
+	}
+
+	commentBody := fmt.Sprintf("%s\n%s", annotation, body)
+
+	if existingComment == nil {
+		return aladino.AddReportComment(e, commentBody)
+	}
+
+	if existingComment.GetBody() == commentBody {
+		return nil
+	}
+
+	return aladino.UpdateReportComment(e, existingComment.GetID(), commentBody)
+}
+
+// commentKeyAnnotation returns the hidden annotation that identifies the comments with the key.
+func commentKeyAnnotation(builtIn, key string) (string, error) {
+	return keyAnnotation(builtIn, "comment", key)
+}
+
+// keyAnnotation returns the hidden annotation that identifies the entities of the kind with the key.
+func keyAnnotation(builtIn, kind, key string) (string, error) {
+	if strings.TrimSpace(key) == "" {
+		return "", fmt.Errorf("%s: key must not be empty", builtIn)
+	}
+
+	if strings.Contains(key, "-->") {
+		return "", fmt.Errorf("%s: key must not contain -->", builtIn)
+	}
+
+	return fmt.Sprintf("<!--@annotation-reviewpad-%s-%s-->", kind, key), nil
+}
@@ -0,0 +1,180 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/reviewpad/reviewpad/v4/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var commentUpsert = plugins_aladino.PluginBuiltIns().Actions["commentUpsert"].Code
+
+func TestCommentUpsert_WhenCommentDoesNotExist(t *testing.T) {
+	var addedComment string
+
+	mockedEnv := aladino.MockDefaultEnv(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatch(
+				mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber,
+				[]*github.IssueComment{
+					{
+						ID:   github.Int64(1),
+						Body: github.String("<!--@annotation-reviewpad-comment-other-->\nLorem Ipsum"),
+					},
+				},
+			),
+			mock.WithRequestMatchHandler(
+				mock.PostReposIssuesCommentsByOwnerByRepoByIssueNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					rawBody, _ := io.ReadAll(r.Body)
+					body := github.IssueComment{}
+
+					utils.MustUnmarshal(rawBody, &body)
+
+					addedComment = body.GetBody()
+				}),
+			),
+		},
+		nil,
+		aladino.MockBuiltIns(),
+		nil,
+	)
+
+	args := []lang.Value{lang.BuildStringValue("coverage"), lang.BuildStringValue("Coverage: 80%")}
+	err := commentUpsert(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "<!--@annotation-reviewpad-comment-coverage-->\nCoverage: 80%", addedComment)
+}
+
+func TestCommentUpsert_WhenCommentExists(t *testing.T) {
+	var updatedPath, updatedComment string
+	commentCreated := false
+
+	mockedEnv := aladino.MockDefaultEnv(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatch(
+				mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber,
+				[]*github.IssueComment{
+					{
+						ID:   github.Int64(1),
+						Body: github.String("<!--@annotation-reviewpad-comment-coverage.total-->\nCoverage: 70%"),
+					},
+					{
+						ID:   github.Int64(2),
+						Body: github.String("<!--@annotation-reviewpad-comment-coverage-->\nCoverage: 70%"),
+					},
+				},
+			),
+			mock.WithRequestMatchHandler(
+				mock.PatchReposIssuesCommentsByOwnerByRepoByCommentId,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					rawBody, _ := io.ReadAll(r.Body)
+					body := github.IssueComment{}
+
+					utils.MustUnmarshal(rawBody, &body)
+
+					updatedPath = r.URL.Path
+					updatedComment = body.GetBody()
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PostReposIssuesCommentsByOwnerByRepoByIssueNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					commentCreated = true
+				}),
+			),
+		},
+		nil,
+		aladino.MockBuiltIns(),
+		nil,
+	)
+
+	args := []lang.Value{lang.BuildStringValue("coverage"), lang.BuildStringValue("Coverage: 80%")}
+	err := commentUpsert(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.False(t, commentCreated, "The comment should not be created")
+	assert.Equal(t, "/repos/foobar/default-mock-repo/issues/comments/2", updatedPath)
+	assert.Equal(t, "<!--@annotation-reviewpad-comment-coverage-->\nCoverage: 80%", updatedComment)
+}
+
+func TestCommentUpsert_WhenCommentIsUpToDate(t *testing.T) {
+	commentChanged := false
+
+	mockedEnv := aladino.MockDefaultEnv(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatch(
+				mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber,
+				[]*github.IssueComment{
+					{
+						ID:   github.Int64(1),
+						Body: github.String("<!--@annotation-reviewpad-comment-coverage-->\nCoverage: 80%"),
+					},
+				},
+			),
+			mock.WithRequestMatchHandler(
+				mock.PatchReposIssuesCommentsByOwnerByRepoByCommentId,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					commentChanged = true
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PostReposIssuesCommentsByOwnerByRepoByIssueNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					commentChanged = true
+				}),
+			),
+		},
+		nil,
+		aladino.MockBuiltIns(),
+		nil,
+	)
+
+	args := []lang.Value{lang.BuildStringValue("coverage"), lang.BuildStringValue("Coverage: 80%")}
+	err := commentUpsert(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.False(t, commentChanged, "The comment should not be changed")
+}
+
+func TestCommentUpsert_WhenKeyIsInvalid(t *testing.T) {
+	tests := map[string]struct {
+		key     string
+		wantErr string
+	}{
+		"when key is empty": {
+			key:     " ",
+			wantErr: "commentUpsert: key must not be empty",
+		},
+		"when key closes the annotation": {
+			key:     "a-->b",
+			wantErr: "commentUpsert: key must not contain -->",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+			args := []lang.Value{lang.BuildStringValue(test.key), lang.BuildStringValue("Lorem Ipsum")}
+			err := commentUpsert(mockedEnv, args)
+
+			assert.EqualError(t, err, test.wantErr)
+		})
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/reviewpad/go-conventionalcommits"
 	"github.com/reviewpad/go-conventionalcommits/parser"
 	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -18,28 +20,21 @@ func CommitLint() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
 		Type:           lang.BuildFunctionType([]lang.Type{}, nil),
 		Code:           commitLintCode,
-		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest},
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, codehost.Branch},
 	}
 }
 
 func commitLintCode(e aladino.Env, _ []lang.Value) error {
-	entity := e.GetTarget().GetTargetEntity()
-
-	prNum := entity.Number
-	owner := entity.Owner
-	repo := entity.Repo
-
-	ghCommits, err := e.GetGithubClient().GetPullRequestCommits(e.GetCtx(), owner, repo, prNum)
+	commits, err := getCommitsToLint(e)
 	if err != nil {
 		return err
 	}
 
-	for _, ghCommit := range ghCommits {
-		commitMsg := ghCommit.Commit.GetMessage()
-		res, err := parser.NewMachine(conventionalcommits.WithTypes(conventionalcommits.TypesConventional)).Parse([]byte(commitMsg))
-
+	for _, commit := range commits {
+		commitMsg := commit.Message
+		res, err := parseConventionalCommit(commitMsg)
 		if err != nil || !res.Ok() {
-			body := fmt.Sprintf("**Unconventional commit detected**: '%v' (%v)", commitMsg, ghCommit.GetSHA())
+			body := fmt.Sprintf("**Unconventional commit detected**: '%v' (%v)", commitMsg, commit.SHA)
 			reportedMessages := e.GetBuiltInsReportedMessages()
 			reportedMessages[aladino.SEVERITY_ERROR] = append(reportedMessages[aladino.SEVERITY_ERROR], body)
 		}
@@ -47,3 +42,17 @@ func commitLintCode(e aladino.Env, _ []lang.Value) error {
 
 	return nil
 }
+
+// parseConventionalCommit parses the message with the types of the conventional commits specification.
+func parseConventionalCommit(message string) (conventionalcommits.Message, error) {
+	return parser.NewMachine(conventionalcommits.WithTypes(conventionalcommits.TypesConventional)).Parse([]byte(message))
+}
+
+// getCommitsToLint returns the commits of the pull request or the commits added to the branch by the push.
+func getCommitsToLint(e aladino.Env) ([]*codehost.Commit, error) {
+	if t, ok := e.GetTarget().(*target.BranchTarget); ok {
+		return t.GetPushedCommits()
+	}
+
+	return e.GetTarget().(codehost.PullRequest).GetCommits()
+}
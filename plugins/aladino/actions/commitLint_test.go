@@ -177,6 +177,37 @@ func TestCommitLint(t *testing.T) {
 			),
 			wantReportedMessages: map[aladino.Severity][]string{},
 		},
+		"when there is one non conventional pushed commit": {
+			env: aladino.MockDefaultEnvWithTargetEntity(
+				t,
+				[]mock.MockBackendOption{
+					mock.WithRequestMatch(
+						mock.GetReposCompareByOwnerByRepoByBasehead,
+						&github.CommitsComparison{
+							Commits: []*github.RepositoryCommit{
+								{
+									SHA:    github.String("6dcb09b5b57875f334f61aebed695e2e4193db5e"),
+									Commit: &github.Commit{Message: github.String("Fix all bugs")},
+								},
+								{
+									SHA:    github.String("6dcb09b5b57875f334f61aebed695e2e4193db5a"),
+									Commit: &github.Commit{Message: github.String("docs: correct spelling of CHANGELOG")},
+								},
+							},
+						},
+					),
+				},
+				nil,
+				aladino.MockBuiltIns(),
+				aladino.GetDefaultMockPushEvent(),
+				aladino.DefaultMockBranchTargetEntity,
+			),
+			wantReportedMessages: map[aladino.Severity][]string{
+				aladino.SEVERITY_ERROR: {
+					"**Unconventional commit detected**: 'Fix all bugs' (6dcb09b5b57875f334f61aebed695e2e4193db5e)",
+				},
+			},
+		},
 	}
 
 	for name, test := range tests {
@@ -0,0 +1,116 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+)
+
+func CreateFollowUpIssue() *aladino.BuiltInAction {
+	return &aladino.BuiltInAction{
+		Type: lang.BuildFunctionType([]lang.Type{
+			lang.BuildStringType(),
+			lang.BuildStringType(),
+			lang.BuildStringType(),
+			lang.BuildArrayOfType(lang.BuildStringType()),
+			lang.BuildArrayOfType(lang.BuildStringType()),
+		}, nil),
+		Code:           createFollowUpIssueCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue},
+	}
+}
+
+// createFollowUpIssueCode creates an issue that refers back to the target and comments on the target with a link to it.
+// Both the issue body and the comment carry a hidden annotation with the key, so a single issue is created for each key of the target
+// even when the run stops before the comment is added.
+func createFollowUpIssueCode(e aladino.Env, args []lang.Value) error {
+	if e.GetGithubClient() == nil {
+		return codehost.ErrNotSupported
+	}
+
+	key := args[0].(*lang.StringValue).Val
+	title := args[1].(*lang.StringValue).Val
+	body := args[2].(*lang.StringValue).Val
+	labels := args[3].(*lang.ArrayValue)
+	log := e.GetLogger().WithField("builtin", "createFollowUpIssue")
+
+	annotation, err := keyAnnotation("createFollowUpIssue", "follow-up-issue", key)
+	if err != nil {
+		return err
+	}
+
+	if title == "" {
+		return fmt.Errorf("createFollowUpIssue: title can't be empty")
+	}
+
+	existingComment, err := aladino.FindReportCommentByAnnotation(e, regexp.QuoteMeta(annotation))
+	if err != nil {
+		return err
+	}
+
+	if existingComment != nil {
+		log.Infof("skipping follow-up issue %s because it was already created", key)
+		return nil
+	}
+
+	targetEntity := e.GetTarget().GetTargetEntity()
+	issueAnnotation, err := keyAnnotation("createFollowUpIssue", fmt.Sprintf("follow-up-issue-%d", targetEntity.Number), key)
+	if err != nil {
+		return err
+	}
+
+	issue, err := findFollowUpIssue(e, issueAnnotation, labels)
+	if err != nil {
+		return err
+	}
+
+	if issue != nil {
+		log.Infof("linking follow-up issue %s to the existing issue #%d", key, issue.GetNumber())
+	} else {
+		issueBody := fmt.Sprintf("%s\n%s\n\nFollow-up of #%d.", issueAnnotation, body, targetEntity.Number)
+
+		issue, err = createIssue(e, title, issueBody, labels, args[4].(*lang.ArrayValue))
+		if err != nil {
+			return err
+		}
+	}
+
+	return aladino.AddReportComment(e, fmt.Sprintf("%s\nCreated the follow-up issue #%d.", annotation, issue.GetNumber()))
+}
+
+// findFollowUpIssue returns the issue of the repository whose body has the annotation.
+// Follow-up issues are created with the labels, so only the issues with them are listed.
+func findFollowUpIssue(e aladino.Env, annotation string, labelValues *lang.ArrayValue) (*github.Issue, error) {
+	targetEntity := e.GetTarget().GetTargetEntity()
+
+	labels := make([]string, len(labelValues.Vals))
+	for i, label := range labelValues.Vals {
+		labels[i] = label.(*lang.StringValue).Val
+	}
+
+	issues, _, err := e.GetGithubClient().ListIssuesByRepo(e.GetCtx(), targetEntity.Owner, targetEntity.Repo, &github.IssueListByRepoOptions{
+		State:  "all",
+		Labels: labels,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, issue := range issues {
+		if !issue.IsPullRequest() && strings.Contains(issue.GetBody(), annotation) {
+			return issue, nil
+		}
+	}
+
+	return nil, nil
+}
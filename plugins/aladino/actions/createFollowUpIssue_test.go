@@ -0,0 +1,212 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/reviewpad/reviewpad/v4/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var createFollowUpIssue = plugins_aladino.PluginBuiltIns().Actions["createFollowUpIssue"].Code
+
+func buildCreateFollowUpIssueArgs(key, title string) []lang.Value {
+	return []lang.Value{
+		lang.BuildStringValue(key),
+		lang.BuildStringValue(title),
+		lang.BuildStringValue("Add the missing tests."),
+		lang.BuildArrayValue([]lang.Value{lang.BuildStringValue("tech-debt")}),
+		lang.BuildArrayValue([]lang.Value{lang.BuildStringValue("john")}),
+	}
+}
+
+func TestCreateFollowUpIssue(t *testing.T) {
+	var createdIssue *github.IssueRequest
+	var addedComment string
+
+	mockedEnv := aladino.MockDefaultEnv(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatch(
+				mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber,
+				[]*github.IssueComment{
+					{
+						ID:   github.Int64(1),
+						Body: github.String("<!--@annotation-reviewpad-follow-up-issue-other-->\nCreated the follow-up issue #9."),
+					},
+				},
+			),
+			mock.WithRequestMatchHandler(
+				mock.GetReposIssuesByOwnerByRepo,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "all", r.URL.Query().Get("state"))
+					assert.Equal(t, "tech-debt", r.URL.Query().Get("labels"))
+
+					w.Write(mock.MustMarshal([]*github.Issue{
+						{
+							Number: github.Int(9),
+							Body:   github.String("<!--@annotation-reviewpad-follow-up-issue-6-other-->\nOther follow-up."),
+						},
+						{
+							Number: github.Int(8),
+							Body:   github.String("<!--@annotation-reviewpad-follow-up-issue-5-tests-->\nFollow-up of another pull request."),
+						},
+					}))
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PostReposIssuesByOwnerByRepo,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					rawBody, _ := io.ReadAll(r.Body)
+					createdIssue = &github.IssueRequest{}
+
+					utils.MustUnmarshal(rawBody, createdIssue)
+
+					w.Write(mock.MustMarshal(&github.Issue{Number: github.Int(10)}))
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PostReposIssuesCommentsByOwnerByRepoByIssueNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					rawBody, _ := io.ReadAll(r.Body)
+					body := github.IssueComment{}
+
+					utils.MustUnmarshal(rawBody, &body)
+
+					addedComment = body.GetBody()
+				}),
+			),
+		},
+		nil,
+		aladino.MockBuiltIns(),
+		nil,
+	)
+
+	err := createFollowUpIssue(mockedEnv, buildCreateFollowUpIssueArgs("tests", "Add tests"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, &github.IssueRequest{
+		Title:     github.String("Add tests"),
+		Body:      github.String("<!--@annotation-reviewpad-follow-up-issue-6-tests-->\nAdd the missing tests.\n\nFollow-up of #6."),
+		Labels:    &[]string{"tech-debt"},
+		Assignees: &[]string{"john"},
+	}, createdIssue)
+	assert.Equal(t, "<!--@annotation-reviewpad-follow-up-issue-tests-->\nCreated the follow-up issue #10.", addedComment)
+}
+
+func TestCreateFollowUpIssue_WhenAlreadyCreated(t *testing.T) {
+	issueCreated := false
+	commentCreated := false
+
+	mockedEnv := aladino.MockDefaultEnv(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatch(
+				mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber,
+				[]*github.IssueComment{
+					{
+						ID:   github.Int64(1),
+						Body: github.String("<!--@annotation-reviewpad-follow-up-issue-tests-->\nCreated the follow-up issue #10."),
+					},
+				},
+			),
+			mock.WithRequestMatchHandler(
+				mock.PostReposIssuesByOwnerByRepo,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					issueCreated = true
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PostReposIssuesCommentsByOwnerByRepoByIssueNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					commentCreated = true
+				}),
+			),
+		},
+		nil,
+		aladino.MockBuiltIns(),
+		nil,
+	)
+
+	err := createFollowUpIssue(mockedEnv, buildCreateFollowUpIssueArgs("tests", "Add tests"))
+
+	assert.Nil(t, err)
+	assert.False(t, issueCreated)
+	assert.False(t, commentCreated)
+}
+
+func TestCreateFollowUpIssue_WhenIssueWasCreatedWithoutComment(t *testing.T) {
+	issueCreated := false
+	var addedComment string
+
+	mockedEnv := aladino.MockDefaultEnv(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatch(
+				mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber,
+				[]*github.IssueComment{},
+			),
+			mock.WithRequestMatch(
+				mock.GetReposIssuesByOwnerByRepo,
+				[]*github.Issue{
+					{
+						Number: github.Int(10),
+						Body:   github.String("<!--@annotation-reviewpad-follow-up-issue-6-tests-->\nAdd the missing tests.\n\nFollow-up of #6."),
+					},
+				},
+			),
+			mock.WithRequestMatchHandler(
+				mock.PostReposIssuesByOwnerByRepo,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					issueCreated = true
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PostReposIssuesCommentsByOwnerByRepoByIssueNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					rawBody, _ := io.ReadAll(r.Body)
+					body := github.IssueComment{}
+
+					utils.MustUnmarshal(rawBody, &body)
+
+					addedComment = body.GetBody()
+				}),
+			),
+		},
+		nil,
+		aladino.MockBuiltIns(),
+		nil,
+	)
+
+	err := createFollowUpIssue(mockedEnv, buildCreateFollowUpIssueArgs("tests", "Add tests"))
+
+	assert.Nil(t, err)
+	assert.False(t, issueCreated)
+	assert.Equal(t, "<!--@annotation-reviewpad-follow-up-issue-tests-->\nCreated the follow-up issue #10.", addedComment)
+}
+
+func TestCreateFollowUpIssue_WhenKeyIsEmpty(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	err := createFollowUpIssue(mockedEnv, buildCreateFollowUpIssueArgs("", "Add tests"))
+
+	assert.EqualError(t, err, "createFollowUpIssue: key must not be empty")
+}
+
+func TestCreateFollowUpIssue_WhenTitleIsEmpty(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	err := createFollowUpIssue(mockedEnv, buildCreateFollowUpIssueArgs("tests", ""))
+
+	assert.EqualError(t, err, "createFollowUpIssue: title can't be empty")
+}
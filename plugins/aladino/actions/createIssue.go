@@ -0,0 +1,69 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions
+
+import (
+	"fmt"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+)
+
+func CreateIssue() *aladino.BuiltInAction {
+	return &aladino.BuiltInAction{
+		Type: lang.BuildFunctionType([]lang.Type{
+			lang.BuildStringType(),
+			lang.BuildStringType(),
+			lang.BuildArrayOfType(lang.BuildStringType()),
+			lang.BuildArrayOfType(lang.BuildStringType()),
+		}, nil),
+		Code:           createIssueCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue, entities.Discussion, codehost.Branch},
+	}
+}
+
+// createIssueCode creates an issue in the repository of the target with the title, body, labels and assignees.
+func createIssueCode(e aladino.Env, args []lang.Value) error {
+	title := args[0].(*lang.StringValue).Val
+	body := args[1].(*lang.StringValue).Val
+
+	if title == "" {
+		return fmt.Errorf("createIssue: title can't be empty")
+	}
+
+	_, err := createIssue(e, title, body, args[2].(*lang.ArrayValue), args[3].(*lang.ArrayValue))
+
+	return err
+}
+
+func createIssue(e aladino.Env, title, body string, labelValues, assigneeValues *lang.ArrayValue) (*github.Issue, error) {
+	if e.GetGithubClient() == nil {
+		return nil, codehost.ErrNotSupported
+	}
+
+	targetEntity := e.GetTarget().GetTargetEntity()
+
+	labels := make([]string, len(labelValues.Vals))
+	for i, label := range labelValues.Vals {
+		labels[i] = label.(*lang.StringValue).Val
+	}
+
+	assignees := make([]string, len(assigneeValues.Vals))
+	for i, assignee := range assigneeValues.Vals {
+		assignees[i] = assignee.(*lang.StringValue).Val
+	}
+
+	issue, _, err := e.GetGithubClient().CreateIssue(e.GetCtx(), targetEntity.Owner, targetEntity.Repo, &github.IssueRequest{
+		Title:     github.String(title),
+		Body:      github.String(body),
+		Labels:    &labels,
+		Assignees: &assignees,
+	})
+
+	return issue, err
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/reviewpad/reviewpad/v4/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var createIssue = plugins_aladino.PluginBuiltIns().Actions["createIssue"].Code
+
+func buildCreateIssueArgs(title, body string, labels, assignees []string) []lang.Value {
+	labelValues := make([]lang.Value, len(labels))
+	for i, label := range labels {
+		labelValues[i] = lang.BuildStringValue(label)
+	}
+
+	assigneeValues := make([]lang.Value, len(assignees))
+	for i, assignee := range assignees {
+		assigneeValues[i] = lang.BuildStringValue(assignee)
+	}
+
+	return []lang.Value{
+		lang.BuildStringValue(title),
+		lang.BuildStringValue(body),
+		lang.BuildArrayValue(labelValues),
+		lang.BuildArrayValue(assigneeValues),
+	}
+}
+
+func TestCreateIssue(t *testing.T) {
+	var gotIssue *github.IssueRequest
+	mockedEnv := aladino.MockDefaultEnvWithTargetEntity(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.PostReposIssuesByOwnerByRepo,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					rawBody, _ := io.ReadAll(r.Body)
+					utils.MustUnmarshal(rawBody, &gotIssue)
+
+					w.WriteHeader(http.StatusCreated)
+					w.Write(mock.MustMarshal(&github.Issue{Number: github.Int(1)}))
+				}),
+			),
+		},
+		nil,
+		aladino.MockBuiltIns(),
+		aladino.GetDefaultMockPushEvent(),
+		aladino.DefaultMockBranchTargetEntity,
+	)
+
+	err := createIssue(mockedEnv, buildCreateIssueArgs("Update the changelog", "The push to release did not update the changelog.", []string{"release"}, []string{"john"}))
+
+	assert.Nil(t, err)
+	assert.Equal(t, &github.IssueRequest{
+		Title:     github.String("Update the changelog"),
+		Body:      github.String("The push to release did not update the changelog."),
+		Labels:    &[]string{"release"},
+		Assignees: &[]string{"john"},
+	}, gotIssue)
+}
+
+func TestCreateIssue_WhenTitleIsEmpty(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	err := createIssue(mockedEnv, buildCreateIssueArgs("", "body", []string{}, []string{}))
+
+	assert.EqualError(t, err, "createIssue: title can't be empty")
+}
+
+func TestCreateIssue_WhenRequestFails(t *testing.T) {
+	failMessage := "CreateIssueRequestFail"
+	mockedEnv := aladino.MockDefaultEnv(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.PostReposIssuesByOwnerByRepo,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					mock.WriteError(
+						w,
+						http.StatusInternalServerError,
+						failMessage,
+					)
+				}),
+			),
+		},
+		nil,
+		aladino.MockBuiltIns(),
+		nil,
+	)
+
+	err := createIssue(mockedEnv, buildCreateIssueArgs("title", "body", []string{}, []string{}))
+
+	assert.Equal(t, err.(*github.ErrorResponse).Message, failMessage)
+}
+
+func TestCreateIssue_WhenCodeHostIsNotGithub(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+	mockedEnv.(*aladino.BaseEnv).GithubClient = nil
+
+	err := createIssue(mockedEnv, buildCreateIssueArgs("title", "body", []string{}, []string{}))
+
+	assert.Equal(t, codehost.ErrNotSupported, err)
+}
@@ -5,10 +5,8 @@
 package plugins_aladino_actions
 
 import (
-	"fmt"
-
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -22,31 +20,16 @@ func DeleteHeadBranch() *aladino.BuiltInAction {
 }
 
 func deleteHeadBranch(e aladino.Env, args []lang.Value) error {
-	target := e.GetTarget().(*target.PullRequestTarget)
-	targetEntity := target.GetTargetEntity()
-	ctx := e.GetCtx()
-	owner := targetEntity.Owner
-	repo := targetEntity.Repo
+	target := e.GetTarget().(codehost.PullRequest)
 
-	if !target.PullRequest.IsMerged && target.PullRequest.ClosedAt == nil {
+	if !target.GetPullRequest().IsMerged && target.GetPullRequest().ClosedAt == nil {
 		return nil
 	}
 
-	if target.PullRequest.Head.Repo.IsFork {
+	if target.GetPullRequest().Head.Repo.IsFork {
 		e.GetLogger().Warnln("$deleteHeadBranch built-in action doesn't work across forks")
 		return nil
 	}
 
-	ref := "heads/" + target.PullRequest.Head.Name
-
-	refExists, err := e.GetGithubClient().RefExists(ctx, owner, repo, "refs/"+ref)
-	if err != nil {
-		return fmt.Errorf("error getting reference: %w", err)
-	}
-
-	if !refExists {
-		return nil
-	}
-
-	return e.GetGithubClient().DeleteReference(ctx, owner, repo, ref)
+	return target.DeleteHeadBranch()
 }
@@ -6,23 +6,23 @@ package plugins_aladino_actions
 
 import (
 	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
 
 func ErrorMsg() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type:           lang.BuildFunctionType([]lang.Type{lang.BuildStringType()}, nil),
+		Type:           lang.BuildFunctionType([]lang.Type{lang.BuildStringType(), lang.BuildStringType(), lang.BuildIntType()}, nil),
 		Code:           errorCode,
-		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue},
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue, entities.Discussion, codehost.Branch},
 	}
 }
 
 func errorCode(e aladino.Env, args []lang.Value) error {
 	body := args[0].(*lang.StringValue).Val
+	path := args[1].(*lang.StringValue).Val
+	line := args[2].(*lang.IntValue).Val
 
-	reportedMessages := e.GetBuiltInsReportedMessages()
-	reportedMessages[aladino.SEVERITY_ERROR] = append(reportedMessages[aladino.SEVERITY_ERROR], body)
-
-	return nil
+	return aladino.ReportMessage(e, aladino.SEVERITY_ERROR, body, path, line)
 }
@@ -20,7 +20,7 @@ func TestErrorMsg(t *testing.T) {
 
 	message := "This pull request was considered too large."
 
-	args := []lang.Value{lang.BuildStringValue(message)}
+	args := []lang.Value{lang.BuildStringValue(message), lang.BuildStringValue(""), lang.BuildIntValue(0)}
 	gotError := errorMsg(mockedEnv, args)
 
 	wantReportedErrors := []string{message}
@@ -30,3 +30,35 @@ func TestErrorMsg(t *testing.T) {
 	assert.Nil(t, gotError)
 	assert.Equal(t, wantReportedErrors, gotReportedErrors)
 }
+
+func TestErrorMsg_OnLine(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	message := "This function is too long."
+
+	args := []lang.Value{lang.BuildStringValue(message), lang.BuildStringValue("main.go"), lang.BuildIntValue(10)}
+	gotError := errorMsg(mockedEnv, args)
+
+	wantReportedAnnotations := []*aladino.ReportedAnnotation{
+		{
+			Severity: aladino.SEVERITY_ERROR,
+			Path:     "main.go",
+			Line:     10,
+			Message:  message,
+		},
+	}
+
+	assert.Nil(t, gotError)
+	assert.Equal(t, []string{message}, mockedEnv.GetBuiltInsReportedMessages()[aladino.SEVERITY_ERROR])
+	assert.Equal(t, wantReportedAnnotations, mockedEnv.GetBuiltInsReportedAnnotations())
+}
+
+func TestErrorMsg_WhenLineIsInvalid(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	args := []lang.Value{lang.BuildStringValue("This function is too long."), lang.BuildStringValue("main.go"), lang.BuildIntValue(0)}
+	gotError := errorMsg(mockedEnv, args)
+
+	assert.EqualError(t, gotError, "invalid line 0 of main.go: lines start at 1")
+	assert.Nil(t, mockedEnv.GetBuiltInsReportedMessages()[aladino.SEVERITY_ERROR])
+}
@@ -0,0 +1,253 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	gh "github.com/reviewpad/reviewpad/v4/codehost/github"
+	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	"github.com/reviewpad/reviewpad/v4/utils"
+)
+
+const RELEASE_NOTES_OTHER_GROUP = "Other"
+
+const DEFAULT_RELEASE_NOTES_TEMPLATE = `## What's Changed
+{{- range .Groups }}
+
+### {{ .Name }}
+{{ range .PullRequests }}
+- {{ .Title }} by @{{ .Author }} in #{{ .Number }}
+{{- end }}
+{{- end }}
+
+**Full Changelog**: {{ .PreviousTag }}...{{ .Tag }}
+`
+
+var releaseNotesGroupBy = []string{"label", "type"}
+
+// conventionalCommitTypeGroups are the names of the groups of the conventional commit types.
+// Other types are grouped by the type itself.
+var conventionalCommitTypeGroups = map[string]string{
+	"build":    "Build System",
+	"chore":    "Chores",
+	"ci":       "Continuous Integration",
+	"docs":     "Documentation",
+	"feat":     "Features",
+	"fix":      "Bug Fixes",
+	"perf":     "Performance Improvements",
+	"refactor": "Code Refactoring",
+	"revert":   "Reverts",
+	"style":    "Styles",
+	"test":     "Tests",
+}
+
+type releaseNotes struct {
+	Tag         string
+	PreviousTag string
+	Groups      []*releaseNotesGroup
+}
+
+type releaseNotesGroup struct {
+	Name         string
+	PullRequests []*releaseNotesPullRequest
+}
+
+type releaseNotesPullRequest struct {
+	Number int
+	Title  string
+	Author string
+	URL    string
+}
+
+func GenerateReleaseNotes() *aladino.BuiltInAction {
+	return &aladino.BuiltInAction{
+		Type:           lang.BuildFunctionType([]lang.Type{lang.BuildStringType(), lang.BuildStringType(), lang.BuildStringType()}, nil),
+		Code:           generateReleaseNotesCode,
+		SupportedKinds: []entities.TargetEntityKind{codehost.Release},
+	}
+}
+
+// generateReleaseNotesCode replaces the body of the release with the pull requests merged since the previous tag.
+// When the previous tag is empty, the tag of the previous release is used.
+func generateReleaseNotesCode(e aladino.Env, args []lang.Value) error {
+	previousTag := args[0].(*lang.StringValue).Val
+	groupBy := args[1].(*lang.StringValue).Val
+	rawTemplate := args[2].(*lang.StringValue).Val
+
+	if !utils.ElementOf(releaseNotesGroupBy, groupBy) {
+		return fmt.Errorf("generateReleaseNotes: invalid group by %v, expected one of %v", groupBy, releaseNotesGroupBy)
+	}
+
+	if rawTemplate == "" {
+		rawTemplate = DEFAULT_RELEASE_NOTES_TEMPLATE
+	}
+
+	tmpl, err := template.New("release notes").Parse(rawTemplate)
+	if err != nil {
+		return fmt.Errorf("generateReleaseNotes: invalid template: %w", err)
+	}
+
+	ctx := e.GetCtx()
+	githubClient := e.GetGithubClient()
+	releaseTarget := e.GetTarget().(*target.ReleaseTarget)
+	targetEntity := releaseTarget.GetTargetEntity()
+	owner := targetEntity.Owner
+	repo := targetEntity.Repo
+
+	if previousTag == "" {
+		previousTag, err = getPreviousReleaseTag(ctx, githubClient, owner, repo, releaseTarget.GetRelease())
+		if err != nil {
+			return err
+		}
+	}
+
+	comparison, err := githubClient.CompareCommits(ctx, owner, repo, previousTag, releaseTarget.GetTag())
+	if err != nil {
+		return err
+	}
+
+	pullRequests, err := getMergedPullRequestsWithCommits(ctx, githubClient, owner, repo, comparison.Commits)
+	if err != nil {
+		return err
+	}
+
+	notes := &releaseNotes{
+		Tag:         releaseTarget.GetTag(),
+		PreviousTag: previousTag,
+		Groups:      groupReleaseNotesPullRequests(pullRequests, groupBy),
+	}
+
+	var body strings.Builder
+	if err := tmpl.Execute(&body, notes); err != nil {
+		return fmt.Errorf("generateReleaseNotes: %w", err)
+	}
+
+	return releaseTarget.UpdateBody(body.String())
+}
+
+// getPreviousReleaseTag returns the tag of the most recent published release created before the release.
+// Drafts and prereleases are skipped.
+func getPreviousReleaseTag(ctx context.Context, githubClient *gh.GithubClient, owner, repo string, release *github.RepositoryRelease) (string, error) {
+	releases, err := githubClient.ListReleases(ctx, owner, repo)
+	if err != nil {
+		return "", err
+	}
+
+	var previousRelease *github.RepositoryRelease
+	for _, r := range releases {
+		if r.GetID() == release.GetID() || r.GetDraft() || r.GetPrerelease() {
+			continue
+		}
+
+		if !r.GetCreatedAt().Before(release.GetCreatedAt().Time) {
+			continue
+		}
+
+		if previousRelease == nil || r.GetCreatedAt().After(previousRelease.GetCreatedAt().Time) {
+			previousRelease = r
+		}
+	}
+
+	if previousRelease == nil {
+		return "", fmt.Errorf("generateReleaseNotes: no release found before %v", release.GetTagName())
+	}
+
+	return previousRelease.GetTagName(), nil
+}
+
+// getMergedPullRequestsWithCommits returns the merged pull requests with the commits in the order they were merged.
+func getMergedPullRequestsWithCommits(ctx context.Context, githubClient *gh.GithubClient, owner, repo string, commits []*github.RepositoryCommit) ([]*github.PullRequest, error) {
+	pullRequests := make([]*github.PullRequest, 0)
+	seen := make(map[int]bool)
+
+	for _, commit := range commits {
+		commitPullRequests, err := githubClient.ListPullRequestsWithCommit(ctx, owner, repo, commit.GetSHA())
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pullRequest := range commitPullRequests {
+			if pullRequest.MergedAt == nil || seen[pullRequest.GetNumber()] {
+				continue
+			}
+
+			seen[pullRequest.GetNumber()] = true
+			pullRequests = append(pullRequests, pullRequest)
+		}
+	}
+
+	return pullRequests, nil
+}
+
+// groupReleaseNotesPullRequests groups the pull requests by their first label or by the conventional commit type of their title.
+// The groups are sorted by name with the pull requests that don't belong to any group last.
+func groupReleaseNotesPullRequests(pullRequests []*github.PullRequest, groupBy string) []*releaseNotesGroup {
+	groupsByName := make(map[string]*releaseNotesGroup)
+
+	for _, pullRequest := range pullRequests {
+		name := getReleaseNotesGroupName(pullRequest, groupBy)
+
+		group, ok := groupsByName[name]
+		if !ok {
+			group = &releaseNotesGroup{Name: name}
+			groupsByName[name] = group
+		}
+
+		group.PullRequests = append(group.PullRequests, &releaseNotesPullRequest{
+			Number: pullRequest.GetNumber(),
+			Title:  pullRequest.GetTitle(),
+			Author: pullRequest.GetUser().GetLogin(),
+			URL:    pullRequest.GetHTMLURL(),
+		})
+	}
+
+	groups := make([]*releaseNotesGroup, 0, len(groupsByName))
+	for _, group := range groupsByName {
+		groups = append(groups, group)
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Name == RELEASE_NOTES_OTHER_GROUP || groups[j].Name == RELEASE_NOTES_OTHER_GROUP {
+			return groups[j].Name == RELEASE_NOTES_OTHER_GROUP && groups[i].Name != RELEASE_NOTES_OTHER_GROUP
+		}
+
+		return groups[i].Name < groups[j].Name
+	})
+
+	return groups
+}
+
+func getReleaseNotesGroupName(pullRequest *github.PullRequest, groupBy string) string {
+	if groupBy == "label" {
+		if len(pullRequest.Labels) == 0 {
+			return RELEASE_NOTES_OTHER_GROUP
+		}
+
+		return pullRequest.Labels[0].GetName()
+	}
+
+	res, err := parseConventionalCommit(pullRequest.GetTitle())
+	if err != nil || !res.Ok() {
+		return RELEASE_NOTES_OTHER_GROUP
+	}
+
+	commitType := res.(*conventionalcommits.ConventionalCommit).Type
+	if name, ok := conventionalCommitTypeGroups[commitType]; ok {
+		return name
+	}
+
+	return commitType
+}
@@ -0,0 +1,228 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/reviewpad/reviewpad/v4/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var generateReleaseNotes = plugins_aladino.PluginBuiltIns().Actions["generateReleaseNotes"].Code
+
+func mockReleaseNotesEnv(t *testing.T, gotRelease **github.RepositoryRelease, clientOptions ...mock.MockBackendOption) aladino.Env {
+	mergedAt := &github.Timestamp{Time: aladino.DefaultMockPrDate}
+	pullRequestsByCommit := map[string][]*github.PullRequest{
+		"a": {
+			{
+				Number:   github.Int(1),
+				Title:    github.String("feat: release notes"),
+				User:     &github.User{Login: github.String("john")},
+				Labels:   []*github.Label{{Name: github.String("enhancement")}},
+				MergedAt: mergedAt,
+			},
+		},
+		"b": {
+			{
+				Number:   github.Int(1),
+				Title:    github.String("feat: release notes"),
+				User:     &github.User{Login: github.String("john")},
+				Labels:   []*github.Label{{Name: github.String("enhancement")}},
+				MergedAt: mergedAt,
+			},
+			{
+				Number: github.Int(3),
+				Title:  github.String("feat: open pull request"),
+				User:   &github.User{Login: github.String("jane")},
+			},
+		},
+		"c": {
+			{
+				Number:   github.Int(2),
+				Title:    github.String("Update README"),
+				User:     &github.User{Login: github.String("jane")},
+				MergedAt: mergedAt,
+			},
+		},
+		"d": {
+			{
+				Number:   github.Int(4),
+				Title:    github.String("fix: empty tag"),
+				User:     &github.User{Login: github.String("jane")},
+				Labels:   []*github.Label{{Name: github.String("bug")}},
+				MergedAt: mergedAt,
+			},
+		},
+	}
+
+	options := append([]mock.MockBackendOption{
+		mock.WithRequestMatch(
+			mock.GetReposReleasesByOwnerByRepoByReleaseId,
+			aladino.GetDefaultMockRelease(),
+		),
+		mock.WithRequestMatchHandler(
+			mock.GetReposCompareByOwnerByRepoByBasehead,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/repos/foobar/default-mock-repo/compare/v1.0.0...v1.1.0", r.URL.Path)
+				w.Write(mock.MustMarshal(&github.CommitsComparison{
+					Commits: []*github.RepositoryCommit{
+						{SHA: github.String("a")},
+						{SHA: github.String("b")},
+						{SHA: github.String("c")},
+						{SHA: github.String("d")},
+					},
+				}))
+			}),
+		),
+		mock.WithRequestMatchHandler(
+			mock.GetReposCommitsPullsByOwnerByRepoByCommitSha,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				parts := strings.Split(r.URL.Path, "/")
+				w.Write(mock.MustMarshal(pullRequestsByCommit[parts[len(parts)-2]]))
+			}),
+		),
+		mock.WithRequestMatchHandler(
+			mock.PatchReposReleasesByOwnerByRepoByReleaseId,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				rawBody, _ := io.ReadAll(r.Body)
+				utils.MustUnmarshal(rawBody, gotRelease)
+				w.Write(mock.MustMarshal(*gotRelease))
+			}),
+		),
+	}, clientOptions...)
+
+	return aladino.MockDefaultEnvWithTargetEntity(t, options, nil, aladino.MockBuiltIns(), nil, aladino.DefaultMockReleaseTargetEntity)
+}
+
+func TestGenerateReleaseNotes_WhenGroupedByType(t *testing.T) {
+	var gotRelease *github.RepositoryRelease
+	mockedEnv := mockReleaseNotesEnv(t, &gotRelease)
+
+	args := []lang.Value{lang.BuildStringValue("v1.0.0"), lang.BuildStringValue("type"), lang.BuildStringValue("")}
+	err := generateReleaseNotes(mockedEnv, args)
+
+	wantBody := `## What's Changed
+
+### Bug Fixes
+
+- fix: empty tag by @jane in #4
+
+### Features
+
+- feat: release notes by @john in #1
+
+### Other
+
+- Update README by @jane in #2
+
+**Full Changelog**: v1.0.0...v1.1.0
+`
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantBody, gotRelease.GetBody())
+}
+
+func TestGenerateReleaseNotes_WhenGroupedByLabelSinceThePreviousRelease(t *testing.T) {
+	var gotRelease *github.RepositoryRelease
+	mockedEnv := mockReleaseNotesEnv(
+		t,
+		&gotRelease,
+		mock.WithRequestMatch(
+			mock.GetReposReleasesByOwnerByRepo,
+			[]*github.RepositoryRelease{
+				{
+					ID:        github.Int64(43),
+					TagName:   github.String("v1.2.0"),
+					Draft:     github.Bool(true),
+					CreatedAt: &github.Timestamp{Time: aladino.DefaultMockPrDate.Add(time.Hour)},
+				},
+				aladino.GetDefaultMockRelease(),
+				{
+					ID:        github.Int64(40),
+					TagName:   github.String("v0.9.0"),
+					CreatedAt: &github.Timestamp{Time: aladino.DefaultMockPrDate.Add(-2 * time.Hour)},
+				},
+				{
+					ID:         github.Int64(42),
+					TagName:    github.String("v1.1.0-rc.1"),
+					Prerelease: github.Bool(true),
+					CreatedAt:  &github.Timestamp{Time: aladino.DefaultMockPrDate.Add(-time.Minute)},
+				},
+				{
+					ID:        github.Int64(41),
+					TagName:   github.String("v1.0.0"),
+					CreatedAt: &github.Timestamp{Time: aladino.DefaultMockPrDate.Add(-time.Hour)},
+				},
+			},
+		),
+	)
+
+	template := `{{ range .Groups }}{{ .Name }}:{{ range .PullRequests }} #{{ .Number }}{{ end }}
+{{ end }}`
+	args := []lang.Value{lang.BuildStringValue(""), lang.BuildStringValue("label"), lang.BuildStringValue(template)}
+	err := generateReleaseNotes(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "bug: #4\nenhancement: #1\nOther: #2\n", gotRelease.GetBody())
+}
+
+func TestGenerateReleaseNotes_WhenThereIsNoPreviousRelease(t *testing.T) {
+	var gotRelease *github.RepositoryRelease
+	mockedEnv := mockReleaseNotesEnv(
+		t,
+		&gotRelease,
+		mock.WithRequestMatch(
+			mock.GetReposReleasesByOwnerByRepo,
+			[]*github.RepositoryRelease{aladino.GetDefaultMockRelease()},
+		),
+	)
+
+	args := []lang.Value{lang.BuildStringValue(""), lang.BuildStringValue("type"), lang.BuildStringValue("")}
+	err := generateReleaseNotes(mockedEnv, args)
+
+	assert.EqualError(t, err, "generateReleaseNotes: no release found before v1.1.0")
+	assert.Nil(t, gotRelease)
+}
+
+func TestGenerateReleaseNotes_WhenArgumentsAreInvalid(t *testing.T) {
+	tests := map[string]struct {
+		groupBy  string
+		template string
+		wantErr  string
+	}{
+		"when group by is invalid": {
+			groupBy: "author",
+			wantErr: "generateReleaseNotes: invalid group by author, expected one of [label type]",
+		},
+		"when template is invalid": {
+			groupBy:  "type",
+			template: "{{ .Tag ",
+			wantErr:  "generateReleaseNotes: invalid template: template: release notes:1: unclosed action",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotRelease *github.RepositoryRelease
+			mockedEnv := mockReleaseNotesEnv(t, &gotRelease)
+
+			args := []lang.Value{lang.BuildStringValue("v1.0.0"), lang.BuildStringValue(test.groupBy), lang.BuildStringValue(test.template)}
+			err := generateReleaseNotes(mockedEnv, args)
+
+			assert.EqualError(t, err, test.wantErr)
+			assert.Nil(t, gotRelease)
+		})
+	}
+}
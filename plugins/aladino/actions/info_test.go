@@ -20,7 +20,7 @@ func TestInfo(t *testing.T) {
 
 	message := "This pull request was considered too large."
 
-	args := []lang.Value{lang.BuildStringValue(message)}
+	args := []lang.Value{lang.BuildStringValue(message), lang.BuildStringValue(""), lang.BuildIntValue(0)}
 	gotError := info(mockedEnv, args)
 
 	wantReportedInfos := []string{message}
@@ -30,3 +30,25 @@ func TestInfo(t *testing.T) {
 	assert.Nil(t, gotError)
 	assert.Equal(t, wantReportedInfos, gotReportedInfos)
 }
+
+func TestInfo_OnLine(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	message := "This function is too long."
+
+	args := []lang.Value{lang.BuildStringValue(message), lang.BuildStringValue("main.go"), lang.BuildIntValue(10)}
+	gotError := info(mockedEnv, args)
+
+	wantReportedAnnotations := []*aladino.ReportedAnnotation{
+		{
+			Severity: aladino.SEVERITY_INFO,
+			Path:     "main.go",
+			Line:     10,
+			Message:  message,
+		},
+	}
+
+	assert.Nil(t, gotError)
+	assert.Equal(t, []string{message}, mockedEnv.GetBuiltInsReportedMessages()[aladino.SEVERITY_INFO])
+	assert.Equal(t, wantReportedAnnotations, mockedEnv.GetBuiltInsReportedAnnotations())
+}
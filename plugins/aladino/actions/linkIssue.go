@@ -0,0 +1,63 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+)
+
+func LinkIssue() *aladino.BuiltInAction {
+	return &aladino.BuiltInAction{
+		Type:           lang.BuildFunctionType([]lang.Type{lang.BuildIntType()}, nil),
+		Code:           linkIssueCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest},
+	}
+}
+
+// linkIssueCode links the issue to the pull request, so the issue is closed when the pull request is merged.
+// GitHub has no API to link issues, so a closing keyword referencing the issue is added to the description.
+func linkIssueCode(e aladino.Env, args []lang.Value) error {
+	if e.GetGithubClient() == nil {
+		return codehost.ErrNotSupported
+	}
+
+	t := e.GetTarget().(codehost.PullRequest)
+	targetEntity := t.GetTargetEntity()
+	number := args[0].(*lang.IntValue).Val
+	log := e.GetLogger().WithField("builtin", "linkIssue")
+
+	issue, _, err := e.GetGithubClient().GetIssue(e.GetCtx(), targetEntity.Owner, targetEntity.Repo, number)
+	if err != nil {
+		return err
+	}
+
+	if issue.IsPullRequest() {
+		return fmt.Errorf("linkIssue: #%d is a pull request", number)
+	}
+
+	description := t.GetPullRequest().GetDescription()
+
+	closingKeywordRegex := regexp.MustCompile(fmt.Sprintf(`(?i)\b(close[sd]?|fix(es|ed)?|resolve[sd]?)\s+#%d\b`, number))
+	if closingKeywordRegex.MatchString(description) {
+		log.Infof("skipping link because the issue #%d is already linked", number)
+		return nil
+	}
+
+	description = strings.TrimSpace(fmt.Sprintf("%s\n\nCloses #%d", description, number))
+
+	_, _, err = e.GetGithubClient().EditPullRequest(e.GetCtx(), targetEntity.Owner, targetEntity.Repo, targetEntity.Number, &github.PullRequest{
+		Body: github.String(description),
+	})
+
+	return err
+}
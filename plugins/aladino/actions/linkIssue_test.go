@@ -0,0 +1,99 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	pbc "github.com/reviewpad/api/go/codehost"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/reviewpad/reviewpad/v4/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var linkIssue = plugins_aladino.PluginBuiltIns().Actions["linkIssue"].Code
+
+func TestLinkIssue(t *testing.T) {
+	tests := map[string]struct {
+		description     string
+		issue           *github.Issue
+		wantDescription *string
+		wantErr         string
+	}{
+		"when issue is not linked": {
+			description:     "Please pull these awesome changes in!",
+			issue:           &github.Issue{Number: github.Int(12)},
+			wantDescription: github.String("Please pull these awesome changes in!\n\nCloses #12"),
+		},
+		"when issue is linked to another number": {
+			description:     "Fixes #123",
+			issue:           &github.Issue{Number: github.Int(12)},
+			wantDescription: github.String("Fixes #123\n\nCloses #12"),
+		},
+		"when issue is already linked": {
+			description: "Some changes.\n\nfixes #12",
+			issue:       &github.Issue{Number: github.Int(12)},
+		},
+		"when number is a pull request": {
+			description: "Please pull these awesome changes in!",
+			issue: &github.Issue{
+				Number:           github.Int(12),
+				PullRequestLinks: &github.PullRequestLinks{URL: github.String("https://api.github.com/repos/foobar/default-mock-repo/pulls/12")},
+			},
+			wantErr: "linkIssue: #12 is a pull request",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotDescription *string
+
+			mockedPullRequest := aladino.GetDefaultMockPullRequestDetailsWith(&pbc.PullRequest{
+				Description: test.description,
+			})
+
+			mockedEnv := aladino.MockDefaultEnvWithPullRequestAndFiles(
+				t,
+				[]mock.MockBackendOption{
+					mock.WithRequestMatch(
+						mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+						test.issue,
+					),
+					mock.WithRequestMatchHandler(
+						mock.PatchReposPullsByOwnerByRepoByPullNumber,
+						http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+							rawBody, _ := io.ReadAll(r.Body)
+							body := github.PullRequest{}
+
+							utils.MustUnmarshal(rawBody, &body)
+
+							gotDescription = body.Body
+						}),
+					),
+				},
+				nil,
+				mockedPullRequest,
+				aladino.GetDefaultPullRequestFileList(),
+				aladino.MockBuiltIns(),
+				nil,
+			)
+
+			err := linkIssue(mockedEnv, []lang.Value{lang.BuildIntValue(12)})
+
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, test.wantDescription, gotDescription)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions
+
+import (
+	"fmt"
+
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	"github.com/shurcooL/githubv4"
+)
+
+var lockReasons = map[string]githubv4.LockReason{
+	"off_topic":  githubv4.LockReasonOffTopic,
+	"resolved":   githubv4.LockReasonResolved,
+	"spam":       githubv4.LockReasonSpam,
+	"too_heated": githubv4.LockReasonTooHeated,
+}
+
+func Lock() *aladino.BuiltInAction {
+	return &aladino.BuiltInAction{
+		Type:           lang.BuildFunctionType([]lang.Type{lang.BuildStringType()}, nil),
+		Code:           lockCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue, entities.Discussion},
+	}
+}
+
+// lockCode locks the conversation with an optional reason.
+func lockCode(e aladino.Env, args []lang.Value) error {
+	if e.GetGithubClient() == nil {
+		return codehost.ErrNotSupported
+	}
+
+	reason := args[0].(*lang.StringValue).Val
+
+	var lockReason *githubv4.LockReason
+	if reason != "" {
+		r, ok := lockReasons[reason]
+		if !ok {
+			return fmt.Errorf("lock: invalid reason %v, expected one of [off_topic resolved spam too_heated]", reason)
+		}
+		lockReason = &r
+	}
+
+	return e.GetGithubClient().LockLockable(e.GetCtx(), e.GetTarget().GetNodeID(), lockReason)
+}
@@ -0,0 +1,96 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/reviewpad/reviewpad/v4/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var lock = plugins_aladino.PluginBuiltIns().Actions["lock"].Code
+
+func TestLock(t *testing.T) {
+	tests := map[string]struct {
+		reason       string
+		wantMutation string
+	}{
+		"when there is a reason": {
+			reason: "resolved",
+			wantMutation: `{
+				"query": "mutation($input:LockLockableInput!) {
+					lockLockable(input: $input) {
+						clientMutationId
+					}
+				}",
+				"variables": {
+					"input": {
+						"lockableId": "D_kwDOJRdjrs4AT7xD",
+						"lockReason": "RESOLVED"
+					}
+				}
+			}`,
+		},
+		"when there is no reason": {
+			reason: "",
+			wantMutation: `{
+				"query": "mutation($input:LockLockableInput!) {
+					lockLockable(input: $input) {
+						clientMutationId
+					}
+				}",
+				"variables": {
+					"input": {
+						"lockableId": "D_kwDOJRdjrs4AT7xD"
+					}
+				}
+			}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotMutation string
+			mockedEnv := aladino.MockDefaultEnvWithTargetEntity(
+				t,
+				nil,
+				aladino.MockDiscussionGraphQLHandler("[]", func(w http.ResponseWriter, query string) {
+					gotMutation = query
+					utils.MustWrite(w, `{"data": {"lockLockable": {"clientMutationId": ""}}}`)
+				}),
+				aladino.MockBuiltIns(),
+				nil,
+				aladino.DefaultMockDiscussionTargetEntity,
+			)
+
+			err := lock(mockedEnv, []lang.Value{lang.BuildStringValue(test.reason)})
+
+			assert.Nil(t, err)
+			assert.Equal(t, utils.MinifyQuery(test.wantMutation), gotMutation)
+		})
+	}
+}
+
+func TestLock_WhenReasonIsInvalid(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnvWithTargetEntity(
+		t,
+		nil,
+		aladino.MockDiscussionGraphQLHandler("[]", func(w http.ResponseWriter, query string) {
+			assert.FailNow(t, "unexpected request", query)
+		}),
+		aladino.MockBuiltIns(),
+		nil,
+		aladino.DefaultMockDiscussionTargetEntity,
+	)
+
+	err := lock(mockedEnv, []lang.Value{lang.BuildStringValue("duplicate")})
+
+	assert.EqualError(t, err, "lock: invalid reason duplicate, expected one of [off_topic resolved spam too_heated]")
+}
@@ -0,0 +1,32 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions
+
+import (
+	"fmt"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+)
+
+func MarkAnswer() *aladino.BuiltInAction {
+	return &aladino.BuiltInAction{
+		Type:           lang.BuildFunctionType([]lang.Type{}, nil),
+		Code:           markAnswerCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.Discussion},
+	}
+}
+
+// markAnswerCode marks the comment that triggered the discussion_comment event as the answer of the discussion.
+func markAnswerCode(e aladino.Env, _ []lang.Value) error {
+	event, ok := e.GetEventPayload().(*github.DiscussionCommentEvent)
+	if !ok {
+		return fmt.Errorf("markAnswer: only a discussion comment can be marked as the answer")
+	}
+
+	return e.GetGithubClient().MarkDiscussionCommentAsAnswer(e.GetCtx(), event.GetComment().GetNodeID())
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/reviewpad/reviewpad/v4/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var markAnswer = plugins_aladino.PluginBuiltIns().Actions["markAnswer"].Code
+
+func TestMarkAnswer(t *testing.T) {
+	var gotMutation string
+	mockedMarkDiscussionCommentAsAnswerMutation := `{
+		"query": "mutation($input:MarkDiscussionCommentAsAnswerInput!) {
+			markDiscussionCommentAsAnswer(input: $input) {
+				clientMutationId
+			}
+		}",
+		"variables": {
+			"input": {
+				"id": "DC_kwDOJRdjrs4AWz6g"
+			}
+		}
+	}`
+	mockedEnv := aladino.MockDefaultEnvWithTargetEntity(
+		t,
+		nil,
+		aladino.MockDiscussionGraphQLHandler("[]", func(w http.ResponseWriter, query string) {
+			gotMutation = query
+			utils.MustWrite(w, `{"data": {"markDiscussionCommentAsAnswer": {"clientMutationId": ""}}}`)
+		}),
+		aladino.MockBuiltIns(),
+		&github.DiscussionCommentEvent{
+			Comment: &github.CommentDiscussion{
+				NodeID: github.String("DC_kwDOJRdjrs4AWz6g"),
+			},
+		},
+		aladino.DefaultMockDiscussionTargetEntity,
+	)
+
+	err := markAnswer(mockedEnv, []lang.Value{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, utils.MinifyQuery(mockedMarkDiscussionCommentAsAnswerMutation), gotMutation)
+}
+
+func TestMarkAnswer_WhenEventIsNotADiscussionComment(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnvWithTargetEntity(
+		t,
+		nil,
+		aladino.MockDiscussionGraphQLHandler("[]", func(w http.ResponseWriter, query string) {
+			assert.FailNow(t, "unexpected request", query)
+		}),
+		aladino.MockBuiltIns(),
+		&github.DiscussionEvent{},
+		aladino.DefaultMockDiscussionTargetEntity,
+	)
+
+	err := markAnswer(mockedEnv, []lang.Value{})
+
+	assert.EqualError(t, err, "markAnswer: only a discussion comment can be marked as the answer")
+}
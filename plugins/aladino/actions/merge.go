@@ -7,10 +7,9 @@ package plugins_aladino_actions
 import (
 	"fmt"
 
-	"github.com/google/go-github/v52/github"
 	pbc "github.com/reviewpad/api/go/codehost"
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -24,10 +23,10 @@ func Merge() *aladino.BuiltInAction {
 }
 
 func mergeCode(e aladino.Env, args []lang.Value) error {
-	t := e.GetTarget().(*target.PullRequestTarget)
+	t := e.GetTarget().(codehost.PullRequest)
 	log := e.GetLogger().WithField("builtin", "merge")
 
-	if t.PullRequest.Status != pbc.PullRequestStatus_OPEN || t.PullRequest.IsDraft {
+	if t.GetPullRequest().Status != pbc.PullRequestStatus_OPEN || t.GetPullRequest().IsDraft {
 		log.Infof("skipping action because pull request is not open or is a draft")
 		return nil
 	}
@@ -41,13 +40,7 @@ func mergeCode(e aladino.Env, args []lang.Value) error {
 		return nil
 	}
 
-	isGithubMergeQueueEnabled, err := e.GetGithubClient().IsGithubMergeQueueEnabled(
-		e.GetCtx(),
-		t.PullRequest.GetBase().GetRepo().GetOwner(),
-		t.PullRequest.GetBase().GetRepo().GetName(),
-		t.PullRequest.GetBase().GetName(),
-	)
-
+	isGithubMergeQueueEnabled, err := t.IsMergeQueueEnabled()
 	if err != nil {
 		return err
 	}
@@ -80,17 +73,13 @@ func mergeCode(e aladino.Env, args []lang.Value) error {
 }
 
 func updateCheckRunWithSummary(e aladino.Env, summary string) error {
-	targetEntity := e.GetTarget().GetTargetEntity()
-	_, _, err := e.GetGithubClient().GetClientREST().Checks.UpdateCheckRun(e.GetCtx(), targetEntity.Owner, targetEntity.Repo, *e.GetCheckRunID(), github.UpdateCheckRunOptions{
+	return e.GetTarget().(codehost.PullRequest).UpdateCheckRun(*e.GetCheckRunID(), &codehost.CheckRun{
 		Name:       "reviewpad",
-		Status:     github.String("completed"),
-		Conclusion: github.String("success"),
-		Output: &github.CheckRunOutput{
-			Title:   github.String("Reviewpad about to merge"),
-			Summary: github.String(summary),
-		},
+		Status:     "completed",
+		Conclusion: "success",
+		Title:      "Reviewpad about to merge",
+		Summary:    summary,
 	})
-	return err
 }
 
 func parseMergeMethod(args []lang.Value) (string, error) {
@@ -108,24 +97,16 @@ func parseMergeMethod(args []lang.Value) (string, error) {
 }
 
 func processMergeForGitHubMergeQueue(e aladino.Env) error {
-	t := e.GetTarget().(*target.PullRequestTarget)
+	t := e.GetTarget().(codehost.PullRequest)
 	log := e.GetLogger().WithField("builtin", "merge")
 
-	totalRetryCount := 2
-	gitHubMergeQueueEntries, err := e.GetGithubClient().GetGitHubMergeQueueEntries(
-		e.GetCtx(),
-		t.PullRequest.GetBase().GetRepo().GetOwner(),
-		t.PullRequest.GetBase().GetRepo().GetName(),
-		t.PullRequest.GetBase().GetName(),
-		totalRetryCount,
-	)
-
+	gitHubMergeQueueEntries, err := t.GetMergeQueueEntries()
 	if err != nil {
 		return err
 	}
 
 	for _, pullRequestNumber := range gitHubMergeQueueEntries {
-		if int64(pullRequestNumber) == t.PullRequest.GetNumber() {
+		if int64(pullRequestNumber) == t.GetPullRequest().GetNumber() {
 			log.Infof("skipping action because pull request is already in the GitHub Merge Queue")
 			return nil
 		}
@@ -139,7 +120,7 @@ func processMergeForGitHubMergeQueue(e aladino.Env) error {
 		}
 	}
 
-	if err := e.GetGithubClient().AddPullRequestToGithubMergeQueue(e.GetCtx(), t.PullRequest.GetId()); err != nil {
+	if err := t.AddToMergeQueue(); err != nil {
 		if e.GetCheckRunID() != nil {
 			if checkRunUpdateErr := updateCheckRunWithSummary(e, fmt.Sprintf("The pull request cannot be added to the merge queue: %v", err)); checkRunUpdateErr != nil {
 				return checkRunUpdateErr
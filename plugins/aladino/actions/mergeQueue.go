@@ -0,0 +1,362 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/go-github/v52/github"
+	pbc "github.com/reviewpad/api/go/codehost"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	"github.com/reviewpad/reviewpad/v4/utils"
+)
+
+// MERGE_QUEUE_LABEL is the label of the pull requests in the merge queue managed by reviewpad.
+const MERGE_QUEUE_LABEL = "reviewpad-merge-queue"
+
+// MERGE_QUEUE_DISPATCH_EVENT_TYPE is the event type of the repository_dispatch events that run reviewpad on the next
+// pull request of the merge queue.
+const MERGE_QUEUE_DISPATCH_EVENT_TYPE = "reviewpad-merge-queue"
+
+// successfulCheckRunConclusions are the conclusions of the check runs that don't block the merge.
+var successfulCheckRunConclusions = []string{"success", "neutral", "skipped"}
+
+func MergeQueue() *aladino.BuiltInAction {
+	return &aladino.BuiltInAction{
+		Type:           lang.BuildFunctionType([]lang.Type{lang.BuildStringType()}, nil),
+		Code:           mergeQueueCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest},
+	}
+}
+
+// mergeQueueCode adds the pull request to the merge queue of the repository and merges the pull requests one at a time.
+// The queue is made of the open pull requests with the merge queue label, in the order they were labeled.
+// Each run moves the pull request at the front of the queue one step forward:
+// it is updated with the base branch, then its checks are awaited, then it is merged and the next pull request is updated.
+// The checks are the check runs, other than reviewpad's, and the commit statuses of the head commit. The merge waits for the
+// required status checks of the base branch and for at least one check, so that a pull request is not merged before its CI starts.
+// The runs triggered by the check_suite events of the updated pull requests take the queue forward.
+// When the next pull request is already up to date, a repository_dispatch event of type reviewpad-merge-queue runs reviewpad on it instead.
+// When GitHub's merge queue is enabled for the base branch, the pull request is added to it instead.
+func mergeQueueCode(e aladino.Env, args []lang.Value) error {
+	if e.GetGithubClient() == nil {
+		return codehost.ErrNotSupported
+	}
+
+	t := e.GetTarget().(codehost.PullRequest)
+	pr := t.GetPullRequest()
+	log := e.GetLogger().WithField("builtin", "mergeQueue")
+
+	if pr.Status != pbc.PullRequestStatus_OPEN || pr.IsDraft {
+		log.Infof("skipping action because pull request is not open or is a draft")
+		return nil
+	}
+
+	mergeMethod, err := parseMergeMethod(args)
+	if err != nil {
+		return fmt.Errorf("mergeQueue: unsupported merge method %v", args[0].(*lang.StringValue).Val)
+	}
+
+	if len(e.GetBuiltInsReportedMessages()[aladino.SEVERITY_FAIL]) > 0 {
+		return nil
+	}
+
+	isGithubMergeQueueEnabled, err := e.GetGithubClient().IsGithubMergeQueueEnabled(e.GetCtx(), pr.GetBase().GetRepo().GetOwner(), pr.GetBase().GetRepo().GetName(), pr.GetBase().GetName())
+	if err != nil {
+		return err
+	}
+
+	if isGithubMergeQueueEnabled {
+		return processMergeForGitHubMergeQueue(e)
+	}
+
+	if !hasLabel(t, MERGE_QUEUE_LABEL) {
+		if err := t.AddLabels([]string{MERGE_QUEUE_LABEL}); err != nil {
+			return err
+		}
+	}
+
+	queue, err := getMergeQueue(e)
+	if err != nil {
+		return err
+	}
+
+	number := t.GetTargetEntity().Number
+	position := 0
+	for i, queuedNumber := range queue {
+		if queuedNumber == number {
+			position = i + 1
+			break
+		}
+	}
+
+	// The pull request was just labeled and may not be listed yet.
+	if position == 0 {
+		queue = append(queue, number)
+		position = len(queue)
+	}
+
+	if position > 1 {
+		reportMergeQueue(e, aladino.SEVERITY_INFO, fmt.Sprintf("This pull request is at position %d of the merge queue, behind #%d.", position, queue[0]))
+		return nil
+	}
+
+	targetEntity := t.GetTargetEntity()
+	isUpToDate, err := e.GetGithubClient().GetPullRequestUpToDate(e.GetCtx(), targetEntity.Owner, targetEntity.Repo, number)
+	if err != nil {
+		return err
+	}
+
+	if !isUpToDate {
+		if err := e.GetGithubClient().UpdatePullRequestBranch(e.GetCtx(), targetEntity.Owner, targetEntity.Repo, number, pr.GetHead().GetSha()); err != nil {
+			return dequeue(e, queue, fmt.Sprintf("This pull request was removed from the merge queue because it could not be updated with %s: %v", pr.GetBase().GetName(), err))
+		}
+
+		reportMergeQueue(e, aladino.SEVERITY_INFO, fmt.Sprintf("This pull request is at the front of the merge queue and is being updated with %s.", pr.GetBase().GetName()))
+		return nil
+	}
+
+	checks, err := getMergeQueueChecks(e, pr.GetHead().GetSha())
+	if err != nil {
+		return err
+	}
+
+	for _, check := range checks {
+		if check.Status != "completed" {
+			reportMergeQueue(e, aladino.SEVERITY_INFO, "This pull request is at the front of the merge queue and is waiting for the checks to complete.")
+			return nil
+		}
+
+		if !utils.ElementOf(successfulCheckRunConclusions, check.Conclusion) {
+			return dequeue(e, queue, fmt.Sprintf("This pull request was removed from the merge queue because the check %s concluded with %s.", check.Name, check.Conclusion))
+		}
+	}
+
+	requiredChecks, err := e.GetGithubClient().GetRequiredStatusChecks(e.GetCtx(), targetEntity.Owner, targetEntity.Repo, pr.GetBase().GetName())
+	if err != nil {
+		log.WithError(err).Warnln("failed to get the required status checks")
+	}
+
+	for _, requiredCheck := range requiredChecks {
+		if !hasMergeQueueCheck(checks, requiredCheck) {
+			reportMergeQueue(e, aladino.SEVERITY_INFO, fmt.Sprintf("This pull request is at the front of the merge queue and is waiting for the required check %s.", requiredCheck))
+			return nil
+		}
+	}
+
+	// The checks are only created once the CI picks up the head commit, which usually happens after this run was triggered by the update.
+	if len(checks) == 0 {
+		reportMergeQueue(e, aladino.SEVERITY_INFO, "This pull request is at the front of the merge queue and is waiting for the checks to start.")
+		return nil
+	}
+
+	if err := t.Merge(mergeMethod); err != nil {
+		return dequeue(e, queue, fmt.Sprintf("This pull request was removed from the merge queue because it could not be merged: %v", err))
+	}
+
+	if err := t.RemoveLabel(MERGE_QUEUE_LABEL); err != nil {
+		log.WithError(err).Warnln("failed to remove the merge queue label")
+	}
+
+	advanceMergeQueue(e, queue)
+
+	return nil
+}
+
+// getMergeQueue returns the numbers of the open pull requests in the merge queue in the order they were labeled.
+func getMergeQueue(e aladino.Env) ([]int, error) {
+	targetEntity := e.GetTarget().GetTargetEntity()
+
+	issues, _, err := e.GetGithubClient().ListIssuesByRepo(e.GetCtx(), targetEntity.Owner, targetEntity.Repo, &github.IssueListByRepoOptions{
+		State:  "open",
+		Labels: []string{MERGE_QUEUE_LABEL},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	queue := make([]int, 0, len(issues))
+	queuedAt := make(map[int]time.Time, len(issues))
+	for _, issue := range issues {
+		if !issue.IsPullRequest() {
+			continue
+		}
+
+		labeledAt, err := getMergeQueueLabeledAt(e, issue.GetNumber())
+		if err != nil {
+			return nil, err
+		}
+
+		queue = append(queue, issue.GetNumber())
+		queuedAt[issue.GetNumber()] = labeledAt
+	}
+
+	sort.SliceStable(queue, func(i, j int) bool {
+		iQueuedAt, jQueuedAt := queuedAt[queue[i]], queuedAt[queue[j]]
+
+		// The pull requests whose labeled event is not in the timeline yet were just labeled.
+		if iQueuedAt.IsZero() || jQueuedAt.IsZero() {
+			return !iQueuedAt.IsZero() && jQueuedAt.IsZero()
+		}
+
+		return iQueuedAt.Before(jQueuedAt)
+	})
+
+	return queue, nil
+}
+
+// getMergeQueueLabeledAt returns when the merge queue label was last added to the pull request.
+func getMergeQueueLabeledAt(e aladino.Env, number int) (time.Time, error) {
+	targetEntity := e.GetTarget().GetTargetEntity()
+
+	timeline, err := e.GetGithubClient().GetIssueTimeline(e.GetCtx(), targetEntity.Owner, targetEntity.Repo, number)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var labeledAt time.Time
+	for _, event := range timeline {
+		if event.GetEvent() == "labeled" && event.GetLabel().GetName() == MERGE_QUEUE_LABEL && event.GetCreatedAt().After(labeledAt) {
+			labeledAt = event.GetCreatedAt().Time
+		}
+	}
+
+	return labeledAt, nil
+}
+
+// getMergeQueueChecks returns the check runs, other than reviewpad's, and the commit statuses of the head commit.
+// The commit statuses are returned as check runs that are completed once their state is no longer pending.
+func getMergeQueueChecks(e aladino.Env, headSHA string) ([]*codehost.CheckRun, error) {
+	targetEntity := e.GetTarget().GetTargetEntity()
+
+	checkRuns, err := e.GetTarget().(codehost.PullRequest).GetCheckRuns(headSHA)
+	if err != nil {
+		return nil, err
+	}
+
+	checks := make([]*codehost.CheckRun, 0, len(checkRuns))
+	for _, checkRun := range checkRuns {
+		if !isReviewpadCheckRun(e, checkRun) {
+			checks = append(checks, checkRun)
+		}
+	}
+
+	statuses, err := e.GetGithubClient().GetCommitStatuses(e.GetCtx(), targetEntity.Owner, targetEntity.Repo, headSHA)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, status := range statuses {
+		check := &codehost.CheckRun{
+			Name:       status.GetContext(),
+			Status:     "completed",
+			Conclusion: status.GetState(),
+		}
+
+		if status.GetState() == "pending" {
+			check.Status = "in_progress"
+			check.Conclusion = ""
+		}
+
+		checks = append(checks, check)
+	}
+
+	return checks, nil
+}
+
+func hasMergeQueueCheck(checks []*codehost.CheckRun, name string) bool {
+	for _, check := range checks {
+		if check.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isReviewpadCheckRun reports whether the check run is the one running this action or the check run of the report.
+func isReviewpadCheckRun(e aladino.Env, checkRun *codehost.CheckRun) bool {
+	if e.GetCheckRunID() != nil && checkRun.ID == *e.GetCheckRunID() {
+		return true
+	}
+
+	return e.GetCheckRun() != nil && checkRun.Name == e.GetCheckRun().GetName()
+}
+
+// dequeue removes the pull request from the merge queue, reports why and moves the queue forward.
+func dequeue(e aladino.Env, queue []int, reason string) error {
+	if err := e.GetTarget().RemoveLabel(MERGE_QUEUE_LABEL); err != nil {
+		return err
+	}
+
+	reportMergeQueue(e, aladino.SEVERITY_ERROR, reason)
+
+	advanceMergeQueue(e, queue)
+
+	return nil
+}
+
+// advanceMergeQueue updates the next pull request in the merge queue with its base branch.
+// The update triggers the checks of the pull request and, once they complete, a new run of reviewpad on it.
+// A pull request that is already up to date has no checks to trigger, so reviewpad is dispatched on it instead.
+func advanceMergeQueue(e aladino.Env, queue []int) {
+	targetEntity := e.GetTarget().GetTargetEntity()
+	log := e.GetLogger().WithField("builtin", "mergeQueue")
+
+	for _, number := range queue {
+		if number == targetEntity.Number {
+			continue
+		}
+
+		isUpToDate, err := e.GetGithubClient().GetPullRequestUpToDate(e.GetCtx(), targetEntity.Owner, targetEntity.Repo, number)
+		if err != nil {
+			log.WithError(err).Warnf("failed to check if pull request #%d of the merge queue is up to date", number)
+			return
+		}
+
+		if !isUpToDate {
+			pullRequest, _, err := e.GetGithubClient().GetPullRequest(e.GetCtx(), targetEntity.Owner, targetEntity.Repo, number)
+			if err != nil {
+				log.WithError(err).Warnf("failed to get pull request #%d of the merge queue", number)
+				return
+			}
+
+			if err := e.GetGithubClient().UpdatePullRequestBranch(e.GetCtx(), targetEntity.Owner, targetEntity.Repo, number, pullRequest.GetHead().GetSHA()); err != nil {
+				log.WithError(err).Warnf("failed to update pull request #%d of the merge queue", number)
+			}
+
+			return
+		}
+
+		err = e.GetGithubClient().CreateRepositoryDispatch(e.GetCtx(), targetEntity.Owner, targetEntity.Repo, MERGE_QUEUE_DISPATCH_EVENT_TYPE, map[string]interface{}{
+			utils.DISPATCH_INPUT_NUMBER: number,
+		})
+		if err != nil {
+			log.WithError(err).Warnf("failed to dispatch pull request #%d of the merge queue", number)
+		}
+
+		return
+	}
+}
+
+func reportMergeQueue(e aladino.Env, severity aladino.Severity, message string) {
+	reportedMessages := e.GetBuiltInsReportedMessages()
+	reportedMessages[severity] = append(reportedMessages[severity], message)
+}
+
+func hasLabel(t codehost.Target, name string) bool {
+	for _, label := range t.GetLabels() {
+		if label.GetName() == name {
+			return true
+		}
+	}
+
+	return false
+}
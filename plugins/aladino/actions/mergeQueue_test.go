@@ -0,0 +1,439 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions_test
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	pbc "github.com/reviewpad/api/go/codehost"
+	"github.com/reviewpad/reviewpad/v4/engine"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/reviewpad/reviewpad/v4/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var mergeQueue = plugins_aladino.PluginBuiltIns().Actions["mergeQueue"].Code
+
+type mergeQueueRequests struct {
+	addedLabels              bool
+	removedLabel             bool
+	merged                   bool
+	updatedPullRequestsPaths []string
+	updatedHeadSHAs          []string
+	dispatchedNumbers        []int
+}
+
+// mergeQueueState is the state of the repository served by the mock of the merge queue.
+type mergeQueueState struct {
+	queue     []int
+	upToDate  []int
+	checkRuns []*github.CheckRun
+	statuses  []*github.RepoStatus
+	// requiredChecks are the required status checks of the base branch, which is not protected when they are nil.
+	requiredChecks []string
+}
+
+// mockMergeQueueEnv mocks the merge queue with the pull requests labeled in the order of the queue.
+// The pull requests are listed in the reverse order, one per page.
+// The head SHA of the pull request #6 is headSha and the one of the others is headSha followed by their number.
+func mockMergeQueueEnv(t *testing.T, state *mergeQueueState, requests *mergeQueueRequests) aladino.Env {
+	queue := state.queue
+	labeledAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	queuedIssues := make([]*github.Issue, len(queue))
+	timelines := make(map[string][]*github.Timeline, len(queue))
+	for i, number := range queue {
+		queuedIssues[len(queue)-1-i] = &github.Issue{
+			Number:           github.Int(number),
+			PullRequestLinks: &github.PullRequestLinks{URL: github.String("https://foo.bar")},
+		}
+		timelines[fmt.Sprintf("/repos/foobar/default-mock-repo/issues/%d/timeline", number)] = []*github.Timeline{
+			{
+				Event:     github.String("labeled"),
+				Label:     &github.Label{Name: github.String("reviewpad-merge-queue")},
+				CreatedAt: &github.Timestamp{Time: labeledAt.Add(time.Duration(i) * time.Hour)},
+			},
+		}
+	}
+
+	mockedPullRequest := aladino.GetDefaultMockPullRequestDetailsWith(&pbc.PullRequest{
+		Head: &pbc.Branch{
+			Repo: &pbc.Repository{
+				Owner: "foobar",
+				Name:  "default-mock-repo",
+			},
+			Name: "new-topic",
+			Sha:  "headSha",
+		},
+	})
+
+	return aladino.MockDefaultEnvWithPullRequestAndFiles(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.PostReposIssuesLabelsByOwnerByRepoByIssueNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					requests.addedLabels = true
+					utils.MustWriteBytes(w, mock.MustMarshal([]*github.Label{}))
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.DeleteReposIssuesLabelsByOwnerByRepoByIssueNumberByName,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					requests.removedLabel = true
+					assert.Equal(t, "/repos/foobar/default-mock-repo/issues/6/labels/reviewpad-merge-queue", r.URL.Path)
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.GetReposIssuesByOwnerByRepo,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "reviewpad-merge-queue", r.URL.Query().Get("labels"))
+
+					if len(queuedIssues) == 0 {
+						utils.MustWriteBytes(w, mock.MustMarshal(queuedIssues))
+						return
+					}
+
+					page, err := strconv.Atoi(r.URL.Query().Get("page"))
+					assert.Nil(t, err)
+
+					if page < len(queuedIssues) {
+						w.Header().Add("Link", fmt.Sprintf(`<https://api.github.com/repositories/1/issues?page=%d>; rel="next", <https://api.github.com/repositories/1/issues?page=%d>; rel="last"`, page+1, len(queuedIssues)))
+					}
+
+					utils.MustWriteBytes(w, mock.MustMarshal(queuedIssues[page-1:page]))
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.GetReposIssuesTimelineByOwnerByRepoByIssueNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					utils.MustWriteBytes(w, mock.MustMarshal(timelines[r.URL.Path]))
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PostReposDispatchesByOwnerByRepo,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					body := github.DispatchRequestOptions{}
+					utils.MustUnmarshal([]byte(utils.MustRead(r.Body)), &body)
+
+					clientPayload := struct {
+						Number int `json:"number"`
+					}{}
+					utils.MustUnmarshal(*body.ClientPayload, &clientPayload)
+
+					assert.Equal(t, "reviewpad-merge-queue", body.EventType)
+					requests.dispatchedNumbers = append(requests.dispatchedNumbers, clientPayload.Number)
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PutReposPullsUpdateBranchByOwnerByRepoByPullNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					body := github.PullRequestBranchUpdateOptions{}
+					utils.MustUnmarshal([]byte(utils.MustRead(r.Body)), &body)
+
+					requests.updatedPullRequestsPaths = append(requests.updatedPullRequestsPaths, r.URL.Path)
+					requests.updatedHeadSHAs = append(requests.updatedHeadSHAs, body.GetExpectedHeadSHA())
+					w.WriteHeader(http.StatusAccepted)
+					utils.MustWriteBytes(w, mock.MustMarshal(&github.PullRequestBranchUpdateResponse{}))
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "/repos/foobar/default-mock-repo/commits/headSha/check-runs", r.URL.Path)
+					utils.MustWriteBytes(w, mock.MustMarshal(&github.ListCheckRunsResults{
+						Total:     github.Int(len(state.checkRuns)),
+						CheckRuns: state.checkRuns,
+					}))
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.GetReposCommitsStatusByOwnerByRepoByRef,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "/repos/foobar/default-mock-repo/commits/headSha/status", r.URL.Path)
+					utils.MustWriteBytes(w, mock.MustMarshal(&github.CombinedStatus{
+						TotalCount: github.Int(len(state.statuses)),
+						Statuses:   state.statuses,
+					}))
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.GetReposBranchesProtectionRequiredStatusChecksByOwnerByRepoByBranch,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "/repos/foobar/default-mock-repo/branches/master/protection/required_status_checks", r.URL.Path)
+					if state.requiredChecks == nil {
+						mock.WriteError(w, http.StatusNotFound, "Branch not protected")
+						return
+					}
+
+					requiredChecks := make([]*github.RequiredStatusCheck, len(state.requiredChecks))
+					for i, requiredCheck := range state.requiredChecks {
+						requiredChecks[i] = &github.RequiredStatusCheck{Context: requiredCheck}
+					}
+
+					utils.MustWriteBytes(w, mock.MustMarshal(&github.RequiredStatusChecks{Checks: requiredChecks}))
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.GetReposPullsByOwnerByRepoByPullNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					parts := strings.Split(r.URL.Path, "/")
+					utils.MustWriteBytes(w, mock.MustMarshal(&github.PullRequest{
+						Head: &github.PullRequestBranch{SHA: github.String("headSha" + parts[len(parts)-1])},
+					}))
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PutReposPullsMergeByOwnerByRepoByPullNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					requests.merged = true
+				}),
+			),
+		},
+		func(w http.ResponseWriter, req *http.Request) {
+			query := utils.MustRead(req.Body)
+			switch {
+			case strings.Contains(query, "mergeQueue"):
+				utils.MustWrite(w, `{"data": {"repository": {"mergeQueue": null}}}`)
+			case strings.Contains(query, "baseRefOid"):
+				body := struct {
+					Variables struct {
+						Number int `json:"number"`
+					} `json:"variables"`
+				}{}
+				utils.MustUnmarshal([]byte(query), &body)
+
+				baseRefOid := "oldBaseRefOid"
+				for _, number := range state.upToDate {
+					if number == body.Variables.Number {
+						baseRefOid = "baseRefOid"
+					}
+				}
+
+				utils.MustWrite(w, `{
+					"data": {
+						"repository": {
+							"pullRequest": {
+								"baseRefOid": "`+baseRefOid+`",
+								"baseRef": {
+									"target": {
+										"history": {
+											"nodes": [{"oid": "baseRefOid"}]
+										}
+									}
+								}
+							}
+						}
+					}
+				}`)
+			}
+		},
+		mockedPullRequest,
+		aladino.GetDefaultPullRequestFileList(),
+		aladino.MockBuiltIns(),
+		nil,
+	)
+}
+
+func TestMergeQueue(t *testing.T) {
+	reviewpadCheckRuns := []*github.CheckRun{
+		{ID: github.Int64(1), Name: github.String("reviewpad"), Status: github.String("in_progress")},
+		{ID: github.Int64(2), Name: github.String("reviewpad-report"), Status: github.String("completed"), Conclusion: github.String("failure")},
+	}
+
+	tests := map[string]struct {
+		state        mergeQueueState
+		wantMessages map[aladino.Severity][]string
+		wantRequests mergeQueueRequests
+	}{
+		"when pull request is behind in the queue": {
+			state: mergeQueueState{queue: []int{3, 6}},
+			wantMessages: map[aladino.Severity][]string{
+				aladino.SEVERITY_INFO: {"This pull request is at position 2 of the merge queue, behind #3."},
+			},
+			wantRequests: mergeQueueRequests{addedLabels: true},
+		},
+		"when pull request is not in the listed queue yet": {
+			state: mergeQueueState{queue: []int{3}},
+			wantMessages: map[aladino.Severity][]string{
+				aladino.SEVERITY_INFO: {"This pull request is at position 2 of the merge queue, behind #3."},
+			},
+			wantRequests: mergeQueueRequests{addedLabels: true},
+		},
+		"when pull request is not up to date": {
+			state: mergeQueueState{queue: []int{6, 8}},
+			wantMessages: map[aladino.Severity][]string{
+				aladino.SEVERITY_INFO: {"This pull request is at the front of the merge queue and is being updated with master."},
+			},
+			wantRequests: mergeQueueRequests{
+				addedLabels:              true,
+				updatedPullRequestsPaths: []string{"/repos/foobar/default-mock-repo/pulls/6/update-branch"},
+				updatedHeadSHAs:          []string{"headSha"},
+			},
+		},
+		"when checks are running": {
+			state: mergeQueueState{
+				queue:    []int{6, 8},
+				upToDate: []int{6},
+				checkRuns: []*github.CheckRun{
+					{Name: github.String("build"), Status: github.String("completed"), Conclusion: github.String("success")},
+					{Name: github.String("test"), Status: github.String("in_progress")},
+				},
+			},
+			wantMessages: map[aladino.Severity][]string{
+				aladino.SEVERITY_INFO: {"This pull request is at the front of the merge queue and is waiting for the checks to complete."},
+			},
+			wantRequests: mergeQueueRequests{addedLabels: true},
+		},
+		"when a status is pending": {
+			state: mergeQueueState{
+				queue:    []int{6, 8},
+				upToDate: []int{6},
+				checkRuns: []*github.CheckRun{
+					{Name: github.String("build"), Status: github.String("completed"), Conclusion: github.String("success")},
+				},
+				statuses: []*github.RepoStatus{
+					{Context: github.String("ci/jenkins"), State: github.String("pending")},
+				},
+			},
+			wantMessages: map[aladino.Severity][]string{
+				aladino.SEVERITY_INFO: {"This pull request is at the front of the merge queue and is waiting for the checks to complete."},
+			},
+			wantRequests: mergeQueueRequests{addedLabels: true},
+		},
+		"when checks did not start": {
+			state: mergeQueueState{
+				queue:     []int{6, 8},
+				upToDate:  []int{6},
+				checkRuns: reviewpadCheckRuns,
+			},
+			wantMessages: map[aladino.Severity][]string{
+				aladino.SEVERITY_INFO: {"This pull request is at the front of the merge queue and is waiting for the checks to start."},
+			},
+			wantRequests: mergeQueueRequests{addedLabels: true},
+		},
+		"when a required check did not start": {
+			state: mergeQueueState{
+				queue:    []int{6, 8},
+				upToDate: []int{6},
+				checkRuns: []*github.CheckRun{
+					{Name: github.String("build"), Status: github.String("completed"), Conclusion: github.String("success")},
+				},
+				requiredChecks: []string{"build", "test"},
+			},
+			wantMessages: map[aladino.Severity][]string{
+				aladino.SEVERITY_INFO: {"This pull request is at the front of the merge queue and is waiting for the required check test."},
+			},
+			wantRequests: mergeQueueRequests{addedLabels: true},
+		},
+		"when a check failed": {
+			state: mergeQueueState{
+				queue:    []int{6, 8},
+				upToDate: []int{6},
+				checkRuns: []*github.CheckRun{
+					{Name: github.String("test"), Status: github.String("completed"), Conclusion: github.String("failure")},
+				},
+			},
+			wantMessages: map[aladino.Severity][]string{
+				aladino.SEVERITY_ERROR: {"This pull request was removed from the merge queue because the check test concluded with failure."},
+			},
+			wantRequests: mergeQueueRequests{
+				addedLabels:              true,
+				removedLabel:             true,
+				updatedPullRequestsPaths: []string{"/repos/foobar/default-mock-repo/pulls/8/update-branch"},
+				updatedHeadSHAs:          []string{"headSha8"},
+			},
+		},
+		"when a status failed": {
+			state: mergeQueueState{
+				queue:    []int{6, 8},
+				upToDate: []int{6},
+				statuses: []*github.RepoStatus{
+					{Context: github.String("ci/jenkins"), State: github.String("error")},
+				},
+			},
+			wantMessages: map[aladino.Severity][]string{
+				aladino.SEVERITY_ERROR: {"This pull request was removed from the merge queue because the check ci/jenkins concluded with error."},
+			},
+			wantRequests: mergeQueueRequests{
+				addedLabels:              true,
+				removedLabel:             true,
+				updatedPullRequestsPaths: []string{"/repos/foobar/default-mock-repo/pulls/8/update-branch"},
+				updatedHeadSHAs:          []string{"headSha8"},
+			},
+		},
+		"when checks passed": {
+			state: mergeQueueState{
+				queue:    []int{6, 8},
+				upToDate: []int{6},
+				checkRuns: append([]*github.CheckRun{
+					{Name: github.String("build"), Status: github.String("completed"), Conclusion: github.String("success")},
+					{Name: github.String("lint"), Status: github.String("completed"), Conclusion: github.String("skipped")},
+				}, reviewpadCheckRuns...),
+				statuses: []*github.RepoStatus{
+					{Context: github.String("ci/jenkins"), State: github.String("success")},
+				},
+				requiredChecks: []string{"build", "ci/jenkins"},
+			},
+			wantMessages: map[aladino.Severity][]string{},
+			wantRequests: mergeQueueRequests{
+				addedLabels:              true,
+				removedLabel:             true,
+				merged:                   true,
+				updatedPullRequestsPaths: []string{"/repos/foobar/default-mock-repo/pulls/8/update-branch"},
+				updatedHeadSHAs:          []string{"headSha8"},
+			},
+		},
+		"when next pull request is up to date": {
+			state: mergeQueueState{
+				queue:    []int{6, 8},
+				upToDate: []int{6, 8},
+				checkRuns: []*github.CheckRun{
+					{Name: github.String("test"), Status: github.String("completed"), Conclusion: github.String("failure")},
+				},
+			},
+			wantMessages: map[aladino.Severity][]string{
+				aladino.SEVERITY_ERROR: {"This pull request was removed from the merge queue because the check test concluded with failure."},
+			},
+			wantRequests: mergeQueueRequests{
+				addedLabels:       true,
+				removedLabel:      true,
+				dispatchedNumbers: []int{8},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotRequests := mergeQueueRequests{}
+			mockedEnv := mockMergeQueueEnv(t, &test.state, &gotRequests)
+			mockedEnv.(*aladino.BaseEnv).CheckRunID = github.Int64(1)
+			mockedEnv.SetCheckRun(&engine.PadCheckRun{})
+
+			err := mergeQueue(mockedEnv, []lang.Value{lang.BuildStringValue("squash")})
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantRequests, gotRequests)
+			assert.Equal(t, test.wantMessages, mockedEnv.GetBuiltInsReportedMessages())
+		})
+	}
+}
+
+func TestMergeQueue_WhenMergeMethodIsUnsupported(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	err := mergeQueue(mockedEnv, []lang.Value{lang.BuildStringValue("INVALID")})
+
+	assert.EqualError(t, err, "mergeQueue: unsupported merge method INVALID")
+}
@@ -13,11 +13,13 @@ import (
 
 	"github.com/migueleliasweb/go-github-mock/src/mock"
 	pbc "github.com/reviewpad/api/go/codehost"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	host "github.com/reviewpad/reviewpad/v4/codehost/github"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
 	"github.com/reviewpad/reviewpad/v4/utils"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -27,6 +29,56 @@ type MergeRequestPostBody struct {
 	MergeMethod string `json:"merge_method"`
 }
 
+// inMemoryPullRequest is a pull request of a code host without a GitHub client.
+type inMemoryPullRequest struct {
+	codehost.PullRequest
+
+	mergeMethod string
+	checkRuns   []*codehost.CheckRun
+}
+
+func (t *inMemoryPullRequest) GetPullRequest() *pbc.PullRequest {
+	return &pbc.PullRequest{Number: 6, Status: pbc.PullRequestStatus_OPEN}
+}
+
+func (t *inMemoryPullRequest) IsMergeQueueEnabled() (bool, error) {
+	return false, nil
+}
+
+func (t *inMemoryPullRequest) UpdateCheckRun(_ int64, checkRun *codehost.CheckRun) error {
+	t.checkRuns = append(t.checkRuns, checkRun)
+	return nil
+}
+
+func (t *inMemoryPullRequest) Merge(mergeMethod string) error {
+	t.mergeMethod = mergeMethod
+	return nil
+}
+
+func TestMerge_WhenTargetHasNoGithubClient(t *testing.T) {
+	pullRequest := &inMemoryPullRequest{}
+	checkRunID := int64(1)
+	env := &aladino.BaseEnv{
+		BuiltInsReportedMessages: map[aladino.Severity][]string{},
+		Target:                   pullRequest,
+		Logger:                   logrus.NewEntry(logrus.New()),
+		CheckRunID:               &checkRunID,
+	}
+
+	err := merge(env, []lang.Value{lang.BuildStringValue("squash")})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "squash", pullRequest.mergeMethod)
+	assert.Equal(t, "success", env.CheckRunConclusion)
+	assert.Equal(t, []*codehost.CheckRun{{
+		Name:       "reviewpad",
+		Status:     "completed",
+		Conclusion: "success",
+		Title:      "Reviewpad about to merge",
+		Summary:    "Reviewpad is about to merge this pull request",
+	}}, pullRequest.checkRuns)
+}
+
 func TestMerge_WhenMergeMethodIsUnsupported(t *testing.T) {
 	mockedEnv := aladino.MockDefaultEnv(
 		t,
@@ -10,8 +10,8 @@ import (
 
 	git "github.com/libgit2/git2go/v31"
 	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	gh "github.com/reviewpad/reviewpad/v4/codehost/github"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -25,20 +25,23 @@ func Rebase() *aladino.BuiltInAction {
 }
 
 func rebaseCode(e aladino.Env, args []lang.Value) error {
-	githubToken := e.GetGithubClient().GetToken()
 	log := e.GetLogger().WithField("builtin", "rebase")
-	t := e.GetTarget().(*target.PullRequestTarget)
-	pr := t.PullRequest
+	t := e.GetTarget().(codehost.PullRequest)
+	pr := t.GetPullRequest()
 
 	if !pr.IsRebaseable {
 		return errors.New("the pull request is not rebaseable")
 	}
 
-	headHTMLUrl := pr.Head.GetRepo().GetUri()
+	credentials, err := t.GetCloneCredentials()
+	if err != nil {
+		return err
+	}
+
 	headRef := pr.Head.Name
 	baseRef := pr.Base.Name
 
-	repo, dir, err := gh.CloneRepository(log, headHTMLUrl, githubToken, "", &git.CloneOptions{
+	repo, dir, err := gh.CloneRepository(log, credentials.URL, credentials.Token, "", &git.CloneOptions{
 		CheckoutBranch: baseRef,
 	})
 	if err != nil {
@@ -56,7 +59,7 @@ func rebaseCode(e aladino.Env, args []lang.Value) error {
 		return err
 	}
 
-	err = gh.Push(log, repo, "origin", headRef, githubToken, true)
+	err = gh.Push(log, repo, "origin", headRef, credentials.Token, true)
 	if err != nil {
 		return err
 	}
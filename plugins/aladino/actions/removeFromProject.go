@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/codehost/github"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
@@ -23,6 +24,10 @@ func RemoveFromProject() *aladino.BuiltInAction {
 }
 
 func removeFromProjectCode(e aladino.Env, args []lang.Value) error {
+	if e.GetGithubClient() == nil {
+		return codehost.ErrNotSupported
+	}
+
 	target := e.GetTarget()
 	entity := target.GetTargetEntity()
 	owner := entity.Owner
@@ -9,7 +9,7 @@ import (
 
 	pbc "github.com/reviewpad/api/go/codehost"
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -23,16 +23,16 @@ func Review() *aladino.BuiltInAction {
 }
 
 func reviewCode(e aladino.Env, args []lang.Value) error {
-	t := e.GetTarget().(*target.PullRequestTarget)
+	t := e.GetTarget().(codehost.PullRequest)
 
 	log := e.GetLogger().WithField("builtin", "review")
 
-	if t.PullRequest.IsDraft {
+	if t.GetPullRequest().IsDraft {
 		log.Infof("skipping review because the pull request is in draft")
 		return nil
 	}
 
-	if t.PullRequest.Status == pbc.PullRequestStatus_CLOSED {
+	if t.GetPullRequest().Status == pbc.PullRequestStatus_CLOSED {
 		log.Infof("skipping review because the pull request is closed")
 		return nil
 	}
@@ -47,7 +47,7 @@ func reviewCode(e aladino.Env, args []lang.Value) error {
 		return err
 	}
 
-	authenticatedUserLogin, err := e.GetGithubClient().GetAuthenticatedUserLogin()
+	authenticatedUserLogin, err := t.GetAuthenticatedUserLogin()
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,68 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions
+
+import (
+	"fmt"
+
+	pbc "github.com/reviewpad/api/go/codehost"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+)
+
+func ReviewComment() *aladino.BuiltInAction {
+	return &aladino.BuiltInAction{
+		Type:           lang.BuildFunctionType([]lang.Type{lang.BuildStringType(), lang.BuildIntType(), lang.BuildStringType()}, nil),
+		Code:           reviewCommentCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest},
+	}
+}
+
+// reviewCommentCode comments on a line of the new version of a file changed by the pull request.
+// The comments of all the actions of the run are submitted together in a single review.
+func reviewCommentCode(e aladino.Env, args []lang.Value) error {
+	path := args[0].(*lang.StringValue).Val
+	line := args[1].(*lang.IntValue).Val
+	body := args[2].(*lang.StringValue).Val
+
+	if body == "" {
+		return fmt.Errorf("reviewComment: comment required")
+	}
+
+	return addPendingReviewComment(e, "reviewComment", path, line, body)
+}
+
+// addPendingReviewComment adds the comment to the review of the run when the line is part of the diff of the file.
+// The lines that are not part of the diff can't be commented on and are skipped.
+func addPendingReviewComment(e aladino.Env, builtIn, path string, line int, body string) error {
+	t := e.GetTarget().(codehost.PullRequest)
+	log := e.GetLogger().WithField("builtin", builtIn)
+
+	if t.GetPullRequest().Status != pbc.PullRequestStatus_OPEN {
+		log.Infof("skipping comment because the pull request is not open")
+		return nil
+	}
+
+	file, ok := t.GetPatch()[path]
+	if !ok {
+		log.Infof("skipping comment because the file %s was not changed", path)
+		return nil
+	}
+
+	if !file.IsCommentableLine(line) {
+		log.Infof("skipping comment because the line %d of %s is not part of the diff", line, path)
+		return nil
+	}
+
+	e.AddPendingReviewComment(&codehost.ReviewComment{
+		Path: path,
+		Line: line,
+		Body: body,
+	})
+
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions_test
+
+import (
+	"errors"
+	"testing"
+
+	pbc "github.com/reviewpad/api/go/codehost"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var reviewComment = plugins_aladino.PluginBuiltIns().Actions["reviewComment"].Code
+
+func TestReviewComment(t *testing.T) {
+	tests := map[string]struct {
+		path         string
+		line         int
+		body         string
+		status       pbc.PullRequestStatus
+		wantComments []*codehost.ReviewComment
+		wantErr      error
+	}{
+		"when line is part of the diff": {
+			status: pbc.PullRequestStatus_OPEN,
+			path:   "default-mock-repo/file1.ts",
+			line:   2,
+			body:   "Please rename this function.",
+			wantComments: []*codehost.ReviewComment{
+				{
+					Path: "default-mock-repo/file1.ts",
+					Line: 2,
+					Body: "Please rename this function.",
+				},
+			},
+		},
+		"when line is not part of the diff": {
+			status: pbc.PullRequestStatus_OPEN,
+			path:   "default-mock-repo/file1.ts",
+			line:   20,
+			body:   "Please rename this function.",
+		},
+		"when file was not changed": {
+			status: pbc.PullRequestStatus_OPEN,
+			path:   "default-mock-repo/file4.ts",
+			line:   2,
+			body:   "Please rename this function.",
+		},
+		"when pull request is closed": {
+			path:   "default-mock-repo/file1.ts",
+			line:   2,
+			body:   "Please rename this function.",
+			status: pbc.PullRequestStatus_CLOSED,
+		},
+		"when comment is empty": {
+			status:  pbc.PullRequestStatus_OPEN,
+			path:    "default-mock-repo/file1.ts",
+			line:    2,
+			wantErr: errors.New("reviewComment: comment required"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockedEnv := aladino.MockDefaultEnvWithPullRequestAndFiles(
+				t,
+				nil,
+				nil,
+				aladino.GetDefaultMockPullRequestDetailsWith(&pbc.PullRequest{Status: test.status}),
+				aladino.GetDefaultPullRequestFileList(),
+				aladino.MockBuiltIns(),
+				nil,
+			)
+
+			args := []lang.Value{lang.BuildStringValue(test.path), lang.BuildIntValue(test.line), lang.BuildStringValue(test.body)}
+			err := reviewComment(mockedEnv, args)
+
+			assert.Equal(t, test.wantErr, err)
+			assert.Equal(t, test.wantComments, mockedEnv.GetPendingReviewComments())
+		})
+	}
+}
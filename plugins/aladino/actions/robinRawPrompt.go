@@ -11,6 +11,7 @@ import (
 	api "github.com/reviewpad/api/go/services"
 	converter "github.com/reviewpad/go-lib/converters"
 	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 	plugins_aladino_services "github.com/reviewpad/reviewpad/v4/plugins/aladino/services"
@@ -25,6 +26,10 @@ func RobinRawPrompt() *aladino.BuiltInAction {
 }
 
 func robinRawPromptCode(e aladino.Env, args []lang.Value) error {
+	if e.GetGithubClient() == nil {
+		return codehost.ErrNotSupported
+	}
+
 	target := e.GetTarget()
 	targetEntity := target.GetTargetEntity()
 	systemPrompt := args[0].(*lang.StringValue).Val
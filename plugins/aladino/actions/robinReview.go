@@ -0,0 +1,300 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	pbc "github.com/reviewpad/api/go/codehost"
+	api_entities "github.com/reviewpad/api/go/entities"
+	api "github.com/reviewpad/api/go/services"
+	converter "github.com/reviewpad/go-lib/converters"
+	"github.com/reviewpad/go-lib/entities"
+	lib_http "github.com/reviewpad/go-lib/http"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino_services "github.com/reviewpad/reviewpad/v4/plugins/aladino/services"
+)
+
+const (
+	// ROBIN_REVIEW_MODEL is the model used to review the pull request.
+	ROBIN_REVIEW_MODEL = "openai-gpt-4"
+	// ROBIN_REVIEW_MAX_CHUNK_LINES is the maximum number of diff lines sent in a single request.
+	// Larger diff blocks are sent on their own.
+	ROBIN_REVIEW_MAX_CHUNK_LINES = 200
+	// ROBIN_REVIEW_MARKER identifies the review comments created by robinReview.
+	ROBIN_REVIEW_MARKER = "<!--@robin-review-->"
+)
+
+const robinReviewSystemPrompt = `You are a code reviewer. Review the changes to the file below following the instructions.
+Each line of the diff is prefixed with its line number in the new version of the file, followed by + for added lines and a space for unchanged lines. Removed lines are prefixed with - and have no line number.
+Reply only with a JSON array of findings. Each finding is an object with the fields "path", "line", "severity" and "message".
+The severity is one of "info", "warning" or "error". The line must be a line number of the new version of the file.
+Reply with [] when there are no findings.`
+
+type robinReviewFinding struct {
+	Path     string `json:"path"`
+	Line     int    `json:"line"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+type robinReviewChunk struct {
+	path  string
+	text  string
+	lines []int
+}
+
+func RobinReview() *aladino.BuiltInAction {
+	return &aladino.BuiltInAction{
+		Type:              lang.BuildFunctionType([]lang.Type{lang.BuildStringType()}, nil),
+		Code:              robinReviewCode,
+		SupportedKinds:    []entities.TargetEntityKind{entities.PullRequest},
+		RunAsynchronously: true,
+	}
+}
+
+func robinReviewCode(e aladino.Env, args []lang.Value) error {
+	if e.GetGithubClient() == nil {
+		return codehost.ErrNotSupported
+	}
+
+	t := e.GetTarget().(codehost.PullRequest)
+	targetEntity := t.GetTargetEntity()
+	instructions := args[0].(*lang.StringValue).Val
+
+	log := e.GetLogger().WithField("builtin", "robinReview")
+
+	if t.GetPullRequest().Status == pbc.PullRequestStatus_CLOSED {
+		log.Infof("skipping review because the pull request is closed")
+		return nil
+	}
+
+	service, ok := e.GetBuiltIns().Services[plugins_aladino_services.ROBIN_SERVICE_KEY]
+	if !ok {
+		return fmt.Errorf("robin service not found")
+	}
+
+	robinClient := service.(api.RobinClient)
+
+	findings := make([]*robinReviewFinding, 0)
+	for _, chunk := range buildRobinReviewChunks(t.GetPatch()) {
+		resp, err := robinClient.RawPrompt(e.GetCtx(), &api.RawPromptRequest{
+			SystemPrompt: robinReviewSystemPrompt,
+			UserPrompt:   fmt.Sprintf("Instructions:\n%s\n\nFile: %s\n\n%s", instructions, chunk.path, chunk.text),
+			Token:        e.GetGithubClient().GetToken(),
+			Target: &api_entities.TargetEntity{
+				Owner:  targetEntity.Owner,
+				Repo:   targetEntity.Repo,
+				Kind:   converter.ToEntityKind(targetEntity.Kind),
+				Number: int32(targetEntity.Number),
+			},
+			Act:   false,
+			Model: ROBIN_REVIEW_MODEL,
+		})
+		if err != nil {
+			log.Infof("review failed with %v", err)
+			return fmt.Errorf("review failed on request %v - please contact us on [Discord](https://reviewpad.com/discord)", lib_http.InRequestID(e.GetCtx()))
+		}
+
+		chunkFindings, err := parseRobinReviewFindings(resp.Reply)
+		if err != nil {
+			log.Warnf("ignoring invalid review of %s: %v", chunk.path, err)
+			continue
+		}
+
+		for _, finding := range chunkFindings {
+			// Each chunk covers a single file so the path reported by the model is not trusted.
+			finding.Path = chunk.path
+			finding.Line = mapRobinReviewLine(finding.Line, chunk.lines)
+			if !t.GetPatch()[chunk.path].IsCommentableLine(finding.Line) {
+				continue
+			}
+
+			findings = append(findings, finding)
+		}
+	}
+
+	existingComments, err := t.GetReviewComments()
+	if err != nil {
+		return err
+	}
+
+	comments := buildRobinReviewComments(findings, existingComments)
+	if len(comments) == 0 {
+		log.Infof("skipping review because there are no new findings")
+		return nil
+	}
+
+	log.Infof("creating review with %d comments", len(comments))
+
+	return t.ReviewWithComments("COMMENT", fmt.Sprintf("**AI-Generated Review**: %d new finding(s).", len(comments)), comments)
+}
+
+// buildRobinReviewChunks groups the diff blocks of each file in chunks of at most ROBIN_REVIEW_MAX_CHUNK_LINES lines.
+// Each chunk line is annotated with its line number in the new version of the file.
+func buildRobinReviewChunks(patch codehost.Patch) []*robinReviewChunk {
+	paths := make([]string, 0, len(patch))
+	for path := range patch {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	chunks := make([]*robinReviewChunk, 0)
+	for _, path := range paths {
+		var current *robinReviewChunk
+		var currentLines []string
+
+		flush := func() {
+			if current != nil && len(current.lines) > 0 {
+				current.text = strings.Join(currentLines, "\n")
+				chunks = append(chunks, current)
+			}
+			current = nil
+			currentLines = nil
+		}
+
+		for _, block := range patch[path].Diff {
+			newStart := 0
+			if block.New != nil {
+				newStart = int(block.New.Start)
+			}
+
+			blockLines, newLines := renderRobinReviewBlock(block.IsContext, newStart, block.OldLines(), block.NewLines())
+
+			if current != nil && len(currentLines)+len(blockLines) > ROBIN_REVIEW_MAX_CHUNK_LINES {
+				flush()
+			}
+
+			if current == nil {
+				current = &robinReviewChunk{path: path}
+			}
+
+			currentLines = append(currentLines, blockLines...)
+			current.lines = append(current.lines, newLines...)
+		}
+
+		flush()
+	}
+
+	return chunks
+}
+
+func renderRobinReviewBlock(isContext bool, start int, oldLines, newLines []string) ([]string, []int) {
+	rendered := make([]string, 0, len(oldLines)+len(newLines))
+	numbers := make([]int, 0, len(newLines))
+
+	if !isContext {
+		for _, line := range oldLines {
+			rendered = append(rendered, fmt.Sprintf("     -%s", line))
+		}
+	}
+
+	prefix := "+"
+	if isContext {
+		prefix = " "
+	}
+
+	for i, line := range newLines {
+		rendered = append(rendered, fmt.Sprintf("%4d %s%s", start+i, prefix, line))
+		numbers = append(numbers, start+i)
+	}
+
+	return rendered, numbers
+}
+
+// parseRobinReviewFindings extracts the JSON array of findings from the reply.
+// Models often wrap the array in a code block or add some text around it.
+func parseRobinReviewFindings(reply string) ([]*robinReviewFinding, error) {
+	start := strings.Index(reply, "[")
+	end := strings.LastIndex(reply, "]")
+	if start == -1 || end < start {
+		return nil, fmt.Errorf("no findings found in reply")
+	}
+
+	findings := make([]*robinReviewFinding, 0)
+	if err := json.Unmarshal([]byte(reply[start:end+1]), &findings); err != nil {
+		return nil, err
+	}
+
+	validFindings := make([]*robinReviewFinding, 0, len(findings))
+	for _, finding := range findings {
+		if strings.TrimSpace(finding.Message) == "" {
+			continue
+		}
+
+		finding.Severity = strings.ToLower(strings.TrimSpace(finding.Severity))
+		if finding.Severity != "warning" && finding.Severity != "error" {
+			finding.Severity = "info"
+		}
+
+		validFindings = append(validFindings, finding)
+	}
+
+	return validFindings, nil
+}
+
+// mapRobinReviewLine maps the line of a finding to the closest line of the chunk that can be commented.
+func mapRobinReviewLine(line int, chunkLines []int) int {
+	if len(chunkLines) == 0 {
+		return line
+	}
+
+	closest := chunkLines[0]
+	for _, chunkLine := range chunkLines {
+		if chunkLine == line {
+			return line
+		}
+
+		if abs(chunkLine-line) < abs(closest-line) {
+			closest = chunkLine
+		}
+	}
+
+	return closest
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// buildRobinReviewComments creates the review comments for the findings that were not reported in earlier reviews.
+func buildRobinReviewComments(findings []*robinReviewFinding, existingComments []*codehost.ReviewComment) []*codehost.ReviewComment {
+	reported := make(map[string]bool)
+	for _, comment := range existingComments {
+		if strings.Contains(comment.Body, ROBIN_REVIEW_MARKER) {
+			reported[robinReviewCommentKey(comment.Path, comment.Line, comment.Body)] = true
+		}
+	}
+
+	comments := make([]*codehost.ReviewComment, 0, len(findings))
+	for _, finding := range findings {
+		body := fmt.Sprintf("**%s**: %s\n%s", finding.Severity, strings.TrimSpace(finding.Message), ROBIN_REVIEW_MARKER)
+		key := robinReviewCommentKey(finding.Path, finding.Line, body)
+		if reported[key] {
+			continue
+		}
+
+		reported[key] = true
+		comments = append(comments, &codehost.ReviewComment{
+			Path: finding.Path,
+			Line: finding.Line,
+			Body: body,
+		})
+	}
+
+	return comments
+}
+
+func robinReviewCommentKey(path string, line int, body string) string {
+	return fmt.Sprintf("%s:%d:%s", path, line, strings.TrimSpace(body))
+}
@@ -0,0 +1,181 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	pbc "github.com/reviewpad/api/go/codehost"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	plugins_aladino_actions "github.com/reviewpad/reviewpad/v4/plugins/aladino/actions"
+	plugins_aladino_services "github.com/reviewpad/reviewpad/v4/plugins/aladino/services"
+	"github.com/reviewpad/reviewpad/v4/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var robinReview = plugins_aladino.PluginBuiltIns().Actions["robinReview"].Code
+
+const mockedRobinReviewReply = "Here is my review:\n```json\n" + `[
+	{"path": "other.go", "line": 2, "severity": "WARNING", "message": "The import is not used."},
+	{"path": "main.go", "line": 10, "severity": "nit", "message": "Add a doc comment."},
+	{"path": "main.go", "line": 3, "severity": "error", "message": ""}
+]` + "\n```"
+
+func mockRobinReviewEnv(t *testing.T, backend *plugins_aladino_services.FakeLLMBackend, existingComments []*github.PullRequestComment, gotReview **github.PullRequestReviewRequest) aladino.Env {
+	robinClient, err := plugins_aladino_services.NewLLMRobinClient(backend, nil)
+	assert.Nil(t, err)
+
+	mockedFiles := []*pbc.File{
+		{
+			Filename: "main.go",
+			Patch:    "@@ -1,2 +1,3 @@\n package main\n+import \"fmt\"\n func main() {}",
+		},
+	}
+
+	return aladino.MockDefaultEnvWithPullRequestAndFiles(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatch(
+				mock.GetReposPullsCommentsByOwnerByRepoByPullNumber,
+				existingComments,
+			),
+			mock.WithRequestMatchHandler(
+				mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					rawBody, _ := io.ReadAll(r.Body)
+					utils.MustUnmarshal(rawBody, gotReview)
+					utils.MustWriteBytes(w, mock.MustMarshal(github.PullRequestReview{}))
+				}),
+			),
+		},
+		nil,
+		aladino.GetDefaultPullRequestDetails(),
+		mockedFiles,
+		&aladino.BuiltIns{
+			Services: map[string]interface{}{
+				plugins_aladino_services.ROBIN_SERVICE_KEY: robinClient,
+			},
+		},
+		nil,
+	)
+}
+
+func TestRobinReview(t *testing.T) {
+	tests := map[string]struct {
+		existingComments []*github.PullRequestComment
+		wantReview       *github.PullRequestReviewRequest
+	}{
+		"when there are no earlier comments": {
+			existingComments: []*github.PullRequestComment{},
+			wantReview: &github.PullRequestReviewRequest{
+				Body:  github.String("**AI-Generated Review**: 2 new finding(s)."),
+				Event: github.String("COMMENT"),
+				Comments: []*github.DraftReviewComment{
+					{
+						Path: github.String("main.go"),
+						Line: github.Int(2),
+						Side: github.String("RIGHT"),
+						Body: github.String("**warning**: The import is not used.\n" + plugins_aladino_actions.ROBIN_REVIEW_MARKER),
+					},
+					{
+						Path: github.String("main.go"),
+						Line: github.Int(3),
+						Side: github.String("RIGHT"),
+						Body: github.String("**info**: Add a doc comment.\n" + plugins_aladino_actions.ROBIN_REVIEW_MARKER),
+					},
+				},
+			},
+		},
+		"when a finding was already reported": {
+			existingComments: []*github.PullRequestComment{
+				{
+					Path: github.String("main.go"),
+					Line: github.Int(2),
+					Body: github.String("**warning**: The import is not used.\n" + plugins_aladino_actions.ROBIN_REVIEW_MARKER),
+				},
+				{
+					Path: github.String("main.go"),
+					Line: github.Int(3),
+					Body: github.String("**info**: Add a doc comment."),
+				},
+			},
+			wantReview: &github.PullRequestReviewRequest{
+				Body:  github.String("**AI-Generated Review**: 1 new finding(s)."),
+				Event: github.String("COMMENT"),
+				Comments: []*github.DraftReviewComment{
+					{
+						Path: github.String("main.go"),
+						Line: github.Int(3),
+						Side: github.String("RIGHT"),
+						Body: github.String("**info**: Add a doc comment.\n" + plugins_aladino_actions.ROBIN_REVIEW_MARKER),
+					},
+				},
+			},
+		},
+		"when all findings were already reported": {
+			existingComments: []*github.PullRequestComment{
+				{
+					Path: github.String("main.go"),
+					Line: github.Int(2),
+					Body: github.String("**warning**: The import is not used.\n" + plugins_aladino_actions.ROBIN_REVIEW_MARKER),
+				},
+				{
+					Path: github.String("main.go"),
+					Line: github.Int(3),
+					Body: github.String("**info**: Add a doc comment.\n" + plugins_aladino_actions.ROBIN_REVIEW_MARKER),
+				},
+			},
+			wantReview: nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotReview *github.PullRequestReviewRequest
+			backend := plugins_aladino_services.NewFakeLLMBackend(mockedRobinReviewReply)
+			mockedEnv := mockRobinReviewEnv(t, backend, test.existingComments, &gotReview)
+
+			err := robinReview(mockedEnv, []lang.Value{lang.BuildStringValue("Look for unused imports.")})
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantReview, gotReview)
+
+			requests := backend.Requests()
+			assert.Len(t, requests, 1)
+			assert.Equal(t, "gpt-4", requests[0].Model)
+			assert.Equal(t, "Instructions:\nLook for unused imports.\n\nFile: main.go\n\n   1  package main\n   2 +import \"fmt\"\n   3  func main() {}", requests[0].UserPrompt)
+		})
+	}
+}
+
+func TestRobinReview_WhenReplyIsInvalid(t *testing.T) {
+	var gotReview *github.PullRequestReviewRequest
+	backend := plugins_aladino_services.NewFakeLLMBackend("I could not review this file.")
+	mockedEnv := mockRobinReviewEnv(t, backend, []*github.PullRequestComment{}, &gotReview)
+
+	err := robinReview(mockedEnv, []lang.Value{lang.BuildStringValue("Look for unused imports.")})
+
+	assert.Nil(t, err)
+	assert.Nil(t, gotReview)
+}
+
+func TestRobinReview_WhenRobinFails(t *testing.T) {
+	var gotReview *github.PullRequestReviewRequest
+	backend := plugins_aladino_services.NewFakeLLMBackend("")
+	backend.Err = errors.New("backend unavailable")
+	mockedEnv := mockRobinReviewEnv(t, backend, []*github.PullRequestComment{}, &gotReview)
+
+	err := robinReview(mockedEnv, []lang.Value{lang.BuildStringValue("Look for unused imports.")})
+
+	assert.ErrorContains(t, err, "review failed on request")
+	assert.Nil(t, gotReview)
+}
@@ -12,6 +12,7 @@ import (
 	converter "github.com/reviewpad/go-lib/converters"
 	"github.com/reviewpad/go-lib/entities"
 	lib_http "github.com/reviewpad/go-lib/http"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 	plugins_aladino_services "github.com/reviewpad/reviewpad/v4/plugins/aladino/services"
@@ -27,6 +28,10 @@ func RobinSummarize() *aladino.BuiltInAction {
 }
 
 func robinSummarizeCode(e aladino.Env, args []lang.Value) error {
+	if e.GetGithubClient() == nil {
+		return codehost.ErrNotSupported
+	}
+
 	target := e.GetTarget()
 	targetEntity := target.GetTargetEntity()
 	summaryMode := args[0].(*lang.StringValue).Val
@@ -0,0 +1,68 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions
+
+import (
+	"fmt"
+
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	gh "github.com/reviewpad/reviewpad/v4/codehost/github"
+	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	"github.com/reviewpad/reviewpad/v4/utils"
+)
+
+var commitStatusStates = []string{"error", "failure", "pending", "success"}
+
+func SetCommitStatus() *aladino.BuiltInAction {
+	return &aladino.BuiltInAction{
+		Type:           lang.BuildFunctionType([]lang.Type{lang.BuildStringType(), lang.BuildStringType(), lang.BuildStringType()}, nil),
+		Code:           setCommitStatusCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, codehost.Branch},
+	}
+}
+
+// setCommitStatusCode sets a commit status with the state, context and description
+// on the last commit of the pull request or on the head commit of the push.
+func setCommitStatusCode(e aladino.Env, args []lang.Value) error {
+	targetEntity := e.GetTarget().GetTargetEntity()
+	state := args[0].(*lang.StringValue).Val
+	statusContext := args[1].(*lang.StringValue).Val
+	description := args[2].(*lang.StringValue).Val
+
+	if !utils.ElementOf(commitStatusStates, state) {
+		return fmt.Errorf("setCommitStatus: invalid state %v, expected one of %v", state, commitStatusStates)
+	}
+
+	if statusContext == "" {
+		return fmt.Errorf("setCommitStatus: context can't be empty")
+	}
+
+	var sha string
+	switch t := e.GetTarget().(type) {
+	case *target.BranchTarget:
+		sha = t.GetHeadSHA()
+	case codehost.PullRequest:
+		lastCommit, err := t.GetLastCommit()
+		if err != nil {
+			return err
+		}
+		sha = lastCommit
+	}
+
+	if e.GetGithubClient() == nil {
+		return codehost.ErrNotSupported
+	}
+
+	_, err := e.GetGithubClient().CreateCommitStatus(e.GetCtx(), targetEntity.Owner, targetEntity.Repo, sha, &gh.CreateCommitStatusOptions{
+		State:       state,
+		Context:     statusContext,
+		Description: description,
+	})
+
+	return err
+}
@@ -0,0 +1,87 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	gh "github.com/reviewpad/reviewpad/v4/codehost/github"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/reviewpad/reviewpad/v4/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var setCommitStatus = plugins_aladino.PluginBuiltIns().Actions["setCommitStatus"].Code
+
+func TestSetCommitStatus(t *testing.T) {
+	pushEvent := aladino.GetDefaultMockPushEvent()
+	var gotStatus *gh.CreateCommitStatusOptions
+	mockedEnv := aladino.MockDefaultEnvWithTargetEntity(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.PostReposStatusesByOwnerByRepoBySha,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "/repos/foobar/default-mock-repo/statuses/"+pushEvent.GetAfter(), r.URL.Path)
+
+					rawBody, _ := io.ReadAll(r.Body)
+					utils.MustUnmarshal(rawBody, &gotStatus)
+
+					w.WriteHeader(http.StatusCreated)
+					w.Write([]byte("{}"))
+				}),
+			),
+		},
+		nil,
+		aladino.MockBuiltIns(),
+		pushEvent,
+		aladino.DefaultMockBranchTargetEntity,
+	)
+
+	args := []lang.Value{lang.BuildStringValue("failure"), lang.BuildStringValue("reviewpad/changelog"), lang.BuildStringValue("CHANGELOG.md was not updated")}
+	err := setCommitStatus(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &gh.CreateCommitStatusOptions{
+		State:       "failure",
+		Context:     "reviewpad/changelog",
+		Description: "CHANGELOG.md was not updated",
+	}, gotStatus)
+}
+
+func TestSetCommitStatus_WhenArgumentsAreInvalid(t *testing.T) {
+	tests := map[string]struct {
+		state   string
+		context string
+		wantErr string
+	}{
+		"when state is invalid": {
+			state:   "failed",
+			context: "reviewpad/changelog",
+			wantErr: "setCommitStatus: invalid state failed, expected one of [error failure pending success]",
+		},
+		"when context is empty": {
+			state:   "success",
+			context: "",
+			wantErr: "setCommitStatus: context can't be empty",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockedEnv := aladino.MockDefaultEnvWithTargetEntity(t, nil, nil, aladino.MockBuiltIns(), aladino.GetDefaultMockPushEvent(), aladino.DefaultMockBranchTargetEntity)
+
+			args := []lang.Value{lang.BuildStringValue(test.state), lang.BuildStringValue(test.context), lang.BuildStringValue("")}
+			err := setCommitStatus(mockedEnv, args)
+
+			assert.EqualError(t, err, test.wantErr)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions
+
+import (
+	"fmt"
+
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+)
+
+func SuggestChange() *aladino.BuiltInAction {
+	return &aladino.BuiltInAction{
+		Type:           lang.BuildFunctionType([]lang.Type{lang.BuildStringType(), lang.BuildIntType(), lang.BuildStringType()}, nil),
+		Code:           suggestChangeCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest},
+	}
+}
+
+// suggestChangeCode suggests replacing a line of the new version of a file changed by the pull request.
+// The suggestion can be applied from the pull request page.
+func suggestChangeCode(e aladino.Env, args []lang.Value) error {
+	path := args[0].(*lang.StringValue).Val
+	line := args[1].(*lang.IntValue).Val
+	replacement := args[2].(*lang.StringValue).Val
+
+	body := fmt.Sprintf("```suggestion\n%s\n```", replacement)
+
+	return addPendingReviewComment(e, "suggestChange", path, line, body)
+}
@@ -0,0 +1,45 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions_test
+
+import (
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var suggestChange = plugins_aladino.PluginBuiltIns().Actions["suggestChange"].Code
+
+func TestSuggestChange(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	args := []lang.Value{lang.BuildStringValue("default-mock-repo/file1.ts"), lang.BuildIntValue(2), lang.BuildStringValue(" func renamed1() {")}
+	err := suggestChange(mockedEnv, args)
+
+	wantComments := []*codehost.ReviewComment{
+		{
+			Path: "default-mock-repo/file1.ts",
+			Line: 2,
+			Body: "```suggestion\n func renamed1() {\n```",
+		},
+	}
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantComments, mockedEnv.GetPendingReviewComments())
+}
+
+func TestSuggestChange_WhenLineIsNotPartOfTheDiff(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnv(t, nil, nil, aladino.MockBuiltIns(), nil)
+
+	args := []lang.Value{lang.BuildStringValue("default-mock-repo/file1.ts"), lang.BuildIntValue(1), lang.BuildStringValue(" func renamed1() {")}
+	err := suggestChange(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Nil(t, mockedEnv.GetPendingReviewComments())
+}
@@ -7,8 +7,6 @@ package plugins_aladino_actions
 import (
 	"fmt"
 
-	"github.com/reviewpad/go-conventionalcommits"
-	"github.com/reviewpad/go-conventionalcommits/parser"
 	"github.com/reviewpad/go-lib/entities"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
@@ -25,7 +23,7 @@ func TitleLint() *aladino.BuiltInAction {
 func titleLintCode(e aladino.Env, _ []lang.Value) error {
 	title := e.GetTarget().GetTitle()
 
-	res, err := parser.NewMachine(conventionalcommits.WithTypes(conventionalcommits.TypesConventional)).Parse([]byte(title))
+	res, err := parseConventionalCommit(title)
 	if err != nil || !res.Ok() {
 		body := fmt.Sprintf("**Unconventional title detected**: '%v'", title)
 		if err != nil {
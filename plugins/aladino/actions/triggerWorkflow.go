@@ -6,7 +6,7 @@ package plugins_aladino_actions
 
 import (
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -20,7 +20,7 @@ func TriggerWorkflow() *aladino.BuiltInAction {
 }
 
 func triggerWorkflowCode(e aladino.Env, args []lang.Value) error {
-	t := e.GetTarget().(*target.PullRequestTarget)
+	t := e.GetTarget().(codehost.PullRequest)
 
 	fileName := args[0].(*lang.StringValue).Val
 
@@ -0,0 +1,134 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	git "github.com/libgit2/git2go/v31"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	gh "github.com/reviewpad/reviewpad/v4/codehost/github"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	"github.com/reviewpad/reviewpad/v4/utils"
+)
+
+const (
+	UPDATE_BRANCH_MERGE_STRATEGY  = "merge"
+	UPDATE_BRANCH_REBASE_STRATEGY = "rebase"
+)
+
+var updateBranchStrategies = []string{UPDATE_BRANCH_MERGE_STRATEGY, UPDATE_BRANCH_REBASE_STRATEGY}
+
+// updateBranchSignature is the author and committer of the merge commits.
+var updateBranchSignature = git.Signature{
+	Name:  "reviewpad[bot]",
+	Email: "reviewpad[bot]@users.noreply.github.com",
+}
+
+func UpdateBranch() *aladino.BuiltInAction {
+	return &aladino.BuiltInAction{
+		Type:           lang.BuildFunctionType([]lang.Type{lang.BuildStringType(), lang.BuildBoolType()}, nil),
+		Code:           updateBranchCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest},
+	}
+}
+
+// updateBranchCode brings the head branch of the pull request up to date with the base branch.
+// The merge strategy uses GitHub's update branch API and falls back to merging on a local clone.
+// The rebase strategy always rebases on a local clone and requires force to push the rewritten branch.
+// When the branches conflict, the conflicting files are reported and the branch is left untouched.
+func updateBranchCode(e aladino.Env, args []lang.Value) error {
+	strategy := args[0].(*lang.StringValue).Val
+	force := args[1].(*lang.BoolValue).Val
+
+	if !utils.ElementOf(updateBranchStrategies, strategy) {
+		return fmt.Errorf("updateBranch: invalid strategy %v, expected one of %v", strategy, updateBranchStrategies)
+	}
+
+	if strategy == UPDATE_BRANCH_REBASE_STRATEGY && !force {
+		return errors.New("updateBranch: the rebase strategy rewrites the branch, set force to true to allow the force push")
+	}
+
+	log := e.GetLogger().WithField("builtin", "updateBranch")
+	githubClient := e.GetGithubClient()
+	t := e.GetTarget().(codehost.PullRequest)
+	targetEntity := t.GetTargetEntity()
+	pr := t.GetPullRequest()
+
+	// The update branch API is a GitHub feature, so the branches of other code hosts are updated on a local clone.
+	if strategy == UPDATE_BRANCH_MERGE_STRATEGY && githubClient != nil {
+		err := githubClient.UpdatePullRequestBranch(e.GetCtx(), targetEntity.Owner, targetEntity.Repo, targetEntity.Number, pr.Head.Sha)
+		if err == nil {
+			return nil
+		}
+
+		log.WithError(err).Info("failed to update branch with the update branch API, updating on a local clone")
+	}
+
+	credentials, err := t.GetCloneCredentials()
+	if err != nil {
+		return err
+	}
+
+	headRef := pr.Head.Name
+	baseRef := pr.Base.Name
+
+	repo, dir, err := gh.CloneRepository(log, credentials.URL, credentials.Token, "", &git.CloneOptions{
+		CheckoutBranch: baseRef,
+	})
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	err = gh.CheckoutBranch(log, repo, headRef)
+	if err != nil {
+		return err
+	}
+
+	conflicts, err := gh.GetMergeConflicts(log, repo, baseRef)
+	if err != nil {
+		return err
+	}
+
+	if len(conflicts) > 0 {
+		reportUpdateBranchConflicts(e, baseRef, conflicts)
+		return nil
+	}
+
+	switch strategy {
+	case UPDATE_BRANCH_MERGE_STRATEGY:
+		signature := updateBranchSignature
+		signature.When = time.Now()
+
+		err = gh.MergeBranch(log, repo, baseRef, &signature)
+	case UPDATE_BRANCH_REBASE_STRATEGY:
+		err = gh.RebaseOnto(log, repo, baseRef, nil)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return gh.Push(log, repo, "origin", headRef, credentials.Token, strategy == UPDATE_BRANCH_REBASE_STRATEGY)
+}
+
+func reportUpdateBranchConflicts(e aladino.Env, baseRef string, conflicts []string) {
+	var message strings.Builder
+
+	message.WriteString(fmt.Sprintf("The branch could not be updated with %s because of conflicts in:", baseRef))
+	for _, file := range conflicts {
+		message.WriteString(fmt.Sprintf("\n- `%s`", file))
+	}
+
+	reportedMessages := e.GetBuiltInsReportedMessages()
+	reportedMessages[aladino.SEVERITY_ERROR] = append(reportedMessages[aladino.SEVERITY_ERROR], message.String())
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/reviewpad/reviewpad/v4/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var updateBranch = plugins_aladino.PluginBuiltIns().Actions["updateBranch"].Code
+
+func TestUpdateBranch_WhenMergingWithTheUpdateBranchAPI(t *testing.T) {
+	var gotOptions *github.PullRequestBranchUpdateOptions
+
+	mockedEnv := aladino.MockDefaultEnv(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.PutReposPullsUpdateBranchByOwnerByRepoByPullNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					rawBody, _ := io.ReadAll(r.Body)
+					utils.MustUnmarshal(rawBody, &gotOptions)
+
+					w.WriteHeader(http.StatusAccepted)
+					w.Write(mock.MustMarshal(&github.PullRequestBranchUpdateResponse{
+						Message: github.String("Updating pull request branch."),
+					}))
+				}),
+			),
+		},
+		nil,
+		aladino.MockBuiltIns(),
+		nil,
+	)
+
+	args := []lang.Value{lang.BuildStringValue("merge"), lang.BuildBoolValue(false)}
+	err := updateBranch(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, gotOptions, "The branch should be updated")
+	assert.Equal(t, aladino.GetDefaultPullRequestDetails().Head.Sha, gotOptions.GetExpectedHeadSHA())
+	assert.Empty(t, mockedEnv.GetBuiltInsReportedMessages())
+}
+
+func TestUpdateBranch_WhenArgumentsAreInvalid(t *testing.T) {
+	tests := map[string]struct {
+		strategy string
+		force    bool
+		wantErr  string
+	}{
+		"when strategy is invalid": {
+			strategy: "squash",
+			wantErr:  "updateBranch: invalid strategy squash, expected one of [merge rebase]",
+		},
+		"when rebasing without force": {
+			strategy: "rebase",
+			wantErr:  "updateBranch: the rebase strategy rewrites the branch, set force to true to allow the force push",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			updateBranchRequested := false
+
+			mockedEnv := aladino.MockDefaultEnv(
+				t,
+				[]mock.MockBackendOption{
+					mock.WithRequestMatchHandler(
+						mock.PutReposPullsUpdateBranchByOwnerByRepoByPullNumber,
+						http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+							updateBranchRequested = true
+						}),
+					),
+				},
+				nil,
+				aladino.MockBuiltIns(),
+				nil,
+			)
+
+			args := []lang.Value{lang.BuildStringValue(test.strategy), lang.BuildBoolValue(test.force)}
+			err := updateBranch(mockedEnv, args)
+
+			assert.EqualError(t, err, test.wantErr)
+			assert.False(t, updateBranchRequested, "The branch should not be updated")
+		})
+	}
+}
@@ -20,13 +20,19 @@ type PluginConfig struct {
 }
 
 func DefaultPluginConfig() (*PluginConfig, error) {
+	return NewPluginConfig("")
+}
+
+// NewPluginConfig creates the plugin config whose services load the pull requests and issues from the GitHub API at githubAPIURL.
+// When githubAPIURL is empty, they are loaded from github.com.
+func NewPluginConfig(githubAPIURL string) (*PluginConfig, error) {
 	connections := make([]*grpc.ClientConn, 0)
 	semanticClient, semanticConnection, err := services.NewSemanticService()
 	if err != nil {
 		return nil, err
 	}
 
-	robinClient, robinConnection, err := services.NewRobinService()
+	robinClient, robinConnection, err := services.NewRobinService(githubAPIURL)
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +42,13 @@ func DefaultPluginConfig() (*PluginConfig, error) {
 		services.ROBIN_SERVICE_KEY:    robinClient,
 	}
 
-	connections = append(connections, semanticConnection, robinConnection)
+	if semanticConnection != nil {
+		connections = append(connections, semanticConnection)
+	}
+
+	if robinConnection != nil {
+		connections = append(connections, robinConnection)
+	}
 
 	config := &PluginConfig{
 		Services:        services,
@@ -59,12 +71,16 @@ func PluginBuiltInsWithConfig(config *PluginConfig) *aladino.BuiltIns {
 	return &aladino.BuiltIns{
 		Functions: map[string]*aladino.BuiltInFunction{
 			// Pull Request
+			"addedFunctions":                functions.AddedFunctions(),
 			"approvalsCount":                functions.ApprovalsCount(),
 			"assignees":                     functions.Assignees(),
 			"author":                        functions.Author(),
 			"base":                          functions.Base(),
 			"changed":                       functions.Changed(),
+			"changedPublicAPI":              functions.ChangedPublicAPI(),
+			"changedSymbols":                functions.ChangedSymbols(),
 			"checkRunConclusion":            functions.CheckRunConclusion(),
+			"codeMatches":                   functions.CodeMatches(),
 			"commentCount":                  functions.CommentCount(),
 			"comments":                      functions.Comments(),
 			"commitCount":                   functions.CommitCount(),
@@ -72,6 +88,7 @@ func PluginBuiltInsWithConfig(config *PluginConfig) *aladino.BuiltIns {
 			"context":                       functions.Context(),
 			"createdAt":                     functions.CreatedAt(),
 			"description":                   functions.Description(),
+			"eventActor":                    functions.EventActor(),
 			"eventType":                     functions.EventType(),
 			"fileCount":                     functions.FileCount(),
 			"filesPath":                     functions.FilesPath(),
@@ -87,9 +104,11 @@ func PluginBuiltInsWithConfig(config *PluginConfig) *aladino.BuiltIns {
 			"hasLinearHistory":              functions.HasLinearHistory(),
 			"hasLinkedIssues":               functions.HasLinkedIssues(),
 			"hasRequiredApprovals":          functions.HasRequiredApprovals(),
+			"hasSignatureChanges":           functions.HasSignatureChanges(),
 			"hasUnaddressedThreads":         functions.HasUnaddressedThreads(),
 			"haveAllChecksRunCompleted":     functions.HaveAllChecksRunCompleted(),
 			"head":                          functions.Head(),
+			"inputs":                        functions.Inputs(),
 			"isBinary":                      functions.IsBinary(),
 			"isDraft":                       functions.IsDraft(),
 			"isLinkedToProject":             functions.IsLinkedToProject(),
@@ -99,6 +118,8 @@ func PluginBuiltInsWithConfig(config *PluginConfig) *aladino.BuiltIns {
 			"labels":                        functions.Labels(),
 			"lastEventAt":                   functions.LastEventAt(),
 			"milestone":                     functions.Milestone(),
+			"pullRequestTemplateCompliance": functions.PullRequestTemplateCompliance(),
+			"removedFunctions":              functions.RemovedFunctions(),
 			"requestedReviewers":            functions.RequestedReviewers(),
 			"reviewers":                     functions.Reviewers(),
 			"reviewerStatus":                functions.ReviewerStatus(),
@@ -106,7 +127,17 @@ func PluginBuiltInsWithConfig(config *PluginConfig) *aladino.BuiltIns {
 			"state":                         functions.State(),
 			"title":                         functions.Title(),
 			"toJSON":                        functions.ToJSON(),
+			"triggeringLabel":               functions.TriggeringLabel(),
+			"triggeringMilestone":           functions.TriggeringMilestone(),
 			"workflowStatus":                functions.WorkflowStatus(),
+			// Branch
+			"pushedBranch":  functions.PushedBranch(),
+			"pushedCommits": functions.PushedCommits(),
+			"pushedFiles":   functions.PushedFiles(),
+			"pusher":        functions.Pusher(),
+			// Discussion
+			"category":   functions.Category(),
+			"isAnswered": functions.IsAnswered(),
 			// Organization
 			"organization": functions.Organization(),
 			"team":         functions.Team(),
@@ -149,28 +180,43 @@ func PluginBuiltInsWithConfig(config *PluginConfig) *aladino.BuiltIns {
 			"assignRandomReviewer":      actions.AssignRandomReviewer(),
 			"assignReviewer":            actions.AssignReviewer(),
 			"assignTeamReviewer":        actions.AssignTeamReviewer(),
+			"checkPullRequestTemplate":  actions.CheckPullRequestTemplate(),
 			"close":                     actions.Close(),
 			"comment":                   actions.Comment(),
+			"commentDelete":             actions.CommentDelete(),
 			"commentOnce":               actions.CommentOnce(),
+			"commentUpsert":             actions.CommentUpsert(),
 			"commitLint":                actions.CommitLint(),
+			"createFollowUpIssue":       actions.CreateFollowUpIssue(),
+			"createIssue":               actions.CreateIssue(),
 			"deleteHeadBranch":          actions.DeleteHeadBranch(),
 			"disableActions":            actions.DisableActions(),
 			"error":                     actions.ErrorMsg(),
 			"fail":                      actions.Fail(),
 			"failCheckStatus":           actions.FailCheckStatus(),
+			"generateReleaseNotes":      actions.GenerateReleaseNotes(),
 			"info":                      actions.Info(),
+			"linkIssue":                 actions.LinkIssue(),
+			"lock":                      actions.Lock(),
+			"markAnswer":                actions.MarkAnswer(),
 			"merge":                     actions.Merge(),
+			"mergeQueue":                actions.MergeQueue(),
 			"rebase":                    actions.Rebase(),
 			"removeFromProject":         actions.RemoveFromProject(),
 			"removeLabel":               actions.RemoveLabel(),
 			"removeLabels":              actions.RemoveLabels(),
 			"review":                    actions.Review(),
+			"reviewComment":             actions.ReviewComment(),
 			"robinPrompt":               actions.RobinPrompt(),
 			"robinRawPrompt":            actions.RobinRawPrompt(),
+			"robinReview":               actions.RobinReview(),
 			"robinSummarize":            actions.RobinSummarize(),
+			"setCommitStatus":           actions.SetCommitStatus(),
 			"setProjectField":           actions.SetProjectField(),
+			"suggestChange":             actions.SuggestChange(),
 			"titleLint":                 actions.TitleLint(),
 			"triggerWorkflow":           actions.TriggerWorkflow(),
+			"updateBranch":              actions.UpdateBranch(),
 			"warn":                      actions.Warn(),
 		},
 		Services: config.Services,
@@ -0,0 +1,26 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	semantic "github.com/reviewpad/reviewpad/v4/plugins/aladino/semantic"
+)
+
+func AddedFunctions() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type:           lang.BuildFunctionType([]lang.Type{}, lang.BuildJSONType()),
+		Code:           addedFunctionsCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest},
+	}
+}
+
+func addedFunctionsCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
+	return filterSymbolChanges(e, func(change *semantic.SymbolChange) bool {
+		return change.Change == semantic.SYMBOL_ADDED && change.IsFunction()
+	})
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v4/lang"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var addedFunctions = plugins_aladino.PluginBuiltIns().Functions["addedFunctions"].Code
+
+func TestAddedFunctions(t *testing.T) {
+	mockedEnv := mockSemanticEnv(
+		t,
+		buildMockedSemanticSymbols(map[string]string{
+			"Crawl": "func Crawl(url string)",
+		}),
+		buildMockedSemanticSymbols(map[string]string{
+			"Crawl": "func Crawl(url string, depth int)",
+			"Parse": "func Parse(body string)",
+		}),
+	)
+
+	wantVal := lang.BuildJSONValue([]interface{}{
+		map[string]interface{}{
+			"name":              "Parse",
+			"type":              "function",
+			"path":              mockedSemanticFilePath,
+			"change":            "added",
+			"public":            true,
+			"signature":         "func Parse(body string)",
+			"previousSignature": "",
+		},
+	})
+
+	gotVal, err := addedFunctions(mockedEnv, []lang.Value{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
@@ -6,6 +6,7 @@ package plugins_aladino_functions
 
 import (
 	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -14,7 +15,7 @@ func AppendString() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
 		Type:           lang.BuildFunctionType([]lang.Type{lang.BuildArrayOfType(lang.BuildStringType()), lang.BuildArrayOfType(lang.BuildStringType())}, lang.BuildArrayOfType(lang.BuildStringType())),
 		Code:           appendStringCode,
-		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue},
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue, entities.Discussion, codehost.Branch, codehost.Release},
 	}
 }
 
@@ -9,7 +9,7 @@ import (
 	"strings"
 
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -23,7 +23,7 @@ func Changed() *aladino.BuiltInFunction {
 }
 
 func changedCode(e aladino.Env, args []lang.Value) (lang.Value, error) {
-	pullRequest := e.GetTarget().(*target.PullRequestTarget)
+	pullRequest := e.GetTarget().(codehost.PullRequest)
 
 	antecedentRegex := args[0].(*lang.StringValue).Val
 	consequentRegex := args[1].(*lang.StringValue).Val
@@ -56,13 +56,13 @@ func changedCode(e aladino.Env, args []lang.Value) (lang.Value, error) {
 	return retValue, nil
 }
 
-func getMatches(pullRequest *target.PullRequestTarget, pattern string) map[string][]string {
+func getMatches(pullRequest codehost.PullRequest, pattern string) map[string][]string {
 	resolvedPattern, vars := interpolateRegex(pattern)
 	re := regexp.MustCompile(resolvedPattern)
 
 	valsMatrix := make(map[string][]string, 0)
 
-	for fp := range pullRequest.Patch {
+	for fp := range pullRequest.GetPatch() {
 		for idx, ranges := range re.FindAllStringSubmatchIndex(fp, -1) {
 			lower := ranges[2]
 			upper := ranges[3]
@@ -0,0 +1,45 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v4/lang"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var changedPublicAPI = plugins_aladino.PluginBuiltIns().Functions["changedPublicAPI"].Code
+
+func TestChangedPublicAPI(t *testing.T) {
+	mockedEnv := mockSemanticEnv(
+		t,
+		buildMockedSemanticSymbols(map[string]string{
+			"Crawl": "func Crawl(url string)",
+			"fetch": "func fetch(url string)",
+		}),
+		buildMockedSemanticSymbols(map[string]string{
+			"Crawl": "func Crawl(url string, depth int)",
+		}),
+	)
+
+	wantVal := lang.BuildJSONValue([]interface{}{
+		map[string]interface{}{
+			"name":              "Crawl",
+			"type":              "function",
+			"path":              mockedSemanticFilePath,
+			"change":            "modified",
+			"public":            true,
+			"signature":         "func Crawl(url string, depth int)",
+			"previousSignature": "func Crawl(url string)",
+		},
+	})
+
+	gotVal, err := changedPublicAPI(mockedEnv, []lang.Value{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
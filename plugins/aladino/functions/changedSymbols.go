@@ -0,0 +1,44 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	semantic "github.com/reviewpad/reviewpad/v4/plugins/aladino/semantic"
+)
+
+func ChangedSymbols() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type:           lang.BuildFunctionType([]lang.Type{}, lang.BuildJSONType()),
+		Code:           changedSymbolsCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest},
+	}
+}
+
+func changedSymbolsCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
+	return filterSymbolChanges(e, func(*semantic.SymbolChange) bool {
+		return true
+	})
+}
+
+// filterSymbolChanges returns the symbol changes of the pull request that satisfy the predicate
+// as a JSON array of objects.
+func filterSymbolChanges(e aladino.Env, predicate func(*semantic.SymbolChange) bool) (lang.Value, error) {
+	changes, err := semantic.GetSymbolChanges(e)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, 0)
+	for _, change := range changes {
+		if predicate(change) {
+			res = append(res, change.ToMap())
+		}
+	}
+
+	return lang.BuildJSONValue(res), nil
+}
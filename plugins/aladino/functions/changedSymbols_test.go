@@ -0,0 +1,205 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	gocontext "context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-github/v52/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	pbc "github.com/reviewpad/api/go/codehost"
+	"github.com/reviewpad/api/go/entities"
+	"github.com/reviewpad/api/go/services"
+	"github.com/reviewpad/api/go/services_mocks"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	plugins_aladino_services "github.com/reviewpad/reviewpad/v4/plugins/aladino/services"
+	"github.com/reviewpad/reviewpad/v4/utils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+var changedSymbols = plugins_aladino.PluginBuiltIns().Functions["changedSymbols"].Code
+
+const (
+	mockedSemanticBaseSHA  = "base123"
+	mockedSemanticHeadSHA  = "head123"
+	mockedSemanticFilePath = "crawler/crawler.go"
+)
+
+func buildMockedSemanticSymbols(symbols map[string]string) *entities.Symbols {
+	res := &entities.Symbols{
+		Files:   map[string]*entities.File{},
+		Symbols: map[string]*entities.Symbol{},
+	}
+
+	for name, signature := range symbols {
+		res.Symbols[name] = &entities.Symbol{
+			Id:           fmt.Sprintf("%s:%s", name, signature),
+			Name:         name,
+			LocalId:      name,
+			AgnosticType: entities.AgnosticType_FUNCTION,
+			Definition: &entities.Definition{
+				FilePath: mockedSemanticFilePath,
+			},
+			Signature: &entities.SymbolDocumentation{
+				Code: signature,
+			},
+		}
+	}
+
+	return res
+}
+
+func mockSemanticEnv(t *testing.T, baseSymbols *entities.Symbols, headSymbols *entities.Symbols) aladino.Env {
+	controller := gomock.NewController(t)
+	t.Cleanup(controller.Finish)
+
+	mockedSemanticClient := services_mocks.NewMockSemanticClient(controller)
+	mockedSemanticClient.EXPECT().GetSymbols(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ gocontext.Context, req *services.GetSymbolsRequest, _ ...grpc.CallOption) (*services.GetSymbolsReply, error) {
+			if req.CommitId == mockedSemanticBaseSHA {
+				return &services.GetSymbolsReply{Symbols: baseSymbols}, nil
+			}
+			return &services.GetSymbolsReply{Symbols: headSymbols}, nil
+		},
+	).AnyTimes()
+
+	mockedBuiltIns := &aladino.BuiltIns{
+		Services: map[string]interface{}{
+			plugins_aladino_services.SEMANTIC_SERVICE_KEY: mockedSemanticClient,
+		},
+	}
+
+	mockedRepo := &pbc.Repository{
+		Owner: aladino.DefaultMockPrOwner,
+		Name:  aladino.DefaultMockPrRepoName,
+		Uri:   fmt.Sprintf("https://github.com/%v/%v", aladino.DefaultMockPrOwner, aladino.DefaultMockPrRepoName),
+	}
+	mockedPullRequest := aladino.GetDefaultMockPullRequestDetailsWith(&pbc.PullRequest{
+		Head: &pbc.Branch{
+			Repo: mockedRepo,
+			Name: "new-topic",
+			Sha:  mockedSemanticHeadSHA,
+		},
+		Base: &pbc.Branch{
+			Repo: mockedRepo,
+			Name: "main",
+			Sha:  mockedSemanticBaseSHA,
+		},
+	})
+	mockedFiles := []*pbc.File{
+		{
+			Sha:      "1234",
+			Filename: mockedSemanticFilePath,
+			Patch:    "",
+			Status:   pbc.File_MODIFIED,
+		},
+	}
+	mockedFileLocation := fmt.Sprintf("/%v/%v/%v", aladino.DefaultMockPrOwner, aladino.DefaultMockPrRepoName, mockedSemanticFilePath)
+
+	return aladino.MockDefaultEnvWithPullRequestAndFiles(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.GetReposContentsByOwnerByRepoByPath,
+				http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					utils.MustWriteBytes(w, mock.MustMarshal([]github.RepositoryContent{
+						{
+							Name:        github.String("crawler.go"),
+							Path:        github.String(mockedSemanticFilePath),
+							DownloadURL: github.String(fmt.Sprintf("https://raw.githubusercontent.com%v", mockedFileLocation)),
+						},
+					}))
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.EndpointPattern{
+					Pattern: mockedFileLocation,
+					Method:  "GET",
+				},
+				http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					utils.MustWrite(w, "package main")
+				}),
+			),
+		},
+		nil,
+		mockedPullRequest,
+		mockedFiles,
+		mockedBuiltIns,
+		nil,
+	)
+}
+
+func TestChangedSymbols_WhenPatchIsEmpty(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnvWithPullRequestAndFiles(
+		t,
+		nil,
+		nil,
+		aladino.GetDefaultPullRequestDetails(),
+		[]*pbc.File{},
+		aladino.MockBuiltIns(),
+		nil,
+	)
+
+	gotVal, err := changedSymbols(mockedEnv, []lang.Value{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, lang.BuildJSONValue([]interface{}{}), gotVal)
+}
+
+func TestChangedSymbols(t *testing.T) {
+	mockedEnv := mockSemanticEnv(
+		t,
+		buildMockedSemanticSymbols(map[string]string{
+			"Crawl": "func Crawl(url string)",
+			"fetch": "func fetch(url string)",
+		}),
+		buildMockedSemanticSymbols(map[string]string{
+			"Crawl": "func Crawl(url string, depth int)",
+			"parse": "func parse(body string)",
+		}),
+	)
+
+	wantVal := lang.BuildJSONValue([]interface{}{
+		map[string]interface{}{
+			"name":              "Crawl",
+			"type":              "function",
+			"path":              mockedSemanticFilePath,
+			"change":            "modified",
+			"public":            true,
+			"signature":         "func Crawl(url string, depth int)",
+			"previousSignature": "func Crawl(url string)",
+		},
+		map[string]interface{}{
+			"name":              "fetch",
+			"type":              "function",
+			"path":              mockedSemanticFilePath,
+			"change":            "removed",
+			"public":            false,
+			"signature":         "",
+			"previousSignature": "func fetch(url string)",
+		},
+		map[string]interface{}{
+			"name":              "parse",
+			"type":              "function",
+			"path":              mockedSemanticFilePath,
+			"change":            "added",
+			"public":            false,
+			"signature":         "func parse(body string)",
+			"previousSignature": "",
+		},
+	})
+
+	gotVal, err := changedSymbols(mockedEnv, []lang.Value{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
@@ -5,9 +5,10 @@
 package plugins_aladino_functions
 
 import (
-	"github.com/google/go-github/v52/github"
+	"time"
+
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -22,33 +23,30 @@ func CheckRunConclusion() *aladino.BuiltInFunction {
 
 func checkRunConclusionCode(e aladino.Env, args []lang.Value) (lang.Value, error) {
 	checkRunName := args[0].(*lang.StringValue).Val
-	pullRequest := e.GetTarget().(*target.PullRequestTarget)
-	owner := pullRequest.GetTargetEntity().Owner
-	repo := pullRequest.GetTargetEntity().Repo
-	number := pullRequest.GetTargetEntity().Number
+	pullRequest := e.GetTarget().(codehost.PullRequest)
 
-	ghCommits, err := e.GetGithubClient().GetPullRequestCommits(e.GetCtx(), owner, repo, number)
+	commits, err := pullRequest.GetCommits()
 	if err != nil {
 		return nil, err
 	}
 
-	if len(ghCommits) == 0 {
+	if len(commits) == 0 {
 		return lang.BuildStringValue(""), nil
 	}
 
-	lastCommitSha := ghCommits[len(ghCommits)-1].GetSHA()
+	lastCommitSha := commits[len(commits)-1].SHA
 
-	checkRuns, err := e.GetGithubClient().GetCheckRunsForRef(e.GetCtx(), owner, repo, number, lastCommitSha, &github.ListCheckRunsOptions{})
+	checkRuns, err := pullRequest.GetCheckRuns(lastCommitSha)
 	if err != nil {
 		return nil, err
 	}
 
 	var checkRunConclusion string
-	var checkRunCompletedAt *github.Timestamp
+	var checkRunCompletedAt *time.Time
 
 	for _, checkRun := range checkRuns {
 		if isCheckEligible(checkRun, checkRunName, checkRunCompletedAt) {
-			checkRunConclusion = *checkRun.Conclusion
+			checkRunConclusion = checkRun.Conclusion
 			checkRunCompletedAt = checkRun.CompletedAt
 		}
 	}
@@ -56,20 +54,20 @@ func checkRunConclusionCode(e aladino.Env, args []lang.Value) (lang.Value, error
 	return lang.BuildStringValue(checkRunConclusion), nil
 }
 
-func isCheckEligible(checkRun *github.CheckRun, requiredName string, requiredMinCompletedAt *github.Timestamp) bool {
-	if *checkRun.Name != requiredName {
+func isCheckEligible(checkRun *codehost.CheckRun, requiredName string, requiredMinCompletedAt *time.Time) bool {
+	if checkRun.Name != requiredName {
 		return false
 	}
 
-	if *checkRun.Status != "completed" {
+	if checkRun.Status != "completed" {
 		return false
 	}
 
-	if requiredMinCompletedAt != nil && checkRun.CompletedAt.Before(requiredMinCompletedAt.Time) {
+	if requiredMinCompletedAt != nil && checkRun.CompletedAt != nil && checkRun.CompletedAt.Before(*requiredMinCompletedAt) {
 		return false
 	}
 
-	if checkRun.Conclusion == nil {
+	if checkRun.Conclusion == "" {
 		return false
 	}
 
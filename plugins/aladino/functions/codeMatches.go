@@ -0,0 +1,137 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	doublestar "github.com/bmatcuk/doublestar/v4"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+)
+
+// CODE_MATCHES_ANY_SIDE matches the pattern in the added, removed and context lines.
+const CODE_MATCHES_ANY_SIDE = "any"
+
+func CodeMatches() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type: lang.BuildFunctionType(
+			[]lang.Type{
+				lang.BuildStringType(),
+				lang.BuildArrayOfType(lang.BuildStringType()),
+				lang.BuildStringType(),
+			},
+			lang.BuildJSONType(),
+		),
+		Code:           codeMatchesCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest},
+	}
+}
+
+// codeMatchesCode returns the matches of the pattern in the diff of the files that match the globs
+// as a JSON array of objects with the path, line, text and side of each match.
+// The globs prefixed with ! exclude the files they match. Without other globs, all the remaining files are included.
+func codeMatchesCode(e aladino.Env, args []lang.Value) (lang.Value, error) {
+	pattern := args[0].(*lang.StringValue).Val
+	globs := args[1].(*lang.ArrayValue).Vals
+	side := args[2].(*lang.StringValue).Val
+
+	r, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("codeMatches: compile error %v", err)
+	}
+
+	sides, err := parseCodeMatchesSide(side)
+	if err != nil {
+		return nil, err
+	}
+
+	includeGlobs := make([]string, 0)
+	excludeGlobs := make([]string, 0)
+	for _, globValue := range globs {
+		glob := globValue.(*lang.StringValue).Val
+		if strings.HasPrefix(glob, "!") {
+			excludeGlobs = append(excludeGlobs, strings.TrimPrefix(glob, "!"))
+		} else {
+			includeGlobs = append(includeGlobs, glob)
+		}
+	}
+
+	patch := e.GetTarget().(codehost.PullRequest).GetPatch()
+
+	paths := make([]string, 0, len(patch))
+	for path := range patch {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	res := make([]interface{}, 0)
+	for _, path := range paths {
+		file := patch[path]
+		if file == nil {
+			continue
+		}
+
+		isIncluded, err := matchesAnyGlob(includeGlobs, path)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(includeGlobs) > 0 && !isIncluded {
+			continue
+		}
+
+		isExcluded, err := matchesAnyGlob(excludeGlobs, path)
+		if err != nil {
+			return nil, err
+		}
+
+		if isExcluded {
+			continue
+		}
+
+		for _, match := range file.Matches(r, sides) {
+			res = append(res, map[string]interface{}{
+				"path": match.Path,
+				"line": match.Line,
+				"text": match.Text,
+				"side": match.Side,
+			})
+		}
+	}
+
+	return lang.BuildJSONValue(res), nil
+}
+
+func parseCodeMatchesSide(side string) ([]string, error) {
+	switch side {
+	case codehost.DIFF_SIDE_ADDED, codehost.DIFF_SIDE_REMOVED, codehost.DIFF_SIDE_CONTEXT:
+		return []string{side}, nil
+	case CODE_MATCHES_ANY_SIDE:
+		return []string{codehost.DIFF_SIDE_ADDED, codehost.DIFF_SIDE_REMOVED, codehost.DIFF_SIDE_CONTEXT}, nil
+	default:
+		return nil, fmt.Errorf("codeMatches: unsupported side %s", side)
+	}
+}
+
+func matchesAnyGlob(globs []string, path string) (bool, error) {
+	for _, glob := range globs {
+		match, err := doublestar.Match(glob, path)
+		if err != nil {
+			return false, fmt.Errorf("codeMatches: invalid glob %s: %v", glob, err)
+		}
+
+		if match {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
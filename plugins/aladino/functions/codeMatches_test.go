@@ -0,0 +1,142 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"errors"
+	"testing"
+
+	pbc "github.com/reviewpad/api/go/codehost"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var codeMatches = plugins_aladino.PluginBuiltIns().Functions["codeMatches"].Code
+
+func TestCodeMatches(t *testing.T) {
+	mockedFiles := []*pbc.File{
+		{
+			Filename: "crawler/crawler_test.go",
+			Patch:    "@@ -10,4 +10,4 @@ func TestCrawl(t *testing.T) {\n-\tt.Skip(\"flaky\")\n+\tcrawl(t) //nolint\n \tassert(t)\n }",
+		},
+		{
+			Filename: "crawler/crawler.go",
+			Patch:    "@@ -1,2 +1,3 @@\n package crawler\n+\n+var client = newClient() //nolint",
+		},
+		{
+			Filename: "vendor/lib/lib.go",
+			Patch:    "@@ -1,1 +1,2 @@\n package lib\n+var debug = true //nolint",
+		},
+	}
+
+	tests := map[string]struct {
+		pattern string
+		globs   []string
+		side    string
+		wantVal lang.Value
+		wantErr error
+	}{
+		"removed lines": {
+			pattern: `t\.Skip`,
+			globs:   []string{"**/*_test.go"},
+			side:    "removed",
+			wantVal: lang.BuildJSONValue([]interface{}{
+				map[string]interface{}{
+					"path": "crawler/crawler_test.go",
+					"line": 10,
+					"text": "\tt.Skip(\"flaky\")",
+					"side": "removed",
+				},
+			}),
+		},
+		"added lines with exclusion glob": {
+			pattern: `//nolint`,
+			globs:   []string{"!vendor/**"},
+			side:    "added",
+			wantVal: lang.BuildJSONValue([]interface{}{
+				map[string]interface{}{
+					"path": "crawler/crawler.go",
+					"line": 3,
+					"text": "var client = newClient() //nolint",
+					"side": "added",
+				},
+				map[string]interface{}{
+					"path": "crawler/crawler_test.go",
+					"line": 10,
+					"text": "\tcrawl(t) //nolint",
+					"side": "added",
+				},
+			}),
+		},
+		"any side with multi-line pattern": {
+			pattern: `crawl\(t\)|assert\(t\)\n}`,
+			globs:   []string{},
+			side:    "any",
+			wantVal: lang.BuildJSONValue([]interface{}{
+				map[string]interface{}{
+					"path": "crawler/crawler_test.go",
+					"line": 10,
+					"text": "\tcrawl(t) //nolint",
+					"side": "added",
+				},
+				map[string]interface{}{
+					"path": "crawler/crawler_test.go",
+					"line": 11,
+					"text": "\tassert(t)\n}",
+					"side": "context",
+				},
+			}),
+		},
+		"no matches": {
+			pattern: `t\.Skip`,
+			globs:   []string{"**/*.go"},
+			side:    "added",
+			wantVal: lang.BuildJSONValue([]interface{}{}),
+		},
+		"invalid pattern": {
+			pattern: `a(`,
+			side:    "added",
+			wantErr: errors.New("codeMatches: compile error error parsing regexp: missing closing ): `a(`"),
+		},
+		"unsupported side": {
+			pattern: `t\.Skip`,
+			side:    "left",
+			wantErr: errors.New("codeMatches: unsupported side left"),
+		},
+		"invalid glob": {
+			pattern: `t\.Skip`,
+			globs:   []string{"[a-"},
+			side:    "added",
+			wantErr: errors.New("codeMatches: invalid glob [a-: syntax error in pattern"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockedEnv := aladino.MockDefaultEnvWithPullRequestAndFiles(
+				t,
+				nil,
+				nil,
+				aladino.GetDefaultPullRequestDetails(),
+				mockedFiles,
+				aladino.MockBuiltIns(),
+				nil,
+			)
+
+			globs := make([]lang.Value, len(test.globs))
+			for i, glob := range test.globs {
+				globs[i] = lang.BuildStringValue(glob)
+			}
+
+			args := []lang.Value{lang.BuildStringValue(test.pattern), lang.BuildArrayValue(globs), lang.BuildStringValue(test.side)}
+			gotVal, err := codeMatches(mockedEnv, args)
+
+			assert.Equal(t, test.wantErr, err)
+			assert.Equal(t, test.wantVal, gotVal)
+		})
+	}
+}
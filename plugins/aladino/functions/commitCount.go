@@ -6,7 +6,7 @@ package plugins_aladino_functions
 
 import (
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -20,7 +20,7 @@ func CommitCount() *aladino.BuiltInFunction {
 }
 
 func commitCountCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
-	t := e.GetTarget().(*target.PullRequestTarget)
+	t := e.GetTarget().(codehost.PullRequest)
 
 	return lang.BuildIntValue(int(t.GetCommitCount())), nil
 }
@@ -0,0 +1,32 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+)
+
+func EventActor() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type:           lang.BuildFunctionType([]lang.Type{}, lang.BuildStringType()),
+		Code:           eventActorCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue, entities.Discussion, codehost.Branch, codehost.Release},
+	}
+}
+
+// eventActorCode returns the login of the user that triggered the event.
+// Every webhook event payload has the user that triggered it as the sender.
+func eventActorCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
+	eventPayload, ok := e.GetEventPayload().(interface{ GetSender() *github.User })
+	if !ok {
+		return lang.BuildStringValue(""), nil
+	}
+
+	return lang.BuildStringValue(eventPayload.GetSender().GetLogin()), nil
+}
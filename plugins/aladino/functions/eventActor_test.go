@@ -0,0 +1,68 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var eventActor = plugins_aladino.PluginBuiltIns().Functions["eventActor"].Code
+
+func TestEventActor(t *testing.T) {
+	tests := map[string]struct {
+		eventPayload interface{}
+		wantValue    lang.Value
+	}{
+		"when event payload is nil": {
+			eventPayload: nil,
+			wantValue:    lang.BuildStringValue(""),
+		},
+		"when event has no sender": {
+			eventPayload: &github.PullRequestEvent{},
+			wantValue:    lang.BuildStringValue(""),
+		},
+		"when pull request event": {
+			eventPayload: &github.PullRequestEvent{
+				Action: github.String("labeled"),
+				Sender: &github.User{
+					Login: github.String("john"),
+				},
+			},
+			wantValue: lang.BuildStringValue("john"),
+		},
+		"when label event": {
+			eventPayload: &github.LabelEvent{
+				Action: github.String("edited"),
+				Sender: &github.User{
+					Login: github.String("jane"),
+				},
+			},
+			wantValue: lang.BuildStringValue("jane"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockedEnv := aladino.MockDefaultEnv(
+				t,
+				nil,
+				nil,
+				aladino.MockBuiltIns(),
+				test.eventPayload,
+			)
+
+			gotValue, err := eventActor(mockedEnv, []lang.Value{})
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantValue, gotValue)
+		})
+	}
+}
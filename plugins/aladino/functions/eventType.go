@@ -45,6 +45,18 @@ func eventTypeIssue(issuePayload *github.IssuesEvent) (lang.Value, error) {
 	return lang.BuildStringValue(*issuePayload.Action), nil
 }
 
+func eventTypePullRequestReviewThread(reviewThreadPayload *github.PullRequestReviewThreadEvent) (lang.Value, error) {
+	if reviewThreadPayload == nil {
+		return lang.BuildStringValue(""), nil
+	}
+
+	if reviewThreadPayload.Action == nil {
+		return lang.BuildStringValue(""), nil
+	}
+
+	return lang.BuildStringValue(*reviewThreadPayload.Action), nil
+}
+
 func eventTypeCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
 	pullRequestEvent := e.GetEventPayload()
 	if pullRequestEvent == nil {
@@ -56,6 +68,8 @@ func eventTypeCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
 		return eventTypePullRequest(pullRequestEvent.(*github.PullRequestEvent))
 	case "*github.IssuesEvent":
 		return eventTypeIssue(pullRequestEvent.(*github.IssuesEvent))
+	case "*github.PullRequestReviewThreadEvent":
+		return eventTypePullRequestReviewThread(pullRequestEvent.(*github.PullRequestReviewThreadEvent))
 	default:
 		return lang.BuildStringValue(""), nil
 	}
@@ -96,3 +96,44 @@ func TestEventType(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, wantValue, gotValue)
 }
+
+func TestEventType_WhenPullRequestReviewThreadEvent(t *testing.T) {
+	tests := map[string]struct {
+		eventPayload *github.PullRequestReviewThreadEvent
+		wantValue    lang.Value
+	}{
+		"when thread is resolved": {
+			eventPayload: &github.PullRequestReviewThreadEvent{
+				Action: github.String("resolved"),
+			},
+			wantValue: lang.BuildStringValue("resolved"),
+		},
+		"when thread is unresolved": {
+			eventPayload: &github.PullRequestReviewThreadEvent{
+				Action: github.String("unresolved"),
+			},
+			wantValue: lang.BuildStringValue("unresolved"),
+		},
+		"when action is nil": {
+			eventPayload: &github.PullRequestReviewThreadEvent{},
+			wantValue:    lang.BuildStringValue(""),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockedEnv := aladino.MockDefaultEnv(
+				t,
+				nil,
+				nil,
+				aladino.MockBuiltIns(),
+				test.eventPayload,
+			)
+
+			gotValue, err := eventType(mockedEnv, []lang.Value{})
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantValue, gotValue)
+		})
+	}
+}
@@ -6,7 +6,7 @@ package plugins_aladino_functions
 
 import (
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -20,6 +20,6 @@ func FileCount() *aladino.BuiltInFunction {
 }
 
 func fileCountCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
-	patch := e.GetTarget().(*target.PullRequestTarget).Patch
+	patch := e.GetTarget().(codehost.PullRequest).GetPatch()
 	return lang.BuildIntValue(len(patch)), nil
 }
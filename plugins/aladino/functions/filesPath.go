@@ -6,7 +6,7 @@ package plugins_aladino_functions
 
 import (
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -20,10 +20,10 @@ func FilesPath() *aladino.BuiltInFunction {
 }
 
 func filesPathCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
-	t := e.GetTarget().(*target.PullRequestTarget)
+	t := e.GetTarget().(codehost.PullRequest)
 	filesPath := make([]lang.Value, 0)
 
-	for _, patchFile := range t.Patch {
+	for _, patchFile := range t.GetPatch() {
 		if patchFile.Repr == nil {
 			continue
 		}
@@ -7,9 +7,8 @@ package plugins_aladino_functions
 import (
 	"fmt"
 
-	"github.com/google/go-github/v52/github"
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -29,10 +28,7 @@ func hasAnyCheckRunCompleted(e aladino.Env, args []lang.Value) (lang.Value, erro
 	checkRunsToIgnore := args[0].(*lang.ArrayValue)
 	checkConclusions := args[1].(*lang.ArrayValue)
 
-	pullRequest := e.GetTarget().(*target.PullRequestTarget)
-	owner := pullRequest.GetTargetEntity().Owner
-	repo := pullRequest.GetTargetEntity().Repo
-	number := pullRequest.GetTargetEntity().Number
+	pullRequest := e.GetTarget().(codehost.PullRequest)
 
 	lastCommitSHA, err := pullRequest.GetLastCommit()
 	if err != nil {
@@ -43,7 +39,7 @@ func hasAnyCheckRunCompleted(e aladino.Env, args []lang.Value) (lang.Value, erro
 		return lang.BuildBoolValue(false), nil
 	}
 
-	checkRuns, err := e.GetGithubClient().GetCheckRunsForRef(e.GetCtx(), owner, repo, number, lastCommitSHA, &github.ListCheckRunsOptions{})
+	checkRuns, err := pullRequest.GetCheckRuns(lastCommitSHA)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get check runs: %s", err.Error())
 	}
@@ -62,11 +58,11 @@ func hasAnyCheckRunCompleted(e aladino.Env, args []lang.Value) (lang.Value, erro
 	}
 
 	for _, checkRun := range checkRuns {
-		if checkRunIgnored[checkRun.GetName()] {
+		if checkRunIgnored[checkRun.Name] {
 			continue
 		}
 
-		if checkRun.GetStatus() != "completed" {
+		if checkRun.Status != "completed" {
 			continue
 		}
 
@@ -75,7 +71,7 @@ func hasAnyCheckRunCompleted(e aladino.Env, args []lang.Value) (lang.Value, erro
 			return lang.BuildBoolValue(true), nil
 		}
 
-		if checkConclusionsConsidered[checkRun.GetConclusion()] {
+		if checkConclusionsConsidered[checkRun.Conclusion] {
 			return lang.BuildBoolValue(true), nil
 		}
 	}
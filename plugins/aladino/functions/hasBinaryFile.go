@@ -6,7 +6,7 @@ package plugins_aladino_functions
 
 import (
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -20,10 +20,10 @@ func HasBinaryFile() *aladino.BuiltInFunction {
 }
 
 func hasBinaryFile(e aladino.Env, _ []lang.Value) (lang.Value, error) {
-	target := e.GetTarget().(*target.PullRequestTarget)
-	headBranch := target.PullRequest.Head.Name
+	target := e.GetTarget().(codehost.PullRequest)
+	headBranch := target.GetPullRequest().Head.Name
 
-	for _, patchFile := range target.Patch {
+	for _, patchFile := range target.GetPatch() {
 		isBinary, err := target.IsFileBinary(headBranch, patchFile.Repr.GetFilename())
 		if err != nil {
 			return nil, err
@@ -6,7 +6,7 @@ package plugins_aladino_functions
 
 import (
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -21,7 +21,7 @@ func HasCodePattern() *aladino.BuiltInFunction {
 
 func hasCodePatternCode(e aladino.Env, args []lang.Value) (lang.Value, error) {
 	arg := args[0].(*lang.StringValue)
-	patch := e.GetTarget().(*target.PullRequestTarget).Patch
+	patch := e.GetTarget().(codehost.PullRequest).GetPatch()
 
 	for _, file := range patch {
 		if file == nil {
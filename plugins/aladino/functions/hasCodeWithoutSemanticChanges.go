@@ -8,7 +8,6 @@ import (
 	doublestar "github.com/bmatcuk/doublestar/v4"
 	"github.com/reviewpad/go-lib/entities"
 	"github.com/reviewpad/reviewpad/v4/codehost"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 	semantic "github.com/reviewpad/reviewpad/v4/plugins/aladino/semantic"
@@ -26,11 +25,11 @@ func HasCodeWithoutSemanticChanges() *aladino.BuiltInFunction {
 }
 
 func hasCodeWithoutSemanticChanges(e aladino.Env, args []lang.Value) (lang.Value, error) {
-	pullRequest := e.GetTarget().(*target.PullRequestTarget)
+	pullRequest := e.GetTarget().(codehost.PullRequest)
 	log := e.GetLogger()
 
 	// filter patch by file pattern
-	patch := pullRequest.Patch
+	patch := pullRequest.GetPatch()
 	newPatch := make(map[string]*codehost.File)
 
 	filePatterns := args[0].(*lang.ArrayValue)
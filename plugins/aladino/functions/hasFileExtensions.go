@@ -8,7 +8,7 @@ import (
 	"strings"
 
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 	"github.com/reviewpad/reviewpad/v4/utils"
@@ -33,7 +33,7 @@ func hasFileExtensionsCode(e aladino.Env, args []lang.Value) (lang.Value, error)
 		extensionSet[normalizedStr] = true
 	}
 
-	patch := e.GetTarget().(*target.PullRequestTarget).Patch
+	patch := e.GetTarget().(codehost.PullRequest).GetPatch()
 	for fp := range patch {
 		fpExt := utils.FileExt(fp)
 		normalizedExt := strings.ToLower(fpExt)
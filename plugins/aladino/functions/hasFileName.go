@@ -6,7 +6,7 @@ package plugins_aladino_functions
 
 import (
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -22,7 +22,7 @@ func HasFileName() *aladino.BuiltInFunction {
 func hasFileNameCode(e aladino.Env, args []lang.Value) (lang.Value, error) {
 	fileNameStr := args[0].(*lang.StringValue)
 
-	patch := e.GetTarget().(*target.PullRequestTarget).Patch
+	patch := e.GetTarget().(codehost.PullRequest).GetPatch()
 	for fp := range patch {
 		if fp == fileNameStr.Val {
 			return lang.BuildTrueValue(), nil
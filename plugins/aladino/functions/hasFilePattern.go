@@ -7,7 +7,7 @@ package plugins_aladino_functions
 import (
 	doublestar "github.com/bmatcuk/doublestar/v4"
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -23,7 +23,7 @@ func HasFilePattern() *aladino.BuiltInFunction {
 func hasFilePatternCode(e aladino.Env, args []lang.Value) (lang.Value, error) {
 	filePatternRegex := args[0].(*lang.StringValue)
 
-	patch := e.GetTarget().(*target.PullRequestTarget).Patch
+	patch := e.GetTarget().(codehost.PullRequest).GetPatch()
 	for fp := range patch {
 		re, err := doublestar.Match(filePatternRegex.Val, fp)
 		if err != nil {
@@ -6,11 +6,9 @@ package plugins_aladino_functions
 
 import (
 	"github.com/reviewpad/go-lib/entities"
-	host "github.com/reviewpad/reviewpad/v4/codehost/github"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
-	"github.com/shurcooL/githubv4"
 )
 
 func HasGitConflicts() *aladino.BuiltInFunction {
@@ -22,33 +20,10 @@ func HasGitConflicts() *aladino.BuiltInFunction {
 }
 
 func hasGitConflictsCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
-	pullRequest := e.GetTarget().(*target.PullRequestTarget).PullRequest
-
-	prNum := host.GetPullRequestNumber(pullRequest)
-	repoOwner := host.GetPullRequestBaseOwnerName(pullRequest)
-	repoName := host.GetPullRequestBaseRepoName(pullRequest)
-	var pullRequestQuery struct {
-		Repository struct {
-			PullRequest struct {
-				Mergeable githubv4.String
-			} `graphql:"pullRequest(number: $pullRequestNumber)"`
-		} `graphql:"repository(owner: $repositoryOwner, name: $repositoryName)"`
-	}
-
-	varGQLPullRequestQuery := map[string]interface{}{
-		"pullRequestNumber": githubv4.Int(prNum),
-		"repositoryOwner":   githubv4.String(repoOwner),
-		"repositoryName":    githubv4.String(repoName),
-	}
-
-	err := e.GetGithubClient().GetClientGraphQL().Query(e.GetCtx(), &pullRequestQuery, varGQLPullRequestQuery)
+	hasGitConflicts, err := e.GetTarget().(codehost.PullRequest).HasGitConflicts()
 	if err != nil {
 		return nil, err
 	}
 
-	if string(pullRequestQuery.Repository.PullRequest.Mergeable) == "CONFLICTING" {
-		return lang.BuildBoolValue(true), nil
-	}
-
-	return lang.BuildBoolValue(false), nil
+	return lang.BuildBoolValue(hasGitConflicts), nil
 }
@@ -6,7 +6,7 @@ package plugins_aladino_functions
 
 import (
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -20,7 +20,7 @@ func HasLinkedIssues() *aladino.BuiltInFunction {
 }
 
 func hasLinkedIssuesCode(e aladino.Env, args []lang.Value) (lang.Value, error) {
-	pullRequest := e.GetTarget().(*target.PullRequestTarget)
+	pullRequest := e.GetTarget().(codehost.PullRequest)
 	closingIssuesCount, err := pullRequest.GetLinkedIssuesCount()
 	if err != nil {
 		return nil, err
@@ -8,7 +8,7 @@ import (
 	"fmt"
 
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 	"github.com/reviewpad/reviewpad/v4/utils"
@@ -29,7 +29,7 @@ func HasRequiredApprovals() *aladino.BuiltInFunction {
 }
 
 func hasRequiredApprovalsCode(e aladino.Env, args []lang.Value) (lang.Value, error) {
-	pullRequest := e.GetTarget().(*target.PullRequestTarget)
+	pullRequest := e.GetTarget().(codehost.PullRequest)
 	totalRequiredApprovals := args[0].(*lang.IntValue).Val
 	requiredApprovalsFrom := args[1].(*lang.ArrayValue).Vals
 
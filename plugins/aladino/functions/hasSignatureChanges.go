@@ -0,0 +1,49 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	doublestar "github.com/bmatcuk/doublestar/v4"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	semantic "github.com/reviewpad/reviewpad/v4/plugins/aladino/semantic"
+)
+
+func HasSignatureChanges() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type:           lang.BuildFunctionType([]lang.Type{lang.BuildStringType()}, lang.BuildBoolType()),
+		Code:           hasSignatureChangesCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest},
+	}
+}
+
+func hasSignatureChangesCode(e aladino.Env, args []lang.Value) (lang.Value, error) {
+	pattern := args[0].(*lang.StringValue).Val
+	log := e.GetLogger().WithField("builtin", "hasSignatureChanges")
+
+	changes, err := semantic.GetSymbolChanges(e)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, change := range changes {
+		if !change.HasSignatureChange() {
+			continue
+		}
+
+		match, err := doublestar.Match(pattern, change.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		if match {
+			log.Infof("signature of %v in %v changed", change.Name, change.Path)
+			return lang.BuildTrueValue(), nil
+		}
+	}
+
+	return lang.BuildFalseValue(), nil
+}
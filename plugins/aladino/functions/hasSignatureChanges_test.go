@@ -0,0 +1,62 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v4/lang"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var hasSignatureChanges = plugins_aladino.PluginBuiltIns().Functions["hasSignatureChanges"].Code
+
+func TestHasSignatureChanges(t *testing.T) {
+	tests := map[string]struct {
+		pattern string
+		head    map[string]string
+		wantVal lang.Value
+	}{
+		"when signature changed in matching file": {
+			pattern: "**/*.go",
+			head: map[string]string{
+				"Crawl": "func Crawl(url string, depth int)",
+			},
+			wantVal: lang.BuildTrueValue(),
+		},
+		"when signature changed in other file": {
+			pattern: "api/**",
+			head: map[string]string{
+				"Crawl": "func Crawl(url string, depth int)",
+			},
+			wantVal: lang.BuildFalseValue(),
+		},
+		"when only the body changed": {
+			pattern: "**/*.go",
+			head: map[string]string{
+				"Crawl": "func Crawl(url string)",
+			},
+			wantVal: lang.BuildFalseValue(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockedEnv := mockSemanticEnv(
+				t,
+				buildMockedSemanticSymbols(map[string]string{
+					"Crawl": "func Crawl(url string)",
+				}),
+				buildMockedSemanticSymbols(test.head),
+			)
+
+			gotVal, err := hasSignatureChanges(mockedEnv, []lang.Value{lang.BuildStringValue(test.pattern)})
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantVal, gotVal)
+		})
+	}
+}
@@ -5,9 +5,8 @@
 package plugins_aladino_functions
 
 import (
-	"github.com/google/go-github/v52/github"
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -28,10 +27,7 @@ func haveAllChecksRunCompleted(e aladino.Env, args []lang.Value) (lang.Value, er
 	checkRunsToIgnore := args[0].(*lang.ArrayValue)
 	conclusion := args[1].(*lang.StringValue)
 	checkConclusionsToIgnore := args[2].(*lang.ArrayValue)
-	pullRequest := e.GetTarget().(*target.PullRequestTarget)
-	owner := pullRequest.GetTargetEntity().Owner
-	repo := pullRequest.GetTargetEntity().Repo
-	number := pullRequest.GetTargetEntity().Number
+	pullRequest := e.GetTarget().(codehost.PullRequest)
 
 	lastCommitSHA, err := pullRequest.GetLastCommit()
 	if err != nil {
@@ -42,7 +38,7 @@ func haveAllChecksRunCompleted(e aladino.Env, args []lang.Value) (lang.Value, er
 		return lang.BuildBoolValue(false), nil
 	}
 
-	checkRuns, err := e.GetGithubClient().GetCheckRunsForRef(e.GetCtx(), owner, repo, number, lastCommitSHA, &github.ListCheckRunsOptions{})
+	checkRuns, err := pullRequest.GetCheckRuns(lastCommitSHA)
 	if err != nil {
 		return nil, err
 	}
@@ -65,11 +61,11 @@ func haveAllChecksRunCompleted(e aladino.Env, args []lang.Value) (lang.Value, er
 			continue
 		}
 
-		if checkRun.GetStatus() != "completed" {
+		if checkRun.Status != "completed" {
 			return lang.BuildBoolValue(false), nil
 		}
 
-		if conclusion.Val != "" && checkRun.GetConclusion() != conclusion.Val {
+		if conclusion.Val != "" && checkRun.Conclusion != conclusion.Val {
 			return lang.BuildBoolValue(false), nil
 		}
 	}
@@ -77,6 +73,6 @@ func haveAllChecksRunCompleted(e aladino.Env, args []lang.Value) (lang.Value, er
 	return lang.BuildBoolValue(true), nil
 }
 
-func isIgnoredCheckRun(checkRun *github.CheckRun, ignoredRuns, ignoredConclusions map[string]bool) bool {
-	return ignoredRuns[checkRun.GetName()] || ignoredConclusions[checkRun.GetConclusion()]
+func isIgnoredCheckRun(checkRun *codehost.CheckRun, ignoredRuns, ignoredConclusions map[string]bool) bool {
+	return ignoredRuns[checkRun.Name] || ignoredConclusions[checkRun.Conclusion]
 }
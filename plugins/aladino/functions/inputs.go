@@ -0,0 +1,54 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"fmt"
+
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	"github.com/reviewpad/reviewpad/v4/utils"
+)
+
+func Inputs() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type:           lang.BuildFunctionType([]lang.Type{}, lang.BuildDictionaryType()),
+		Code:           inputsCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue},
+	}
+}
+
+// inputsCode returns the inputs of workflow_dispatch events and the client payload of repository_dispatch events.
+// Other events have no inputs.
+func inputsCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
+	inputs, err := utils.GetDispatchInputs(e.GetEventPayload())
+	if err != nil {
+		return nil, err
+	}
+
+	vals := make(map[string]lang.Value, len(inputs))
+	for key, input := range inputs {
+		vals[key] = buildInputValue(input)
+	}
+
+	return lang.BuildDictionaryValue(vals), nil
+}
+
+func buildInputValue(input interface{}) lang.Value {
+	switch val := input.(type) {
+	case string:
+		return lang.BuildStringValue(val)
+	case bool:
+		return lang.BuildBoolValue(val)
+	case float64:
+		if val == float64(int(val)) {
+			return lang.BuildIntValue(int(val))
+		}
+		return lang.BuildStringValue(fmt.Sprint(val))
+	default:
+		return lang.BuildJSONValue(val)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var inputs = plugins_aladino.PluginBuiltIns().Functions["inputs"].Code
+
+func TestInputs(t *testing.T) {
+	tests := map[string]struct {
+		eventPayload interface{}
+		wantValue    lang.Value
+		wantErr      string
+	}{
+		"when event is not a dispatch": {
+			eventPayload: &github.PullRequestEvent{},
+			wantValue:    lang.BuildDictionaryValue(map[string]lang.Value{}),
+		},
+		"when workflow dispatch": {
+			eventPayload: &github.WorkflowDispatchEvent{
+				Inputs: json.RawMessage(`{"number": "12", "workflow": "release"}`),
+			},
+			wantValue: lang.BuildDictionaryValue(map[string]lang.Value{
+				"number":   lang.BuildStringValue("12"),
+				"workflow": lang.BuildStringValue("release"),
+			}),
+		},
+		"when repository dispatch": {
+			eventPayload: &github.RepositoryDispatchEvent{
+				ClientPayload: json.RawMessage(`{"number": 12, "ratio": 0.5, "force": true, "labels": ["bug"]}`),
+			},
+			wantValue: lang.BuildDictionaryValue(map[string]lang.Value{
+				"number": lang.BuildIntValue(12),
+				"ratio":  lang.BuildStringValue("0.5"),
+				"force":  lang.BuildTrueValue(),
+				"labels": lang.BuildJSONValue([]interface{}{"bug"}),
+			}),
+		},
+		"when inputs are invalid": {
+			eventPayload: &github.RepositoryDispatchEvent{
+				ClientPayload: json.RawMessage(`"release"`),
+			},
+			wantErr: "invalid dispatch inputs: json: cannot unmarshal string into Go value of type map[string]interface {}",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockedEnv := aladino.MockDefaultEnv(
+				t,
+				nil,
+				nil,
+				aladino.MockBuiltIns(),
+				test.eventPayload,
+			)
+
+			gotValue, err := inputs(mockedEnv, []lang.Value{})
+
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, test.wantValue, gotValue)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+)
+
+func IsAnswered() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type:           lang.BuildFunctionType([]lang.Type{}, lang.BuildBoolType()),
+		Code:           isAnsweredCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.Discussion},
+	}
+}
+
+func isAnsweredCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
+	t := e.GetTarget().(*target.DiscussionTarget)
+	return lang.BuildBoolValue(t.IsAnswered()), nil
+}
@@ -0,0 +1,35 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var isAnswered = plugins_aladino.PluginBuiltIns().Functions["isAnswered"].Code
+
+func TestIsAnswered(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnvWithTargetEntity(
+		t,
+		nil,
+		aladino.MockDiscussionGraphQLHandler("[]", func(w http.ResponseWriter, query string) {
+			assert.FailNow(t, "unexpected request", query)
+		}),
+		aladino.MockBuiltIns(),
+		nil,
+		aladino.DefaultMockDiscussionTargetEntity,
+	)
+
+	gotIsAnswered, err := isAnswered(mockedEnv, []lang.Value{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, lang.BuildFalseValue(), gotIsAnswered)
+}
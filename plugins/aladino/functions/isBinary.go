@@ -6,7 +6,7 @@ package plugins_aladino_functions
 
 import (
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -20,8 +20,8 @@ func IsBinary() *aladino.BuiltInFunction {
 }
 
 func isBinaryCode(e aladino.Env, args []lang.Value) (lang.Value, error) {
-	target := e.GetTarget().(*target.PullRequestTarget)
-	headBranch := target.PullRequest.Head.Name
+	target := e.GetTarget().(codehost.PullRequest)
+	headBranch := target.GetPullRequest().Head.Name
 	fileName := args[0].(*lang.StringValue).Val
 
 	isBinary, err := target.IsFileBinary(headBranch, fileName)
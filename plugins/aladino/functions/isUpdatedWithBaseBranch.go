@@ -8,7 +8,7 @@ import (
 	"fmt"
 
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -22,15 +22,7 @@ func IsUpdatedWithBaseBranch() *aladino.BuiltInFunction {
 }
 
 func isUpdatedWithBaseBranchCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
-	pullRequest := e.GetTarget().(*target.PullRequestTarget).PullRequest
-	targetEntity := e.GetTarget().GetTargetEntity()
-
-	pullRequestUpToDate, err := e.GetGithubClient().GetPullRequestUpToDate(
-		e.GetCtx(),
-		targetEntity.Owner,
-		targetEntity.Repo,
-		int(pullRequest.GetNumber()),
-	)
+	pullRequestUpToDate, err := e.GetTarget().(codehost.PullRequest).IsUpdatedWithBaseBranch()
 	if err != nil {
 		return nil, fmt.Errorf("error getting pull request outdated information: %s", err.Error())
 	}
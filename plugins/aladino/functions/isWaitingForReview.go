@@ -5,9 +5,10 @@
 package plugins_aladino_functions
 
 import (
-	"github.com/google/go-github/v52/github"
+	"time"
+
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -21,7 +22,8 @@ func IsWaitingForReview() *aladino.BuiltInFunction {
 }
 
 func isWaitingForReviewCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
-	pullRequest := e.GetTarget().(*target.PullRequestTarget).PullRequest
+	target := e.GetTarget().(codehost.PullRequest)
+	pullRequest := target.GetPullRequest()
 	requestedUsers := pullRequest.RequestedReviewers.Users
 	requestedTeams := pullRequest.RequestedReviewers.Teams
 	log := e.GetLogger().WithField("builtin", "isWaitingForReview")
@@ -35,7 +37,7 @@ func isWaitingForReviewCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
 	repo := pullRequest.GetBase().GetRepo().GetName()
 	author := pullRequest.GetAuthor().GetLogin()
 
-	commits, err := e.GetGithubClient().GetPullRequestCommits(e.GetCtx(), owner, repo, int(prNum))
+	commits, err := target.GetCommits()
 	if err != nil {
 		return nil, err
 	}
@@ -45,27 +47,27 @@ func isWaitingForReviewCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
 		return lang.BuildBoolValue(false), nil
 	}
 
-	lastPushedDate, err := e.GetGithubClient().GetPullRequestLastPushDate(e.GetCtx(), owner, repo, int(prNum))
+	lastPushedDate, err := target.GetPullRequestLastPushDate()
 	if err != nil {
 		return nil, err
 	}
 
-	reviews, err := e.GetGithubClient().GetPullRequestReviews(e.GetCtx(), owner, repo, int(prNum))
+	reviews, err := target.GetReviews()
 	if err != nil {
 		return nil, err
 	}
 
-	lastReviewByUser := make(map[string]*github.PullRequestReview)
+	lastReviewByUser := make(map[string]*codehost.Review)
 
 	for _, review := range reviews {
-		userLogin := review.User.GetLogin()
+		userLogin := review.User.Login
 		if userLogin == "" || userLogin == author {
 			continue
 		}
 
 		lastUserReview, ok := lastReviewByUser[userLogin]
 		if ok {
-			if review.GetSubmittedAt().After(lastUserReview.GetSubmittedAt().Time) {
+			if getReviewSubmittedAt(review).After(getReviewSubmittedAt(lastUserReview)) {
 				lastReviewByUser[userLogin] = review
 			}
 		} else {
@@ -74,8 +76,8 @@ func isWaitingForReviewCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
 	}
 
 	for _, lastUserReview := range lastReviewByUser {
-		if *lastUserReview.State != "APPROVED" {
-			if lastUserReview.GetSubmittedAt().Before(lastPushedDate) {
+		if lastUserReview.State != "APPROVED" {
+			if getReviewSubmittedAt(lastUserReview).Before(lastPushedDate) {
 				return lang.BuildBoolValue(true), nil
 			}
 		}
@@ -83,3 +85,12 @@ func isWaitingForReviewCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
 
 	return lang.BuildBoolValue(false), nil
 }
+
+// getReviewSubmittedAt returns the submission date of the review or the zero time when the review is pending.
+func getReviewSubmittedAt(review *codehost.Review) time.Time {
+	if review.SubmittedAt == nil {
+		return time.Time{}
+	}
+
+	return *review.SubmittedAt
+}
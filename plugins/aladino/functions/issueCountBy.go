@@ -7,6 +7,7 @@ package plugins_aladino_functions
 import (
 	"github.com/google/go-github/v52/github"
 	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -36,6 +37,10 @@ func issueCountByCode(e aladino.Env, args []lang.Value) (lang.Value, error) {
 }
 
 func issueCountBy(e aladino.Env, opts *github.IssueListByRepoOptions, predicate func(issue *github.Issue) bool) (lang.Value, error) {
+	if e.GetGithubClient() == nil {
+		return nil, codehost.ErrNotSupported
+	}
+
 	entity := e.GetTarget().GetTargetEntity()
 	owner := entity.Owner
 	repo := entity.Repo
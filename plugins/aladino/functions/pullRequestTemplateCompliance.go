@@ -0,0 +1,33 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	prtemplate "github.com/reviewpad/reviewpad/v4/plugins/aladino/prtemplate"
+)
+
+func PullRequestTemplateCompliance() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type:           lang.BuildFunctionType([]lang.Type{lang.BuildStringType()}, lang.BuildJSONType()),
+		Code:           pullRequestTemplateComplianceCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest},
+	}
+}
+
+func pullRequestTemplateComplianceCode(e aladino.Env, args []lang.Value) (lang.Value, error) {
+	templatePath := args[0].(*lang.StringValue).Val
+
+	template, err := prtemplate.LoadTemplate(e, templatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	compliance := prtemplate.CheckCompliance(template, e.GetTarget().GetDescription())
+
+	return lang.BuildJSONValue(compliance.ToMap()), nil
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	pbc "github.com/reviewpad/api/go/codehost"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/reviewpad/reviewpad/v4/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var pullRequestTemplateCompliance = plugins_aladino.PluginBuiltIns().Functions["pullRequestTemplateCompliance"].Code
+
+const mockedPullRequestTemplate = `## Description
+<!-- Describe your changes. -->
+
+## Related issues
+Closes #
+
+## Checklist
+- [ ] Tests were added
+- [ ] Documentation was updated
+`
+
+func mockPullRequestTemplateEnv(t *testing.T, description string, templatePath string) aladino.Env {
+	mockedTemplateLocation := fmt.Sprintf("/%v/%v/%v", aladino.DefaultMockPrOwner, aladino.DefaultMockPrRepoName, templatePath)
+
+	return aladino.MockDefaultEnvWithPullRequestAndFiles(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.GetReposContentsByOwnerByRepoByPath,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.URL.Path != fmt.Sprintf("/repos/%v/%v/contents/.github", aladino.DefaultMockPrOwner, aladino.DefaultMockPrRepoName) {
+						w.WriteHeader(http.StatusNotFound)
+						utils.MustWrite(w, `{"message": "Not Found"}`)
+						return
+					}
+
+					utils.MustWriteBytes(w, mock.MustMarshal([]github.RepositoryContent{
+						{
+							Name:        github.String("pull_request_template.md"),
+							Path:        github.String(".github/pull_request_template.md"),
+							DownloadURL: github.String(fmt.Sprintf("https://raw.githubusercontent.com%v", mockedTemplateLocation)),
+						},
+					}))
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.EndpointPattern{
+					Pattern: mockedTemplateLocation,
+					Method:  "GET",
+				},
+				http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					utils.MustWrite(w, mockedPullRequestTemplate)
+				}),
+			),
+		},
+		nil,
+		aladino.GetDefaultMockPullRequestDetailsWith(&pbc.PullRequest{
+			Description: description,
+		}),
+		aladino.GetDefaultPullRequestFileList(),
+		aladino.MockBuiltIns(),
+		nil,
+	)
+}
+
+func TestPullRequestTemplateCompliance(t *testing.T) {
+	tests := map[string]struct {
+		description  string
+		templatePath string
+		wantValue    lang.Value
+		wantErr      string
+	}{
+		"when the description follows the template": {
+			description:  "## Description\nAdds the new feature.\n\n## Related issues\nCloses #42\n\n## Checklist\n- [x] Tests were added\n- [ ] Documentation was updated",
+			templatePath: "",
+			wantValue: lang.BuildJSONValue(map[string]interface{}{
+				"compliant":           true,
+				"missingSections":     []interface{}{},
+				"emptySections":       []interface{}{},
+				"placeholderSections": []interface{}{},
+				"checkedItems":        1,
+				"uncheckedItems":      1,
+			}),
+		},
+		"when the description does not follow the template": {
+			description:  "## Description\n\n## Related issues\nCloses #",
+			templatePath: ".github/pull_request_template.md",
+			wantValue: lang.BuildJSONValue(map[string]interface{}{
+				"compliant":           false,
+				"missingSections":     []interface{}{"Checklist"},
+				"emptySections":       []interface{}{"Description"},
+				"placeholderSections": []interface{}{"Related issues"},
+				"checkedItems":        0,
+				"uncheckedItems":      0,
+			}),
+		},
+		"when the template does not exist": {
+			description:  "## Description",
+			templatePath: "docs/template.md",
+			wantErr:      "pull request template not found in docs/template.md",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockedEnv := mockPullRequestTemplateEnv(t, test.description, ".github/pull_request_template.md")
+
+			gotValue, err := pullRequestTemplateCompliance(mockedEnv, []lang.Value{lang.BuildStringValue(test.templatePath)})
+
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantValue, gotValue)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+)
+
+func PushedBranch() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type:           lang.BuildFunctionType([]lang.Type{}, lang.BuildStringType()),
+		Code:           pushedBranchCode,
+		SupportedKinds: []entities.TargetEntityKind{codehost.Branch},
+	}
+}
+
+func pushedBranchCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
+	t := e.GetTarget().(*target.BranchTarget)
+	return lang.BuildStringValue(t.GetBranch()), nil
+}
@@ -0,0 +1,25 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var pushedBranch = plugins_aladino.PluginBuiltIns().Functions["pushedBranch"].Code
+
+func TestPushedBranch(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnvWithTargetEntity(t, nil, nil, aladino.MockBuiltIns(), aladino.GetDefaultMockPushEvent(), aladino.DefaultMockBranchTargetEntity)
+
+	gotBranch, err := pushedBranch(mockedEnv, []lang.Value{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, lang.BuildStringValue("release"), gotBranch)
+}
@@ -0,0 +1,37 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+)
+
+func PushedCommits() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type:           lang.BuildFunctionType([]lang.Type{}, lang.BuildArrayOfType(lang.BuildStringType())),
+		Code:           pushedCommitsCode,
+		SupportedKinds: []entities.TargetEntityKind{codehost.Branch},
+	}
+}
+
+// pushedCommitsCode returns the messages of the commits added to the branch by the push.
+func pushedCommitsCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
+	t := e.GetTarget().(*target.BranchTarget)
+	commits, err := t.GetPushedCommits()
+	if err != nil {
+		return nil, err
+	}
+
+	commitMessages := make([]lang.Value, len(commits))
+	for i, commit := range commits {
+		commitMessages[i] = lang.BuildStringValue(commit.Message)
+	}
+
+	return lang.BuildArrayValue(commitMessages), nil
+}
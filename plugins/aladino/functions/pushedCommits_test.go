@@ -0,0 +1,114 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var pushedCommits = plugins_aladino.PluginBuiltIns().Functions["pushedCommits"].Code
+
+func TestPushedCommits(t *testing.T) {
+	pushEvent := aladino.GetDefaultMockPushEvent()
+	mockedEnv := aladino.MockDefaultEnvWithTargetEntity(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.GetReposCompareByOwnerByRepoByBasehead,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "/repos/foobar/default-mock-repo/compare/"+pushEvent.GetBefore()+"..."+pushEvent.GetAfter(), r.URL.Path)
+					w.Write(mock.MustMarshal(&github.CommitsComparison{
+						Commits: []*github.RepositoryCommit{
+							{Commit: &github.Commit{Message: github.String("fix: typo")}},
+							{Commit: &github.Commit{Message: github.String("feat: release notes")}},
+						},
+					}))
+				}),
+			),
+		},
+		nil,
+		aladino.MockBuiltIns(),
+		pushEvent,
+		aladino.DefaultMockBranchTargetEntity,
+	)
+
+	wantCommits := lang.BuildArrayValue([]lang.Value{
+		lang.BuildStringValue("fix: typo"),
+		lang.BuildStringValue("feat: release notes"),
+	})
+
+	gotCommits, err := pushedCommits(mockedEnv, []lang.Value{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantCommits, gotCommits)
+}
+
+func TestPushedCommits_WhenBranchIsCreated(t *testing.T) {
+	pushEvent := aladino.GetDefaultMockPushEvent()
+	pushEvent.Before = github.String("0000000000000000000000000000000000000000")
+	pushEvent.Created = github.Bool(true)
+
+	mockedEnv := aladino.MockDefaultEnvWithTargetEntity(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.GetReposCompareByOwnerByRepoByBasehead,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "/repos/foobar/default-mock-repo/compare/main..."+pushEvent.GetAfter(), r.URL.Path)
+					w.Write(mock.MustMarshal(&github.CommitsComparison{
+						Commits: []*github.RepositoryCommit{
+							{Commit: &github.Commit{Message: github.String("feat: release branch")}},
+						},
+					}))
+				}),
+			),
+		},
+		nil,
+		aladino.MockBuiltIns(),
+		pushEvent,
+		aladino.DefaultMockBranchTargetEntity,
+	)
+
+	gotCommits, err := pushedCommits(mockedEnv, []lang.Value{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, lang.BuildArrayValue([]lang.Value{lang.BuildStringValue("feat: release branch")}), gotCommits)
+}
+
+func TestPushedCommits_WhenCompareRequestFails(t *testing.T) {
+	failMessage := "CompareCommitsRequestFail"
+	mockedEnv := aladino.MockDefaultEnvWithTargetEntity(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.GetReposCompareByOwnerByRepoByBasehead,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					mock.WriteError(
+						w,
+						http.StatusInternalServerError,
+						failMessage,
+					)
+				}),
+			),
+		},
+		nil,
+		aladino.MockBuiltIns(),
+		aladino.GetDefaultMockPushEvent(),
+		aladino.DefaultMockBranchTargetEntity,
+	)
+
+	gotCommits, err := pushedCommits(mockedEnv, []lang.Value{})
+
+	assert.Nil(t, gotCommits)
+	assert.Equal(t, err.(*github.ErrorResponse).Message, failMessage)
+}
@@ -0,0 +1,37 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+)
+
+func PushedFiles() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type:           lang.BuildFunctionType([]lang.Type{}, lang.BuildArrayOfType(lang.BuildStringType())),
+		Code:           pushedFilesCode,
+		SupportedKinds: []entities.TargetEntityKind{codehost.Branch},
+	}
+}
+
+// pushedFilesCode returns the paths of the files changed by the push, from the comparison of the branch before and after the push.
+func pushedFilesCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
+	t := e.GetTarget().(*target.BranchTarget)
+	files, err := t.GetPushedFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	filePaths := make([]lang.Value, len(files))
+	for i, file := range files {
+		filePaths[i] = lang.BuildStringValue(file)
+	}
+
+	return lang.BuildArrayValue(filePaths), nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var pushedFiles = plugins_aladino.PluginBuiltIns().Functions["pushedFiles"].Code
+
+func TestPushedFiles(t *testing.T) {
+	mockedEnv := aladino.MockDefaultEnvWithTargetEntity(
+		t,
+		[]mock.MockBackendOption{
+			mock.WithRequestMatch(
+				mock.GetReposCompareByOwnerByRepoByBasehead,
+				&github.CommitsComparison{
+					Files: []*github.CommitFile{
+						{Filename: github.String("CHANGELOG.md")},
+						{Filename: github.String("main.go")},
+					},
+				},
+			),
+		},
+		nil,
+		aladino.MockBuiltIns(),
+		aladino.GetDefaultMockPushEvent(),
+		aladino.DefaultMockBranchTargetEntity,
+	)
+
+	wantFiles := lang.BuildArrayValue([]lang.Value{
+		lang.BuildStringValue("CHANGELOG.md"),
+		lang.BuildStringValue("main.go"),
+	})
+
+	gotFiles, err := pushedFiles(mockedEnv, []lang.Value{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantFiles, gotFiles)
+}
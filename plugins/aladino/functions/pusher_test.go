@@ -0,0 +1,47 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var pusher = plugins_aladino.PluginBuiltIns().Functions["pusher"].Code
+
+func TestPusher(t *testing.T) {
+	tests := map[string]struct {
+		pusher     *github.User
+		wantPusher string
+	}{
+		"when pusher has a name": {
+			pusher:     &github.User{Name: github.String("jane")},
+			wantPusher: "jane",
+		},
+		"when pusher has no name": {
+			pusher:     nil,
+			wantPusher: "john",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			pushEvent := aladino.GetDefaultMockPushEvent()
+			pushEvent.Pusher = test.pusher
+
+			mockedEnv := aladino.MockDefaultEnvWithTargetEntity(t, nil, nil, aladino.MockBuiltIns(), pushEvent, aladino.DefaultMockBranchTargetEntity)
+
+			gotPusher, err := pusher(mockedEnv, []lang.Value{})
+
+			assert.Nil(t, err)
+			assert.Equal(t, lang.BuildStringValue(test.wantPusher), gotPusher)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v4/lang"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var removedFunctions = plugins_aladino.PluginBuiltIns().Functions["removedFunctions"].Code
+
+func TestRemovedFunctions(t *testing.T) {
+	mockedEnv := mockSemanticEnv(
+		t,
+		buildMockedSemanticSymbols(map[string]string{
+			"Crawl": "func Crawl(url string)",
+			"Parse": "func Parse(body string)",
+		}),
+		buildMockedSemanticSymbols(map[string]string{
+			"Crawl": "func Crawl(url string)",
+		}),
+	)
+
+	wantVal := lang.BuildJSONValue([]interface{}{
+		map[string]interface{}{
+			"name":              "Parse",
+			"type":              "function",
+			"path":              mockedSemanticFilePath,
+			"change":            "removed",
+			"public":            true,
+			"signature":         "",
+			"previousSignature": "func Parse(body string)",
+		},
+	})
+
+	gotVal, err := removedFunctions(mockedEnv, []lang.Value{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
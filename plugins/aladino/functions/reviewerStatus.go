@@ -6,7 +6,7 @@ package plugins_aladino_functions
 
 import (
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -22,12 +22,7 @@ func ReviewerStatus() *aladino.BuiltInFunction {
 func reviewerStatusCode(e aladino.Env, args []lang.Value) (lang.Value, error) {
 	reviewerLogin := args[0].(*lang.StringValue)
 
-	pullRequest := e.GetTarget().(*target.PullRequestTarget).PullRequest
-	prNum := pullRequest.Number
-	owner := pullRequest.GetBase().GetRepo().GetOwner()
-	repo := pullRequest.GetBase().GetRepo().GetName()
-
-	reviews, err := e.GetGithubClient().GetPullRequestReviews(e.GetCtx(), owner, repo, int(prNum))
+	reviews, err := e.GetTarget().(codehost.PullRequest).GetReviews()
 	if err != nil {
 		return nil, err
 	}
@@ -36,15 +31,15 @@ func reviewerStatusCode(e aladino.Env, args []lang.Value) (lang.Value, error) {
 	reviewerHasDecision := false
 
 	for _, review := range reviews {
-		if review.User == nil || review.State == nil {
+		if review.User.Login == "" || review.State == "" {
 			continue
 		}
 
-		if *review.User.Login != reviewerLogin.Val {
+		if review.User.Login != reviewerLogin.Val {
 			continue
 		}
 
-		reviewState := *review.State
+		reviewState := review.State
 		if reviewState == "COMMENTED" {
 			if reviewerHasDecision {
 				continue
@@ -6,7 +6,7 @@ package plugins_aladino_functions
 
 import (
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -20,7 +20,7 @@ func Reviewers() *aladino.BuiltInFunction {
 }
 
 func reviewersCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
-	t := e.GetTarget().(*target.PullRequestTarget)
+	t := e.GetTarget().(codehost.PullRequest)
 	reviewers := make([]lang.Value, 0)
 
 	reviews, err := t.GetReviews()
@@ -32,7 +32,7 @@ func reviewersCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
 	for _, review := range reviews {
 		reviewer := review.User.Login
 
-		if reviewer == t.PullRequest.Author.Login {
+		if reviewer == t.GetPullRequest().Author.Login {
 			continue
 		}
 
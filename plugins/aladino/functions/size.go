@@ -7,7 +7,7 @@ package plugins_aladino_functions
 import (
 	"github.com/bmatcuk/doublestar/v4"
 	"github.com/reviewpad/go-lib/entities"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -22,14 +22,15 @@ func Size() *aladino.BuiltInFunction {
 
 func sizeCode(e aladino.Env, args []lang.Value) (lang.Value, error) {
 	filePatternsRegex := args[0].(*lang.ArrayValue)
+	pullRequest := e.GetTarget().(codehost.PullRequest)
 
 	if len(filePatternsRegex.Vals) == 0 {
-		size := e.GetTarget().(*target.PullRequestTarget).PullRequest.AdditionsCount + e.GetTarget().(*target.PullRequestTarget).PullRequest.DeletionsCount
+		size := pullRequest.GetPullRequest().AdditionsCount + pullRequest.GetPullRequest().DeletionsCount
 		return lang.BuildIntValue(int(size)), nil
 	}
 
-	patch := e.GetTarget().(*target.PullRequestTarget).Patch
-	clearedPatch := make(target.Patch)
+	patch := pullRequest.GetPatch()
+	clearedPatch := make(codehost.Patch)
 	for fp, file := range patch {
 		var found bool
 		for _, filePatternRegex := range filePatternsRegex.Vals {
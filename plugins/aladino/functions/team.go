@@ -7,6 +7,7 @@ package plugins_aladino_functions
 import (
 	"github.com/google/go-github/v52/github"
 	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -15,11 +16,15 @@ func Team() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
 		Type:           lang.BuildFunctionType([]lang.Type{lang.BuildStringType()}, lang.BuildArrayOfType(lang.BuildStringType())),
 		Code:           teamCode,
-		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue},
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue, entities.Discussion, codehost.Branch, codehost.Release},
 	}
 }
 
 func teamCode(e aladino.Env, args []lang.Value) (lang.Value, error) {
+	if e.GetGithubClient() == nil {
+		return nil, codehost.ErrNotSupported
+	}
+
 	teamSlug := args[0].(*lang.StringValue).Val
 	orgName := e.GetTarget().GetTargetEntity().Owner
 
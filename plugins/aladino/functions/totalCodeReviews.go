@@ -6,6 +6,7 @@ package plugins_aladino_functions
 
 import (
 	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 	"github.com/shurcooL/githubv4"
@@ -20,6 +21,10 @@ func TotalCodeReviews() *aladino.BuiltInFunction {
 }
 
 func totalCodeReviewsCode(e aladino.Env, args []lang.Value) (lang.Value, error) {
+	if e.GetGithubClient() == nil {
+		return nil, codehost.ErrNotSupported
+	}
+
 	username := args[0].(*lang.StringValue).Val
 
 	var totalPullRequestReviewContributionsQuery struct {
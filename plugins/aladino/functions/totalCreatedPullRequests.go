@@ -7,6 +7,7 @@ package plugins_aladino_functions
 import (
 	"github.com/google/go-github/v52/github"
 	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/lang"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 )
@@ -20,6 +21,10 @@ func TotalCreatedPullRequests() *aladino.BuiltInFunction {
 }
 
 func totalCreatedPullRequestsCode(e aladino.Env, args []lang.Value) (lang.Value, error) {
+	if e.GetGithubClient() == nil {
+		return nil, codehost.ErrNotSupported
+	}
+
 	devName := args[0].(*lang.StringValue).Val
 
 	entity := e.GetTarget().GetTargetEntity()
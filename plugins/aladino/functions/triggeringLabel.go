@@ -0,0 +1,31 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+)
+
+func TriggeringLabel() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type:           lang.BuildFunctionType([]lang.Type{}, lang.BuildStringType()),
+		Code:           triggeringLabelCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue},
+	}
+}
+
+// triggeringLabelCode returns the name of the label that triggered the event.
+// The label is only set on labeled and unlabeled pull request and issue events and on label events.
+func triggeringLabelCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
+	eventPayload, ok := e.GetEventPayload().(interface{ GetLabel() *github.Label })
+	if !ok {
+		return lang.BuildStringValue(""), nil
+	}
+
+	return lang.BuildStringValue(eventPayload.GetLabel().GetName()), nil
+}
@@ -0,0 +1,92 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var triggeringLabel = plugins_aladino.PluginBuiltIns().Functions["triggeringLabel"].Code
+
+func TestTriggeringLabel(t *testing.T) {
+	tests := map[string]struct {
+		eventPayload interface{}
+		wantValue    lang.Value
+	}{
+		"when event payload is nil": {
+			eventPayload: nil,
+			wantValue:    lang.BuildStringValue(""),
+		},
+		"when event has no label": {
+			eventPayload: &github.CheckRunEvent{},
+			wantValue:    lang.BuildStringValue(""),
+		},
+		"when pull request is not labeled": {
+			eventPayload: &github.PullRequestEvent{
+				Action: github.String("opened"),
+			},
+			wantValue: lang.BuildStringValue(""),
+		},
+		"when pull request is labeled": {
+			eventPayload: &github.PullRequestEvent{
+				Action: github.String("labeled"),
+				Label: &github.Label{
+					Name: github.String("ship-it"),
+				},
+			},
+			wantValue: lang.BuildStringValue("ship-it"),
+		},
+		"when pull request target is unlabeled": {
+			eventPayload: &github.PullRequestTargetEvent{
+				Action: github.String("unlabeled"),
+				Label: &github.Label{
+					Name: github.String("wip"),
+				},
+			},
+			wantValue: lang.BuildStringValue("wip"),
+		},
+		"when issue is labeled": {
+			eventPayload: &github.IssuesEvent{
+				Action: github.String("labeled"),
+				Label: &github.Label{
+					Name: github.String("bug"),
+				},
+			},
+			wantValue: lang.BuildStringValue("bug"),
+		},
+		"when label is edited": {
+			eventPayload: &github.LabelEvent{
+				Action: github.String("edited"),
+				Label: &github.Label{
+					Name: github.String("enhancement"),
+				},
+			},
+			wantValue: lang.BuildStringValue("enhancement"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockedEnv := aladino.MockDefaultEnv(
+				t,
+				nil,
+				nil,
+				aladino.MockBuiltIns(),
+				test.eventPayload,
+			)
+
+			gotValue, err := triggeringLabel(mockedEnv, []lang.Value{})
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantValue, gotValue)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+)
+
+func TriggeringMilestone() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type:           lang.BuildFunctionType([]lang.Type{}, lang.BuildStringType()),
+		Code:           triggeringMilestoneCode,
+		SupportedKinds: []entities.TargetEntityKind{entities.PullRequest, entities.Issue},
+	}
+}
+
+// triggeringMilestoneCode returns the title of the milestone that triggered the event.
+// Pull request and issue events only have the milestone when it was just set, i.e. on the milestoned action.
+func triggeringMilestoneCode(e aladino.Env, _ []lang.Value) (lang.Value, error) {
+	var milestone *github.Milestone
+
+	switch eventPayload := e.GetEventPayload().(type) {
+	case *github.MilestoneEvent:
+		milestone = eventPayload.GetMilestone()
+	case *github.IssuesEvent:
+		if eventPayload.GetAction() == "milestoned" {
+			milestone = eventPayload.GetIssue().GetMilestone()
+		}
+	case *github.PullRequestEvent:
+		if eventPayload.GetAction() == "milestoned" {
+			milestone = eventPayload.GetPullRequest().GetMilestone()
+		}
+	case *github.PullRequestTargetEvent:
+		if eventPayload.GetAction() == "milestoned" {
+			milestone = eventPayload.GetPullRequest().GetMilestone()
+		}
+	}
+
+	return lang.BuildStringValue(milestone.GetTitle()), nil
+}
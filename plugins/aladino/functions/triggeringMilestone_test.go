@@ -0,0 +1,98 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/reviewpad/v4/lang"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v4/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var triggeringMilestone = plugins_aladino.PluginBuiltIns().Functions["triggeringMilestone"].Code
+
+func TestTriggeringMilestone(t *testing.T) {
+	milestone := &github.Milestone{
+		Number: github.Int(3),
+		Title:  github.String("v1.0"),
+	}
+
+	tests := map[string]struct {
+		eventPayload interface{}
+		wantValue    lang.Value
+	}{
+		"when event payload is nil": {
+			eventPayload: nil,
+			wantValue:    lang.BuildStringValue(""),
+		},
+		"when event has no milestone": {
+			eventPayload: &github.CheckRunEvent{},
+			wantValue:    lang.BuildStringValue(""),
+		},
+		"when milestone event": {
+			eventPayload: &github.MilestoneEvent{
+				Action:    github.String("closed"),
+				Milestone: milestone,
+			},
+			wantValue: lang.BuildStringValue("v1.0"),
+		},
+		"when issue is milestoned": {
+			eventPayload: &github.IssuesEvent{
+				Action: github.String("milestoned"),
+				Issue: &github.Issue{
+					Milestone: milestone,
+				},
+			},
+			wantValue: lang.BuildStringValue("v1.0"),
+		},
+		"when issue is edited": {
+			eventPayload: &github.IssuesEvent{
+				Action: github.String("edited"),
+				Issue: &github.Issue{
+					Milestone: milestone,
+				},
+			},
+			wantValue: lang.BuildStringValue(""),
+		},
+		"when pull request is milestoned": {
+			eventPayload: &github.PullRequestEvent{
+				Action: github.String("milestoned"),
+				PullRequest: &github.PullRequest{
+					Milestone: milestone,
+				},
+			},
+			wantValue: lang.BuildStringValue("v1.0"),
+		},
+		"when pull request target is milestoned": {
+			eventPayload: &github.PullRequestTargetEvent{
+				Action: github.String("milestoned"),
+				PullRequest: &github.PullRequest{
+					Milestone: milestone,
+				},
+			},
+			wantValue: lang.BuildStringValue("v1.0"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockedEnv := aladino.MockDefaultEnv(
+				t,
+				nil,
+				nil,
+				aladino.MockBuiltIns(),
+				test.eventPayload,
+			)
+
+			gotValue, err := triggeringMilestone(mockedEnv, []lang.Value{})
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantValue, gotValue)
+		})
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_prtemplate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	gh "github.com/reviewpad/reviewpad/v4/codehost/github"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+	"github.com/reviewpad/reviewpad/v4/utils"
+)
+
+// DEFAULT_PULL_REQUEST_TEMPLATE_PATHS are the locations searched for the pull request template
+// when no path is provided, in the same order as GitHub.
+var DEFAULT_PULL_REQUEST_TEMPLATE_PATHS = []string{
+	".github/pull_request_template.md",
+	"pull_request_template.md",
+	"docs/pull_request_template.md",
+}
+
+var (
+	headingRegex       = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	checkboxRegex      = regexp.MustCompile(`^\s*[-*+]\s+\[([ xX])\]`)
+	htmlCommentRegex   = regexp.MustCompile(`(?s)<!--.*?-->`)
+	optionalTitleRegex = regexp.MustCompile(`(?i)\(optional\)`)
+)
+
+// Section is a heading of a markdown document and the content up to the next heading.
+type Section struct {
+	Title          string
+	Level          int
+	Content        string
+	HasSubsections bool
+}
+
+// IsOptional reports whether the section is marked as optional in the template, e.g. "## Screenshots (optional)".
+func (s *Section) IsOptional() bool {
+	return optionalTitleRegex.MatchString(s.Title)
+}
+
+// Compliance is the result of checking a pull request description against a template.
+type Compliance struct {
+	MissingSections     []string
+	EmptySections       []string
+	PlaceholderSections []string
+	CheckedItems        int
+	UncheckedItems      int
+}
+
+// IsCompliant reports whether all the required sections of the template are present and filled in.
+// Unchecked checklist items do not affect the compliance.
+func (c *Compliance) IsCompliant() bool {
+	return len(c.MissingSections) == 0 && len(c.EmptySections) == 0 && len(c.PlaceholderSections) == 0
+}
+
+func (c *Compliance) ToMap() map[string]interface{} {
+	return map[string]interface{}{
+		"compliant":           c.IsCompliant(),
+		"missingSections":     toInterfaceSlice(c.MissingSections),
+		"emptySections":       toInterfaceSlice(c.EmptySections),
+		"placeholderSections": toInterfaceSlice(c.PlaceholderSections),
+		"checkedItems":        c.CheckedItems,
+		"uncheckedItems":      c.UncheckedItems,
+	}
+}
+
+// ParseSections splits the markdown document in sections.
+// Headings inside fenced code blocks are ignored and the text before the first heading is discarded.
+func ParseSections(markdown string) []*Section {
+	sections := make([]*Section, 0)
+	var current *Section
+	var content []string
+	inCodeBlock := false
+
+	flush := func() {
+		if current != nil {
+			current.Content = strings.TrimSpace(strings.Join(content, "\n"))
+			sections = append(sections, current)
+		}
+		content = nil
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inCodeBlock = !inCodeBlock
+		}
+
+		if !inCodeBlock {
+			if matches := headingRegex.FindStringSubmatch(trimmed); matches != nil {
+				level := len(matches[1])
+				if current != nil && level > current.Level && strings.TrimSpace(strings.Join(content, "\n")) == "" {
+					current.HasSubsections = true
+				}
+
+				flush()
+				current = &Section{Title: matches[2], Level: level}
+				continue
+			}
+		}
+
+		content = append(content, line)
+	}
+
+	flush()
+
+	return sections
+}
+
+// CheckCompliance checks which sections of the template are missing, empty or left with the template placeholder text
+// in the description and counts the checklist items of the description.
+func CheckCompliance(template, description string) *Compliance {
+	compliance := &Compliance{
+		MissingSections:     []string{},
+		EmptySections:       []string{},
+		PlaceholderSections: []string{},
+	}
+
+	descriptionSections := make(map[string]*Section)
+	for _, section := range ParseSections(description) {
+		key := sectionKey(section.Title)
+		if _, ok := descriptionSections[key]; !ok {
+			descriptionSections[key] = section
+		}
+	}
+
+	for _, templateSection := range ParseSections(template) {
+		if templateSection.IsOptional() {
+			continue
+		}
+
+		section, ok := descriptionSections[sectionKey(templateSection.Title)]
+		if !ok {
+			compliance.MissingSections = append(compliance.MissingSections, templateSection.Title)
+			continue
+		}
+
+		// Sections that only group subsections in the template do not need content of their own.
+		if templateSection.HasSubsections {
+			continue
+		}
+
+		content := normalizeContent(section.Content)
+		if content == "" {
+			compliance.EmptySections = append(compliance.EmptySections, templateSection.Title)
+			continue
+		}
+
+		placeholder := normalizeContent(withoutCheckboxes(templateSection.Content))
+		if placeholder != "" && normalizeContent(withoutCheckboxes(section.Content)) == placeholder {
+			compliance.PlaceholderSections = append(compliance.PlaceholderSections, templateSection.Title)
+		}
+	}
+
+	compliance.CheckedItems, compliance.UncheckedItems = countCheckboxes(description)
+
+	return compliance
+}
+
+// LoadTemplate downloads the pull request template from the base branch of the pull request.
+// When path is empty, the template is searched in DEFAULT_PULL_REQUEST_TEMPLATE_PATHS.
+func LoadTemplate(e aladino.Env, path string) (string, error) {
+	if e.GetGithubClient() == nil {
+		return "", codehost.ErrNotSupported
+	}
+
+	t := e.GetTarget().(codehost.PullRequest)
+
+	paths := DEFAULT_PULL_REQUEST_TEMPLATE_PATHS
+	if path != "" {
+		paths = []string{path}
+	}
+
+	for _, templatePath := range paths {
+		content, err := e.GetGithubClient().DownloadContents(e.GetCtx(), templatePath, t.GetPullRequest().Base, &gh.DownloadContentsOptions{
+			Method: gh.DownloadMethodBranchName,
+		})
+		if err == nil {
+			return string(content), nil
+		}
+
+		if !utils.IsFileNotFound(err) {
+			return "", err
+		}
+	}
+
+	return "", fmt.Errorf("pull request template not found in %s", strings.Join(paths, ", "))
+}
+
+func sectionKey(title string) string {
+	return strings.ToLower(strings.Join(strings.Fields(title), " "))
+}
+
+func normalizeContent(content string) string {
+	return strings.Join(strings.Fields(htmlCommentRegex.ReplaceAllString(content, "")), " ")
+}
+
+func withoutCheckboxes(content string) string {
+	lines := make([]string, 0)
+	for _, line := range strings.Split(content, "\n") {
+		if !checkboxRegex.MatchString(line) {
+			lines = append(lines, line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func countCheckboxes(markdown string) (int, int) {
+	checked, unchecked := 0, 0
+	inCodeBlock := false
+
+	for _, line := range strings.Split(htmlCommentRegex.ReplaceAllString(markdown, ""), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inCodeBlock = !inCodeBlock
+			continue
+		}
+
+		if inCodeBlock {
+			continue
+		}
+
+		if matches := checkboxRegex.FindStringSubmatch(line); matches != nil {
+			if matches[1] == " " {
+				unchecked++
+			} else {
+				checked++
+			}
+		}
+	}
+
+	return checked, unchecked
+}
+
+func toInterfaceSlice(values []string) []interface{} {
+	res := make([]interface{}, len(values))
+	for i, value := range values {
+		res[i] = value
+	}
+	return res
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_prtemplate_test
+
+import (
+	"testing"
+
+	prtemplate "github.com/reviewpad/reviewpad/v4/plugins/aladino/prtemplate"
+	"github.com/stretchr/testify/assert"
+)
+
+const mockedTemplate = `<!-- Thank you for contributing! -->
+## Description
+<!-- Describe your changes. -->
+
+## Related issues
+Closes #
+
+## Changes
+### Breaking changes
+<!-- List the breaking changes, if any. -->
+None
+
+## Screenshots (optional)
+
+## Checklist
+- [ ] Tests were added
+- [ ] Documentation was updated
+`
+
+func TestParseSections(t *testing.T) {
+	sections := prtemplate.ParseSections("Intro\n# Title #\nText\n```\n# not a heading\n```\n## Subtitle\n- [ ] item")
+
+	assert.Equal(t, []*prtemplate.Section{
+		{Title: "Title", Level: 1, Content: "Text\n```\n# not a heading\n```"},
+		{Title: "Subtitle", Level: 2, Content: "- [ ] item"},
+	}, sections)
+}
+
+func TestCheckCompliance(t *testing.T) {
+	tests := map[string]struct {
+		description    string
+		wantCompliance *prtemplate.Compliance
+		wantCompliant  bool
+	}{
+		"when the description follows the template": {
+			description: `## Description
+Adds the new feature.
+
+## Related issues
+Closes #42
+
+## Changes
+### Breaking changes
+Removes the deprecated API.
+
+## Checklist
+- [x] Tests were added
+- [X] Documentation was updated`,
+			wantCompliance: &prtemplate.Compliance{
+				MissingSections:     []string{},
+				EmptySections:       []string{},
+				PlaceholderSections: []string{},
+				CheckedItems:        2,
+				UncheckedItems:      0,
+			},
+			wantCompliant: true,
+		},
+		"when the description is the template": {
+			description: mockedTemplate,
+			wantCompliance: &prtemplate.Compliance{
+				MissingSections:     []string{},
+				EmptySections:       []string{"Description"},
+				PlaceholderSections: []string{"Related issues", "Breaking changes"},
+				CheckedItems:        0,
+				UncheckedItems:      2,
+			},
+			wantCompliant: false,
+		},
+		"when sections are missing": {
+			description: `# description
+Adds the new feature.
+
+- [ ] Tests were added`,
+			wantCompliance: &prtemplate.Compliance{
+				MissingSections:     []string{"Related issues", "Changes", "Breaking changes", "Checklist"},
+				EmptySections:       []string{},
+				PlaceholderSections: []string{},
+				CheckedItems:        0,
+				UncheckedItems:      1,
+			},
+			wantCompliant: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotCompliance := prtemplate.CheckCompliance(mockedTemplate, test.description)
+
+			assert.Equal(t, test.wantCompliance, gotCompliance)
+			assert.Equal(t, test.wantCompliant, gotCompliance.IsCompliant())
+		})
+	}
+}
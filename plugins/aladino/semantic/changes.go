@@ -0,0 +1,223 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_semantic
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/reviewpad/api/go/entities"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	"github.com/reviewpad/reviewpad/v4/lang/aladino"
+)
+
+const (
+	SYMBOL_ADDED    = "added"
+	SYMBOL_REMOVED  = "removed"
+	SYMBOL_MODIFIED = "modified"
+)
+
+// SymbolChange describes how a symbol differs between the base and the head of a pull request.
+type SymbolChange struct {
+	Name              string
+	Type              string
+	Path              string
+	Change            string
+	Public            bool
+	Signature         string
+	PreviousSignature string
+}
+
+// IsFunction reports whether the symbol is a function, a method or a constructor.
+func (c *SymbolChange) IsFunction() bool {
+	switch strings.ToUpper(c.Type) {
+	case entities.AgnosticType_FUNCTION.String(), entities.AgnosticType_METHOD.String(), entities.AgnosticType_CONSTRUCTOR.String():
+		return true
+	}
+	return false
+}
+
+// HasSignatureChange reports whether the symbol was modified and its signature is different.
+func (c *SymbolChange) HasSignatureChange() bool {
+	return c.Change == SYMBOL_MODIFIED && c.Signature != c.PreviousSignature
+}
+
+// IsAPIChange reports whether the change affects the public API.
+// Modifications that only touch the body of a symbol are not API changes.
+func (c *SymbolChange) IsAPIChange() bool {
+	if !c.Public {
+		return false
+	}
+
+	return c.Change != SYMBOL_MODIFIED || c.HasSignatureChange()
+}
+
+func (c *SymbolChange) ToMap() map[string]interface{} {
+	return map[string]interface{}{
+		"name":              c.Name,
+		"type":              c.Type,
+		"path":              c.Path,
+		"change":            c.Change,
+		"public":            c.Public,
+		"signature":         c.Signature,
+		"previousSignature": c.PreviousSignature,
+	}
+}
+
+// GetSymbolChanges computes the symbols added, removed or modified by the pull request.
+func GetSymbolChanges(e aladino.Env) ([]*SymbolChange, error) {
+	patch := e.GetTarget().(codehost.PullRequest).GetPatch()
+
+	baseSymbols, _, err := GetSymbolsFromBaseByPatch(e, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	headSymbols, _, err := GetSymbolsFromHeadByPatch(e, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	return DiffSymbols(baseSymbols, headSymbols), nil
+}
+
+// DiffSymbols matches the symbols from base and head by file and local identifier
+// and returns the differences sorted by path and name.
+func DiffSymbols(base *entities.Symbols, head *entities.Symbols) []*SymbolChange {
+	baseByKey := indexSymbols(base)
+	headByKey := indexSymbols(head)
+
+	changes := make([]*SymbolChange, 0)
+
+	for key, baseSymbol := range baseByKey {
+		headSymbol, ok := headByKey[key]
+		if !ok {
+			change := buildSymbolChange(baseSymbol, SYMBOL_REMOVED)
+			change.PreviousSignature = change.Signature
+			change.Signature = ""
+			changes = append(changes, change)
+			continue
+		}
+
+		if isSameSymbol(baseSymbol, headSymbol) {
+			continue
+		}
+
+		change := buildSymbolChange(headSymbol, SYMBOL_MODIFIED)
+		change.PreviousSignature = getSignature(baseSymbol)
+		change.Public = change.Public || isPublicSymbol(baseSymbol)
+		changes = append(changes, change)
+	}
+
+	for key, headSymbol := range headByKey {
+		if _, ok := baseByKey[key]; !ok {
+			changes = append(changes, buildSymbolChange(headSymbol, SYMBOL_ADDED))
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Path != changes[j].Path {
+			return changes[i].Path < changes[j].Path
+		}
+		if changes[i].Name != changes[j].Name {
+			return changes[i].Name < changes[j].Name
+		}
+		return changes[i].Change < changes[j].Change
+	})
+
+	return changes
+}
+
+func indexSymbols(symbols *entities.Symbols) map[string]*entities.Symbol {
+	res := make(map[string]*entities.Symbol)
+	if symbols == nil {
+		return res
+	}
+
+	for _, symbol := range symbols.Symbols {
+		res[symbolKey(symbol)] = symbol
+	}
+
+	return res
+}
+
+// symbolKey identifies a symbol independently of the commit it was extracted from.
+// The local id does not depend on the code of the symbol, only on its name and type.
+func symbolKey(symbol *entities.Symbol) string {
+	localID := symbol.GetLocalId()
+	if localID == "" {
+		localID = fmt.Sprintf("%s:%s", symbol.GetType(), symbol.GetName())
+	}
+
+	return fmt.Sprintf("%s#%s", symbol.GetDefinition().GetFilePath(), localID)
+}
+
+func isSameSymbol(base *entities.Symbol, head *entities.Symbol) bool {
+	return base.GetId() == head.GetId() &&
+		getSignature(base) == getSignature(head) &&
+		getBody(base) == getBody(head)
+}
+
+func buildSymbolChange(symbol *entities.Symbol, change string) *SymbolChange {
+	return &SymbolChange{
+		Name:      symbol.GetName(),
+		Type:      getSymbolType(symbol),
+		Path:      symbol.GetDefinition().GetFilePath(),
+		Change:    change,
+		Public:    isPublicSymbol(symbol),
+		Signature: getSignature(symbol),
+	}
+}
+
+func getSymbolType(symbol *entities.Symbol) string {
+	if symbol.GetAgnosticType() != entities.AgnosticType_UNSPECIFIED {
+		return strings.ToLower(symbol.GetAgnosticType().String())
+	}
+
+	return strings.ToLower(symbol.GetType())
+}
+
+func getSignature(symbol *entities.Symbol) string {
+	if signature := symbol.GetSignature().GetCode(); signature != "" {
+		return strings.TrimSpace(signature)
+	}
+
+	return strings.TrimSpace(symbol.GetDefinition().GetMainDefinition().GetSignature().GetCode())
+}
+
+func getBody(symbol *entities.Symbol) string {
+	return strings.TrimSpace(symbol.GetDefinition().GetMainDefinition().GetBody().GetCode())
+}
+
+// isPublicSymbol applies the visibility rules of the language of the file the symbol is defined in.
+// Go relies on the capitalization of the name while most other languages rely on modifiers
+// or on a leading underscore to hide a symbol.
+func isPublicSymbol(symbol *entities.Symbol) bool {
+	name := symbol.GetName()
+	if name == "" {
+		return false
+	}
+
+	if filepath.Ext(symbol.GetDefinition().GetFilePath()) == ".go" {
+		firstRune, _ := utf8.DecodeRuneInString(name)
+		return unicode.IsUpper(firstRune)
+	}
+
+	if strings.HasPrefix(name, "_") || strings.HasPrefix(name, "#") {
+		return false
+	}
+
+	for _, modifier := range strings.Fields(getSignature(symbol)) {
+		if modifier == "private" || modifier == "protected" || modifier == "internal" {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,191 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_semantic
+
+import (
+	"testing"
+
+	"github.com/reviewpad/api/go/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildMockSymbol(id, name, path, signature string, agnosticType entities.AgnosticType) *entities.Symbol {
+	return &entities.Symbol{
+		Id:           id,
+		Name:         name,
+		LocalId:      name,
+		AgnosticType: agnosticType,
+		Definition: &entities.Definition{
+			FilePath: path,
+		},
+		Signature: &entities.SymbolDocumentation{
+			Code: signature,
+		},
+	}
+}
+
+func buildMockSymbols(symbols ...*entities.Symbol) *entities.Symbols {
+	res := &entities.Symbols{
+		Files:   map[string]*entities.File{},
+		Symbols: map[string]*entities.Symbol{},
+	}
+
+	for _, symbol := range symbols {
+		res.Symbols[symbol.Id] = symbol
+	}
+
+	return res
+}
+
+func TestDiffSymbols(t *testing.T) {
+	tests := map[string]struct {
+		base        *entities.Symbols
+		head        *entities.Symbols
+		wantChanges []*SymbolChange
+	}{
+		"when nothing changed": {
+			base:        buildMockSymbols(buildMockSymbol("1", "Crawl", "crawler.go", "func Crawl(url string)", entities.AgnosticType_FUNCTION)),
+			head:        buildMockSymbols(buildMockSymbol("1", "Crawl", "crawler.go", "func Crawl(url string)", entities.AgnosticType_FUNCTION)),
+			wantChanges: []*SymbolChange{},
+		},
+		"when symbol is added": {
+			base: buildMockSymbols(),
+			head: buildMockSymbols(buildMockSymbol("1", "Crawl", "crawler.go", "func Crawl(url string)", entities.AgnosticType_FUNCTION)),
+			wantChanges: []*SymbolChange{
+				{
+					Name:      "Crawl",
+					Type:      "function",
+					Path:      "crawler.go",
+					Change:    SYMBOL_ADDED,
+					Public:    true,
+					Signature: "func Crawl(url string)",
+				},
+			},
+		},
+		"when symbol is removed": {
+			base: buildMockSymbols(buildMockSymbol("1", "crawl", "crawler.go", "func crawl(url string)", entities.AgnosticType_FUNCTION)),
+			head: buildMockSymbols(),
+			wantChanges: []*SymbolChange{
+				{
+					Name:              "crawl",
+					Type:              "function",
+					Path:              "crawler.go",
+					Change:            SYMBOL_REMOVED,
+					Public:            false,
+					PreviousSignature: "func crawl(url string)",
+				},
+			},
+		},
+		"when symbol signature is modified": {
+			base: buildMockSymbols(buildMockSymbol("1", "Crawl", "crawler.go", "func Crawl(url string)", entities.AgnosticType_FUNCTION)),
+			head: buildMockSymbols(buildMockSymbol("2", "Crawl", "crawler.go", "func Crawl(url string, depth int)", entities.AgnosticType_FUNCTION)),
+			wantChanges: []*SymbolChange{
+				{
+					Name:              "Crawl",
+					Type:              "function",
+					Path:              "crawler.go",
+					Change:            SYMBOL_MODIFIED,
+					Public:            true,
+					Signature:         "func Crawl(url string, depth int)",
+					PreviousSignature: "func Crawl(url string)",
+				},
+			},
+		},
+		"when symbols with the same name are in different files": {
+			base: buildMockSymbols(buildMockSymbol("1", "Crawl", "a.go", "func Crawl()", entities.AgnosticType_FUNCTION)),
+			head: buildMockSymbols(buildMockSymbol("2", "Crawl", "b.go", "func Crawl()", entities.AgnosticType_FUNCTION)),
+			wantChanges: []*SymbolChange{
+				{
+					Name:              "Crawl",
+					Type:              "function",
+					Path:              "a.go",
+					Change:            SYMBOL_REMOVED,
+					Public:            true,
+					PreviousSignature: "func Crawl()",
+				},
+				{
+					Name:      "Crawl",
+					Type:      "function",
+					Path:      "b.go",
+					Change:    SYMBOL_ADDED,
+					Public:    true,
+					Signature: "func Crawl()",
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotChanges := DiffSymbols(test.base, test.head)
+
+			assert.Equal(t, test.wantChanges, gotChanges)
+		})
+	}
+}
+
+func TestSymbolChange_IsAPIChange(t *testing.T) {
+	tests := map[string]struct {
+		change  *SymbolChange
+		wantVal bool
+	}{
+		"when private symbol is removed": {
+			change:  &SymbolChange{Change: SYMBOL_REMOVED, Public: false},
+			wantVal: false,
+		},
+		"when public symbol is removed": {
+			change:  &SymbolChange{Change: SYMBOL_REMOVED, Public: true},
+			wantVal: true,
+		},
+		"when public symbol body is modified": {
+			change:  &SymbolChange{Change: SYMBOL_MODIFIED, Public: true, Signature: "func A()", PreviousSignature: "func A()"},
+			wantVal: false,
+		},
+		"when public symbol signature is modified": {
+			change:  &SymbolChange{Change: SYMBOL_MODIFIED, Public: true, Signature: "func A(b int)", PreviousSignature: "func A()"},
+			wantVal: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.wantVal, test.change.IsAPIChange())
+		})
+	}
+}
+
+func TestIsPublicSymbol(t *testing.T) {
+	tests := map[string]struct {
+		symbol  *entities.Symbol
+		wantVal bool
+	}{
+		"when go symbol is exported": {
+			symbol:  buildMockSymbol("1", "Crawl", "crawler.go", "func Crawl()", entities.AgnosticType_FUNCTION),
+			wantVal: true,
+		},
+		"when go symbol is not exported": {
+			symbol:  buildMockSymbol("1", "crawl", "crawler.go", "func crawl()", entities.AgnosticType_FUNCTION),
+			wantVal: false,
+		},
+		"when java method is private": {
+			symbol:  buildMockSymbol("1", "crawl", "Crawler.java", "private void crawl()", entities.AgnosticType_METHOD),
+			wantVal: false,
+		},
+		"when python function starts with underscore": {
+			symbol:  buildMockSymbol("1", "_crawl", "crawler.py", "def _crawl():", entities.AgnosticType_FUNCTION),
+			wantVal: false,
+		},
+		"when typescript function is public": {
+			symbol:  buildMockSymbol("1", "crawl", "crawler.ts", "export function crawl()", entities.AgnosticType_FUNCTION),
+			wantVal: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.wantVal, isPublicSymbol(test.symbol))
+		})
+	}
+}
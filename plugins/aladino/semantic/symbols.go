@@ -14,20 +14,23 @@ import (
 	api "github.com/reviewpad/api/go/services"
 	"github.com/reviewpad/reviewpad/v4/codehost"
 	"github.com/reviewpad/reviewpad/v4/codehost/github"
-	"github.com/reviewpad/reviewpad/v4/codehost/github/target"
 	"github.com/reviewpad/reviewpad/v4/lang/aladino"
 	plugins_aladino_services "github.com/reviewpad/reviewpad/v4/plugins/aladino/services"
 )
 
 func GetSymbolsFromPatch(e aladino.Env) (map[string]*entities.Symbols, error) {
-	pullRequest := e.GetTarget().(*target.PullRequestTarget)
+	if e.GetGithubClient() == nil {
+		return nil, codehost.ErrNotSupported
+	}
+
+	pullRequest := e.GetTarget().(codehost.PullRequest)
 
 	res := make(map[string]*entities.Symbols)
 
-	head := pullRequest.PullRequest.GetHead()
-	base := pullRequest.PullRequest.GetBase()
+	head := pullRequest.GetPullRequest().GetHead()
+	base := pullRequest.GetPullRequest().GetBase()
 	url := head.Repo.Uri
-	patch := pullRequest.Patch
+	patch := pullRequest.GetPatch()
 
 	lastCommit := head.Sha
 
@@ -78,9 +81,9 @@ func GetSymbolsFromPatch(e aladino.Env) (map[string]*entities.Symbols, error) {
 	return res, nil
 }
 
-func GetSymbolsFromHeadByPatch(e aladino.Env, patch target.Patch) (*entities.Symbols, map[string]string, error) {
-	pullRequest := e.GetTarget().(*target.PullRequestTarget)
-	head := pullRequest.PullRequest.GetHead()
+func GetSymbolsFromHeadByPatch(e aladino.Env, patch codehost.Patch) (*entities.Symbols, map[string]string, error) {
+	pullRequest := e.GetTarget().(codehost.PullRequest)
+	head := pullRequest.GetPullRequest().GetHead()
 
 	res := &entities.Symbols{
 		Files:   make(map[string]*entities.File),
@@ -107,9 +110,9 @@ func GetSymbolsFromHeadByPatch(e aladino.Env, patch target.Patch) (*entities.Sym
 	return res, files, nil
 }
 
-func GetSymbolsFromBaseByPatch(e aladino.Env, patch target.Patch) (*entities.Symbols, map[string]string, error) {
-	pullRequest := e.GetTarget().(*target.PullRequestTarget)
-	base := pullRequest.PullRequest.GetBase()
+func GetSymbolsFromBaseByPatch(e aladino.Env, patch codehost.Patch) (*entities.Symbols, map[string]string, error) {
+	pullRequest := e.GetTarget().(codehost.PullRequest)
+	base := pullRequest.GetPullRequest().GetBase()
 
 	res := &entities.Symbols{
 		Files:   make(map[string]*entities.File),
@@ -147,6 +150,10 @@ func joinSymbols(current *entities.Symbols, new *entities.Symbols) {
 }
 
 func GetSymbolsFromFileInBranch(e aladino.Env, commitFile *codehost.File, branch *pbc.Branch) (*entities.Symbols, string, error) {
+	if e.GetGithubClient() == nil {
+		return nil, "", codehost.ErrNotSupported
+	}
+
 	fp := commitFile.Repr.GetFilename()
 
 	blob, err := e.GetGithubClient().DownloadContents(e.GetCtx(), fp, branch, &github.DownloadContentsOptions{
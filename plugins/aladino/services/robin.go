@@ -5,7 +5,9 @@
 package plugins_aladino_services
 
 import (
+	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/reviewpad/api/go/clients"
 	"github.com/reviewpad/api/go/services"
@@ -15,14 +17,132 @@ import (
 const (
 	ROBIN_SERVICE_KEY      = "robin"
 	ROBIN_SERVICE_ENDPOINT = "INPUT_ROBIN_SERVICE"
+	ROBIN_SERVICE_BACKEND  = "INPUT_ROBIN_BACKEND"
+	ROBIN_OPENAI_URL       = "INPUT_ROBIN_OPENAI_URL"
+	ROBIN_OPENAI_API_KEY   = "INPUT_ROBIN_OPENAI_API_KEY"
+	ROBIN_MODEL            = "INPUT_ROBIN_MODEL"
+	ROBIN_MAX_TOKENS       = "INPUT_ROBIN_MAX_TOKENS"
+	ROBIN_TOKEN_BUDGET     = "INPUT_ROBIN_TOKEN_BUDGET"
+	ROBIN_PROMPT_TEMPLATES = "INPUT_ROBIN_PROMPT_TEMPLATES"
 )
 
+// Robin backends that can be selected with the ROBIN_SERVICE_BACKEND environment variable.
+const (
+	// ROBIN_BACKEND_REMOTE uses the Robin service available at ROBIN_SERVICE_ENDPOINT.
+	ROBIN_BACKEND_REMOTE = "remote"
+	// ROBIN_BACKEND_OPENAI sends the prompts to the OpenAI compatible API available at ROBIN_OPENAI_URL.
+	ROBIN_BACKEND_OPENAI = "openai"
+	// ROBIN_BACKEND_FAKE replies with deterministic answers and does not send the prompts anywhere.
+	ROBIN_BACKEND_FAKE = "fake"
+)
+
+// RobinConfig holds the configuration of the Robin backends.
+type RobinConfig struct {
+	Backend             string
+	Endpoint            string
+	OpenAIURL           string
+	OpenAIAPIKey        string
+	Model               string
+	MaxTokens           int
+	TokenBudget         int
+	PromptTemplatesPath string
+	// GitHubAPIURL is the URL of the GitHub API the LLM backends load the pull requests and issues from.
+	GitHubAPIURL string
+}
+
 func NewRobinServiceWithConfig(endpoint string) (services.RobinClient, *grpc.ClientConn, error) {
 	return clients.NewRobinClient(endpoint)
 }
 
-func NewRobinService() (services.RobinClient, *grpc.ClientConn, error) {
-	robinEndpoint := os.Getenv(ROBIN_SERVICE_ENDPOINT)
+// NewRobinServiceWithBackend creates the client of the configured Robin backend.
+// The returned connection is nil when the backend does not rely on the Robin service.
+func NewRobinServiceWithBackend(config *RobinConfig) (services.RobinClient, *grpc.ClientConn, error) {
+	var backend LLMBackend
+	switch config.Backend {
+	case "", ROBIN_BACKEND_REMOTE:
+		return NewRobinServiceWithConfig(config.Endpoint)
+	case ROBIN_BACKEND_OPENAI:
+		backend = NewOpenAIBackend(config.OpenAIURL, config.OpenAIAPIKey)
+	case ROBIN_BACKEND_FAKE:
+		backend = NewFakeLLMBackend("")
+	default:
+		return nil, nil, fmt.Errorf("unknown robin backend %q", config.Backend)
+	}
+
+	templates := map[string]string{}
+	if config.PromptTemplatesPath != "" {
+		var err error
+		templates, err = LoadRobinPromptTemplates(config.PromptTemplatesPath)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	client, err := NewLLMRobinClient(backend, templates)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client.Model = config.Model
+	client.MaxTokens = config.MaxTokens
+	client.TokenBudget = config.TokenBudget
+	client.GitHubAPIURL = config.GitHubAPIURL
+
+	return client, nil, nil
+}
+
+// NewRobinService creates the Robin client configured by the environment.
+// The LLM backends load the pull requests and issues from the GitHub API at githubAPIURL.
+func NewRobinService(githubAPIURL string) (services.RobinClient, *grpc.ClientConn, error) {
+	config, err := robinConfigFromEnv()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	config.GitHubAPIURL = githubAPIURL
+
+	return NewRobinServiceWithBackend(config)
+}
+
+// IsRemoteRobinBackend reports whether the configured Robin backend requires the Robin service.
+func IsRemoteRobinBackend() bool {
+	backend := os.Getenv(ROBIN_SERVICE_BACKEND)
+	return backend == "" || backend == ROBIN_BACKEND_REMOTE
+}
+
+func robinConfigFromEnv() (*RobinConfig, error) {
+	maxTokens, err := intFromEnv(ROBIN_MAX_TOKENS)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenBudget, err := intFromEnv(ROBIN_TOKEN_BUDGET)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RobinConfig{
+		Backend:             os.Getenv(ROBIN_SERVICE_BACKEND),
+		Endpoint:            os.Getenv(ROBIN_SERVICE_ENDPOINT),
+		OpenAIURL:           os.Getenv(ROBIN_OPENAI_URL),
+		OpenAIAPIKey:        os.Getenv(ROBIN_OPENAI_API_KEY),
+		Model:               os.Getenv(ROBIN_MODEL),
+		MaxTokens:           maxTokens,
+		TokenBudget:         tokenBudget,
+		PromptTemplatesPath: os.Getenv(ROBIN_PROMPT_TEMPLATES),
+	}, nil
+}
+
+func intFromEnv(name string) (int, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return 0, nil
+	}
+
+	res, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be an integer: %w", name, err)
+	}
 
-	return NewRobinServiceWithConfig(robinEndpoint)
+	return res, nil
 }
@@ -0,0 +1,57 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+)
+
+// FakeLLMBackend is a deterministic LLMBackend meant for tests.
+// It records every request and replies with Reply or, when Reply is empty,
+// with a reply derived from the model and the prompts.
+type FakeLLMBackend struct {
+	Reply    string
+	Err      error
+	mu       sync.Mutex
+	requests []*LLMRequest
+}
+
+func NewFakeLLMBackend(reply string) *FakeLLMBackend {
+	return &FakeLLMBackend{Reply: reply}
+}
+
+func (b *FakeLLMBackend) Complete(ctx context.Context, req *LLMRequest) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.requests = append(b.requests, req)
+
+	if b.Err != nil {
+		return "", b.Err
+	}
+
+	if b.Reply != "" {
+		return b.Reply, nil
+	}
+
+	return FakeLLMReply(req), nil
+}
+
+// Requests returns the requests received by the backend in order.
+func (b *FakeLLMBackend) Requests() []*LLMRequest {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]*LLMRequest{}, b.requests...)
+}
+
+// FakeLLMReply is the reply given by FakeLLMBackend when no reply is configured.
+func FakeLLMReply(req *LLMRequest) string {
+	hash := sha256.Sum256([]byte(req.Model + "\x00" + req.SystemPrompt + "\x00" + req.UserPrompt))
+	return "fake reply from " + req.Model + " (" + hex.EncodeToString(hash[:4]) + ")"
+}
@@ -0,0 +1,448 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/api/go/entities"
+	"github.com/reviewpad/api/go/services"
+	gh "github.com/reviewpad/reviewpad/v4/codehost/github"
+	"google.golang.org/grpc"
+	"gopkg.in/yaml.v3"
+)
+
+// Prompt templates used by the LLM Robin client.
+// Each template can be overridden with the file set in ROBIN_PROMPT_TEMPLATES.
+const (
+	ROBIN_TEMPLATE_SYSTEM             = "system"
+	ROBIN_TEMPLATE_PROMPT             = "prompt"
+	ROBIN_TEMPLATE_SUMMARIZE          = "summarize"
+	ROBIN_TEMPLATE_SUMMARIZE_EXTENDED = "summarizeExtended"
+	ROBIN_TEMPLATE_EXPLAIN            = "explain"
+	ROBIN_TEMPLATE_CHAT               = "chat"
+)
+
+// ROBIN_DEFAULT_MODEL is used when neither the request nor the client set a model.
+const ROBIN_DEFAULT_MODEL = "gpt-4"
+
+// ROBIN_CHARS_PER_TOKEN is the rough number of characters per token used to enforce the token budget.
+const ROBIN_CHARS_PER_TOKEN = 4
+
+const robinTruncatedMarker = "\n[... truncated ...]\n"
+
+var defaultRobinPromptTemplates = map[string]string{
+	ROBIN_TEMPLATE_SYSTEM: `You are Robin, an assistant that helps developers review pull requests and triage issues. Be concise and precise.`,
+	ROBIN_TEMPLATE_PROMPT: `{{ template "target" . }}
+
+{{ .Prompt }}`,
+	ROBIN_TEMPLATE_SUMMARIZE: `{{ template "target" . }}
+
+Summarize the {{ .Target.Kind }} above in a short paragraph.`,
+	ROBIN_TEMPLATE_SUMMARIZE_EXTENDED: `{{ template "target" . }}
+
+Summarize the {{ .Target.Kind }} above. Start with a short paragraph and follow with a bullet list of the main changes.`,
+	ROBIN_TEMPLATE_EXPLAIN: `{{ template "target" . }}
+
+Explain the following comment in the context of the {{ .Target.Kind }} above:
+
+{{ .Comment }}`,
+	ROBIN_TEMPLATE_CHAT: `{{ template "target" . }}
+
+Reply to the last comment of the conversation above.`,
+}
+
+const robinTargetTemplate = `{{ define "target" -}}
+{{ .Target.Kind }} #{{ .Target.Number }} in {{ .Target.Owner }}/{{ .Target.Repo }}
+Title: {{ .Target.Title }}
+Description:
+{{ .Target.Description }}
+{{- if .Target.Diff }}
+
+Diff:
+{{ .Target.Diff }}
+{{- end }}
+{{- if .Target.Comments }}
+
+Comments:
+{{ range .Target.Comments }}@{{ .Author }}: {{ .Body }}
+{{ end }}
+{{- end }}
+{{- end }}`
+
+// LLMRequest is a single completion request sent to an LLM backend.
+type LLMRequest struct {
+	Model        string
+	SystemPrompt string
+	UserPrompt   string
+	MaxTokens    int
+}
+
+// LLMBackend is implemented by the large language models that can answer the Robin requests.
+type LLMBackend interface {
+	Complete(ctx context.Context, req *LLMRequest) (string, error)
+}
+
+// RobinTarget holds the information of a pull request or issue made available to the prompt templates.
+type RobinTarget struct {
+	Kind        string
+	Owner       string
+	Repo        string
+	Number      int
+	Title       string
+	Description string
+	Diff        string
+	Comments    []*RobinComment
+}
+
+// RobinComment is a comment of the target of a Robin request.
+type RobinComment struct {
+	ID     string
+	NodeID string
+	Author string
+	Body   string
+}
+
+// RobinTargetLoader loads the information of the target entity of a Robin request from the GitHub API at githubAPIURL.
+type RobinTargetLoader func(ctx context.Context, githubAPIURL, token string, target *entities.TargetEntity) (*RobinTarget, error)
+
+type robinPromptData struct {
+	Target  *RobinTarget
+	Prompt  string
+	Comment string
+}
+
+// LLMRobinClient implements services.RobinClient on top of an LLMBackend.
+// Unlike the Robin service, the pull request and issue information is loaded locally
+// and is only sent to the configured backend.
+type LLMRobinClient struct {
+	Backend LLMBackend
+	// Model overrides the model requested by the actions when set.
+	Model string
+	// MaxTokens is the maximum number of tokens of each reply.
+	MaxTokens int
+	// TokenBudget is the maximum number of tokens of each prompt.
+	// The diff and then the prompt itself are truncated to fit in the budget.
+	TokenBudget int
+	// GitHubAPIURL is the URL of the GitHub API the targets are loaded from.
+	// When empty, the targets are loaded from github.com.
+	GitHubAPIURL string
+	LoadTarget   RobinTargetLoader
+	templates    *template.Template
+}
+
+// NewLLMRobinClient creates a Robin client with the default prompt templates overridden by templates.
+func NewLLMRobinClient(backend LLMBackend, templates map[string]string) (*LLMRobinClient, error) {
+	tmpl, err := template.New("robin").Parse(robinTargetTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, text := range defaultRobinPromptTemplates {
+		if override, ok := templates[name]; ok {
+			text = override
+		}
+
+		if _, err := tmpl.New(name).Parse(text); err != nil {
+			return nil, fmt.Errorf("invalid robin prompt template %q: %w", name, err)
+		}
+	}
+
+	for name := range templates {
+		if _, ok := defaultRobinPromptTemplates[name]; !ok {
+			return nil, fmt.Errorf("unknown robin prompt template %q", name)
+		}
+	}
+
+	return &LLMRobinClient{
+		Backend:    backend,
+		LoadTarget: LoadRobinTargetFromGithub,
+		templates:  tmpl,
+	}, nil
+}
+
+// LoadRobinPromptTemplates reads the prompt templates from a YAML file mapping template names to templates.
+func LoadRobinPromptTemplates(path string) (map[string]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make(map[string]string)
+	if err := yaml.Unmarshal(content, &templates); err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+func (c *LLMRobinClient) ChatInCodeHost(ctx context.Context, in *services.ChatInCodeHostRequest, opts ...grpc.CallOption) (*services.ChatInCodeHostReply, error) {
+	reply, err := c.completeForTarget(ctx, in.Token, in.Target, ROBIN_TEMPLATE_CHAT, "", &robinPromptData{}, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &services.ChatInCodeHostReply{Reply: reply}, nil
+}
+
+func (c *LLMRobinClient) Explain(ctx context.Context, in *services.ExplainRequest, opts ...grpc.CallOption) (*services.ExplainReply, error) {
+	target, err := c.LoadTarget(ctx, c.GitHubAPIURL, in.Token, in.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	var comment *RobinComment
+	for _, targetComment := range target.Comments {
+		if targetComment.ID == in.CommentId || targetComment.NodeID == in.CommentId {
+			comment = targetComment
+			break
+		}
+	}
+
+	if comment == nil {
+		return nil, fmt.Errorf("comment %s not found", in.CommentId)
+	}
+
+	target.Comments = nil
+	data := &robinPromptData{Target: target, Comment: comment.Body}
+	explanation, err := c.complete(ctx, ROBIN_TEMPLATE_EXPLAIN, in.Model, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &services.ExplainReply{Explanation: explanation}, nil
+}
+
+func (c *LLMRobinClient) Prompt(ctx context.Context, in *services.PromptRequest, opts ...grpc.CallOption) (*services.PromptReply, error) {
+	reply, err := c.completeForTarget(ctx, in.Token, in.Target, ROBIN_TEMPLATE_PROMPT, in.Model, &robinPromptData{Prompt: in.Prompt}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &services.PromptReply{Reply: reply}, nil
+}
+
+func (c *LLMRobinClient) RawPrompt(ctx context.Context, in *services.RawPromptRequest, opts ...grpc.CallOption) (*services.PromptReply, error) {
+	reply, err := c.Backend.Complete(ctx, &LLMRequest{
+		Model:        c.resolveModel(in.Model),
+		SystemPrompt: in.SystemPrompt,
+		UserPrompt:   c.truncate(in.UserPrompt, len(in.SystemPrompt)),
+		MaxTokens:    c.MaxTokens,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &services.PromptReply{Reply: reply}, nil
+}
+
+func (c *LLMRobinClient) Summarize(ctx context.Context, in *services.SummarizeRequest, opts ...grpc.CallOption) (*services.SummarizeReply, error) {
+	templateName := ROBIN_TEMPLATE_SUMMARIZE
+	if in.Extended {
+		templateName = ROBIN_TEMPLATE_SUMMARIZE_EXTENDED
+	}
+
+	summary, err := c.completeForTarget(ctx, in.Token, in.Target, templateName, in.Model, &robinPromptData{}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &services.SummarizeReply{Summary: summary}, nil
+}
+
+func (c *LLMRobinClient) completeForTarget(ctx context.Context, token string, targetEntity *entities.TargetEntity, templateName, model string, data *robinPromptData, withComments bool) (string, error) {
+	target, err := c.LoadTarget(ctx, c.GitHubAPIURL, token, targetEntity)
+	if err != nil {
+		return "", err
+	}
+
+	if !withComments {
+		target.Comments = nil
+	}
+
+	data.Target = target
+
+	return c.complete(ctx, templateName, model, data)
+}
+
+func (c *LLMRobinClient) complete(ctx context.Context, templateName, model string, data *robinPromptData) (string, error) {
+	systemPrompt, err := c.render(ROBIN_TEMPLATE_SYSTEM, data)
+	if err != nil {
+		return "", err
+	}
+
+	userPrompt, err := c.render(templateName, data)
+	if err != nil {
+		return "", err
+	}
+
+	// The diff is usually what exceeds the budget so it is the first to be truncated.
+	if excess := c.excessChars(systemPrompt, userPrompt); excess > 0 && data.Target.Diff != "" {
+		diff := data.Target.Diff
+		data.Target.Diff = truncateText(diff, len(diff)-excess)
+
+		userPrompt, err = c.render(templateName, data)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return c.Backend.Complete(ctx, &LLMRequest{
+		Model:        c.resolveModel(model),
+		SystemPrompt: systemPrompt,
+		UserPrompt:   c.truncate(userPrompt, len(systemPrompt)),
+		MaxTokens:    c.MaxTokens,
+	})
+}
+
+func (c *LLMRobinClient) render(templateName string, data *robinPromptData) (string, error) {
+	var buf bytes.Buffer
+	if err := c.templates.ExecuteTemplate(&buf, templateName, data); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// resolveModel maps the models used by the Robin service (e.g. openai-gpt-4) to the backend models.
+func (c *LLMRobinClient) resolveModel(model string) string {
+	if c.Model != "" {
+		return c.Model
+	}
+
+	if model == "" {
+		return ROBIN_DEFAULT_MODEL
+	}
+
+	return strings.TrimPrefix(model, "openai-")
+}
+
+func (c *LLMRobinClient) excessChars(systemPrompt, userPrompt string) int {
+	if c.TokenBudget <= 0 {
+		return 0
+	}
+
+	return len(systemPrompt) + len(userPrompt) - c.TokenBudget*ROBIN_CHARS_PER_TOKEN
+}
+
+func (c *LLMRobinClient) truncate(userPrompt string, systemPromptLength int) string {
+	if c.TokenBudget <= 0 {
+		return userPrompt
+	}
+
+	return truncateText(userPrompt, c.TokenBudget*ROBIN_CHARS_PER_TOKEN-systemPromptLength)
+}
+
+func truncateText(text string, maxLength int) string {
+	if len(text) <= maxLength {
+		return text
+	}
+
+	maxLength -= len(robinTruncatedMarker)
+	if maxLength <= 0 {
+		return strings.TrimSpace(robinTruncatedMarker)
+	}
+
+	// Avoid cutting a multi-byte character in half.
+	for maxLength > 0 && !isRuneStart(text[maxLength]) {
+		maxLength--
+	}
+
+	return text[:maxLength] + robinTruncatedMarker
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
+
+// LoadRobinTargetFromGithub loads the title, description, diff and comments of the target from the GitHub API at githubAPIURL.
+func LoadRobinTargetFromGithub(ctx context.Context, githubAPIURL, token string, target *entities.TargetEntity) (*RobinTarget, error) {
+	githubClient, err := gh.NewGithubClientFromTokenWithBaseURL(ctx, token, githubAPIURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return loadRobinTarget(ctx, githubClient, target)
+}
+
+func loadRobinTarget(ctx context.Context, githubClient *gh.GithubClient, target *entities.TargetEntity) (*RobinTarget, error) {
+	if target == nil {
+		return nil, fmt.Errorf("robin target is required")
+	}
+
+	owner := target.GetOwner()
+	repo := target.GetRepo()
+	number := int(target.GetNumber())
+
+	res := &RobinTarget{
+		Owner:  owner,
+		Repo:   repo,
+		Number: number,
+	}
+
+	switch target.GetKind() {
+	case entities.TargetEntityKind_PULL_REQUEST:
+		pullRequest, _, err := githubClient.GetPullRequest(ctx, owner, repo, number)
+		if err != nil {
+			return nil, err
+		}
+
+		files, err := githubClient.GetPullRequestFiles(ctx, owner, repo, number)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Kind = "pull request"
+		res.Title = pullRequest.GetTitle()
+		res.Description = pullRequest.GetBody()
+		res.Diff = buildRobinDiff(files)
+	case entities.TargetEntityKind_ISSUE:
+		issue, _, err := githubClient.GetIssue(ctx, owner, repo, number)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Kind = "issue"
+		res.Title = issue.GetTitle()
+		res.Description = issue.GetBody()
+	default:
+		return nil, fmt.Errorf("robin does not support %s targets", target.GetKind())
+	}
+
+	comments, err := githubClient.GetComments(ctx, owner, repo, number, &github.IssueListCommentsOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, comment := range comments {
+		res.Comments = append(res.Comments, &RobinComment{
+			ID:     fmt.Sprint(comment.GetID()),
+			NodeID: comment.GetNodeID(),
+			Author: comment.GetUser().GetLogin(),
+			Body:   comment.GetBody(),
+		})
+	}
+
+	return res, nil
+}
+
+func buildRobinDiff(files []*github.CommitFile) string {
+	var diff strings.Builder
+	for _, file := range files {
+		diff.WriteString(fmt.Sprintf("--- %s\n", file.GetFilename()))
+		if file.GetPatch() != "" {
+			diff.WriteString(file.GetPatch())
+			diff.WriteString("\n")
+		}
+	}
+
+	return strings.TrimSpace(diff.String())
+}
@@ -0,0 +1,306 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_services_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/reviewpad/api/go/entities"
+	"github.com/reviewpad/api/go/services"
+	plugins_aladino_services "github.com/reviewpad/reviewpad/v4/plugins/aladino/services"
+	"github.com/stretchr/testify/assert"
+)
+
+var mockedRobinTargetEntity = &entities.TargetEntity{
+	Kind:   entities.TargetEntityKind_PULL_REQUEST,
+	Owner:  "foobar",
+	Repo:   "default-mock-repo",
+	Number: 6,
+}
+
+func mockRobinTargetLoader(diff string) plugins_aladino_services.RobinTargetLoader {
+	return func(ctx context.Context, githubAPIURL, token string, target *entities.TargetEntity) (*plugins_aladino_services.RobinTarget, error) {
+		return &plugins_aladino_services.RobinTarget{
+			Kind:        "pull request",
+			Owner:       target.Owner,
+			Repo:        target.Repo,
+			Number:      int(target.Number),
+			Title:       "Amazing new feature",
+			Description: "Please pull these awesome changes in!",
+			Diff:        diff,
+			Comments: []*plugins_aladino_services.RobinComment{
+				{ID: "1", NodeID: "IC_1", Author: "john", Body: "Why is this needed?"},
+			},
+		}, nil
+	}
+}
+
+func mockLLMRobinClient(t *testing.T, backend plugins_aladino_services.LLMBackend, templates map[string]string) *plugins_aladino_services.LLMRobinClient {
+	client, err := plugins_aladino_services.NewLLMRobinClient(backend, templates)
+	assert.Nil(t, err)
+
+	client.LoadTarget = mockRobinTargetLoader("--- main.go\n+func main() {}")
+
+	return client
+}
+
+func TestLLMRobinClient_Prompt(t *testing.T) {
+	backend := plugins_aladino_services.NewFakeLLMBackend("")
+	client := mockLLMRobinClient(t, backend, nil)
+
+	reply, err := client.Prompt(context.Background(), &services.PromptRequest{
+		Token:  "token",
+		Target: mockedRobinTargetEntity,
+		Prompt: "Is this pull request ready?",
+		Model:  "openai-gpt-4",
+	})
+
+	assert.Nil(t, err)
+
+	requests := backend.Requests()
+	assert.Len(t, requests, 1)
+	assert.Equal(t, plugins_aladino_services.FakeLLMReply(requests[0]), reply.Reply)
+	assert.Equal(t, "gpt-4", requests[0].Model)
+	assert.Contains(t, requests[0].SystemPrompt, "Robin")
+	assert.Contains(t, requests[0].UserPrompt, "pull request #6 in foobar/default-mock-repo")
+	assert.Contains(t, requests[0].UserPrompt, "Title: Amazing new feature")
+	assert.Contains(t, requests[0].UserPrompt, "+func main() {}")
+	assert.NotContains(t, requests[0].UserPrompt, "Why is this needed?")
+	assert.True(t, strings.HasSuffix(requests[0].UserPrompt, "Is this pull request ready?"))
+}
+
+func TestLLMRobinClient_Prompt_IsDeterministic(t *testing.T) {
+	req := &services.PromptRequest{Target: mockedRobinTargetEntity, Prompt: "hello", Model: "openai-gpt-4"}
+
+	first, err := mockLLMRobinClient(t, plugins_aladino_services.NewFakeLLMBackend(""), nil).Prompt(context.Background(), req)
+	assert.Nil(t, err)
+
+	second, err := mockLLMRobinClient(t, plugins_aladino_services.NewFakeLLMBackend(""), nil).Prompt(context.Background(), req)
+	assert.Nil(t, err)
+
+	assert.Equal(t, first.Reply, second.Reply)
+}
+
+func TestLLMRobinClient_Summarize(t *testing.T) {
+	tests := map[string]struct {
+		extended     bool
+		templates    map[string]string
+		wantContains string
+	}{
+		"default": {
+			wantContains: "Summarize the pull request above in a short paragraph.",
+		},
+		"extended": {
+			extended:     true,
+			wantContains: "follow with a bullet list of the main changes",
+		},
+		"custom template": {
+			templates: map[string]string{
+				plugins_aladino_services.ROBIN_TEMPLATE_SUMMARIZE: "Summarize {{ .Target.Title }} in one sentence.",
+			},
+			wantContains: "Summarize Amazing new feature in one sentence.",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			backend := plugins_aladino_services.NewFakeLLMBackend("summary")
+			client := mockLLMRobinClient(t, backend, test.templates)
+
+			reply, err := client.Summarize(context.Background(), &services.SummarizeRequest{
+				Target:   mockedRobinTargetEntity,
+				Extended: test.extended,
+				Model:    "openai-gpt-3.5-turbo",
+			})
+
+			assert.Nil(t, err)
+			assert.Equal(t, "summary", reply.Summary)
+			assert.Equal(t, "gpt-3.5-turbo", backend.Requests()[0].Model)
+			assert.Contains(t, backend.Requests()[0].UserPrompt, test.wantContains)
+		})
+	}
+}
+
+func TestLLMRobinClient_Explain(t *testing.T) {
+	backend := plugins_aladino_services.NewFakeLLMBackend("explanation")
+	client := mockLLMRobinClient(t, backend, nil)
+
+	reply, err := client.Explain(context.Background(), &services.ExplainRequest{
+		Target:    mockedRobinTargetEntity,
+		CommentId: "IC_1",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "explanation", reply.Explanation)
+	assert.True(t, strings.HasSuffix(backend.Requests()[0].UserPrompt, "Why is this needed?"))
+
+	_, err = client.Explain(context.Background(), &services.ExplainRequest{
+		Target:    mockedRobinTargetEntity,
+		CommentId: "2",
+	})
+
+	assert.EqualError(t, err, "comment 2 not found")
+}
+
+func TestLLMRobinClient_ChatInCodeHost(t *testing.T) {
+	backend := plugins_aladino_services.NewFakeLLMBackend("chat")
+	client := mockLLMRobinClient(t, backend, nil)
+
+	reply, err := client.ChatInCodeHost(context.Background(), &services.ChatInCodeHostRequest{
+		Target: mockedRobinTargetEntity,
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "chat", reply.Reply)
+	assert.Equal(t, plugins_aladino_services.ROBIN_DEFAULT_MODEL, backend.Requests()[0].Model)
+	assert.Contains(t, backend.Requests()[0].UserPrompt, "@john: Why is this needed?")
+}
+
+func TestLLMRobinClient_RawPrompt(t *testing.T) {
+	backend := plugins_aladino_services.NewFakeLLMBackend("raw")
+	client := mockLLMRobinClient(t, backend, nil)
+	client.Model = "llama-2"
+	client.MaxTokens = 200
+
+	reply, err := client.RawPrompt(context.Background(), &services.RawPromptRequest{
+		Target:       mockedRobinTargetEntity,
+		SystemPrompt: "system",
+		UserPrompt:   "user",
+		Model:        "openai-gpt-4",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "raw", reply.Reply)
+	assert.Equal(t, &plugins_aladino_services.LLMRequest{
+		Model:        "llama-2",
+		SystemPrompt: "system",
+		UserPrompt:   "user",
+		MaxTokens:    200,
+	}, backend.Requests()[0])
+}
+
+func TestLLMRobinClient_TokenBudget(t *testing.T) {
+	backend := plugins_aladino_services.NewFakeLLMBackend("")
+	client := mockLLMRobinClient(t, backend, nil)
+	client.LoadTarget = mockRobinTargetLoader(strings.Repeat("+line\n", 1000))
+	client.TokenBudget = 200
+
+	_, err := client.Prompt(context.Background(), &services.PromptRequest{
+		Target: mockedRobinTargetEntity,
+		Prompt: "Review this.",
+	})
+
+	assert.Nil(t, err)
+
+	req := backend.Requests()[0]
+	assert.LessOrEqual(t, len(req.SystemPrompt)+len(req.UserPrompt), 200*plugins_aladino_services.ROBIN_CHARS_PER_TOKEN)
+	assert.Contains(t, req.UserPrompt, "[... truncated ...]")
+	assert.True(t, strings.HasSuffix(req.UserPrompt, "Review this."))
+}
+
+func TestLLMRobinClient_WhenBackendFails(t *testing.T) {
+	backend := plugins_aladino_services.NewFakeLLMBackend("")
+	backend.Err = errors.New("backend unavailable")
+	client := mockLLMRobinClient(t, backend, nil)
+
+	_, err := client.Prompt(context.Background(), &services.PromptRequest{Target: mockedRobinTargetEntity})
+
+	assert.EqualError(t, err, "backend unavailable")
+}
+
+func TestNewLLMRobinClient_WhenTemplateIsInvalid(t *testing.T) {
+	_, err := plugins_aladino_services.NewLLMRobinClient(nil, map[string]string{
+		plugins_aladino_services.ROBIN_TEMPLATE_PROMPT: "{{ .Prompt",
+	})
+	assert.ErrorContains(t, err, `invalid robin prompt template "prompt"`)
+
+	_, err = plugins_aladino_services.NewLLMRobinClient(nil, map[string]string{
+		"unknown": "{{ .Prompt }}",
+	})
+	assert.EqualError(t, err, `unknown robin prompt template "unknown"`)
+}
+
+func TestNewRobinServiceWithBackend(t *testing.T) {
+	templatesPath := filepath.Join(t.TempDir(), "templates.yml")
+	err := os.WriteFile(templatesPath, []byte("prompt: \"Answer: {{ .Prompt }}\"\n"), 0644)
+	assert.Nil(t, err)
+
+	client, conn, err := plugins_aladino_services.NewRobinServiceWithBackend(&plugins_aladino_services.RobinConfig{
+		Backend:             plugins_aladino_services.ROBIN_BACKEND_FAKE,
+		Model:               "local-model",
+		MaxTokens:           100,
+		TokenBudget:         1000,
+		PromptTemplatesPath: templatesPath,
+		GitHubAPIURL:        "https://github.example.com/api/v3",
+	})
+
+	assert.Nil(t, err)
+	assert.Nil(t, conn)
+
+	llmClient := client.(*plugins_aladino_services.LLMRobinClient)
+	assert.Equal(t, "local-model", llmClient.Model)
+	assert.Equal(t, 100, llmClient.MaxTokens)
+	assert.Equal(t, 1000, llmClient.TokenBudget)
+	assert.Equal(t, "https://github.example.com/api/v3", llmClient.GitHubAPIURL)
+
+	backend := llmClient.Backend.(*plugins_aladino_services.FakeLLMBackend)
+	llmClient.LoadTarget = mockRobinTargetLoader("")
+
+	_, err = client.Prompt(context.Background(), &services.PromptRequest{Target: mockedRobinTargetEntity, Prompt: "hi"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Answer: hi", backend.Requests()[0].UserPrompt)
+
+	client, _, err = plugins_aladino_services.NewRobinServiceWithBackend(&plugins_aladino_services.RobinConfig{
+		Backend: plugins_aladino_services.ROBIN_BACKEND_OPENAI,
+	})
+	assert.Nil(t, err)
+	assert.IsType(t, &plugins_aladino_services.OpenAIBackend{}, client.(*plugins_aladino_services.LLMRobinClient).Backend)
+
+	_, _, err = plugins_aladino_services.NewRobinServiceWithBackend(&plugins_aladino_services.RobinConfig{
+		Backend: "unknown",
+	})
+	assert.EqualError(t, err, `unknown robin backend "unknown"`)
+}
+
+func TestLoadRobinTargetFromGithub_WithGithubEnterprise(t *testing.T) {
+	githubServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/repos/foobar/default-mock-repo/issues/6":
+			w.Write([]byte(`{"number": 6, "title": "Bug", "body": "It fails"}`))
+		case "/api/v3/repos/foobar/default-mock-repo/issues/6/comments":
+			w.Write([]byte(`[{"id": 1, "node_id": "IC_1", "body": "Same here", "user": {"login": "john"}}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer githubServer.Close()
+
+	target, err := plugins_aladino_services.LoadRobinTargetFromGithub(context.Background(), githubServer.URL+"/api/v3", "token", &entities.TargetEntity{
+		Owner:  "foobar",
+		Repo:   "default-mock-repo",
+		Kind:   entities.TargetEntityKind_ISSUE,
+		Number: 6,
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &plugins_aladino_services.RobinTarget{
+		Kind:        "issue",
+		Owner:       "foobar",
+		Repo:        "default-mock-repo",
+		Number:      6,
+		Title:       "Bug",
+		Description: "It fails",
+		Comments: []*plugins_aladino_services.RobinComment{
+			{ID: "1", NodeID: "IC_1", Author: "john", Body: "Same here"},
+		},
+	}, target)
+}
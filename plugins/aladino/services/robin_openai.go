@@ -0,0 +1,116 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ROBIN_OPENAI_DEFAULT_URL is the base URL of the OpenAI API.
+// Local model servers exposing an OpenAI compatible API can be used by changing the URL.
+const ROBIN_OPENAI_DEFAULT_URL = "https://api.openai.com/v1"
+
+// OpenAIBackend is an LLMBackend for the chat completions API of OpenAI compatible servers.
+type OpenAIBackend struct {
+	BaseURL    string
+	APIKey     string
+	HTTPClient *http.Client
+}
+
+type openAIChatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type openAIChatRequest struct {
+	Model       string              `json:"model"`
+	Messages    []openAIChatMessage `json:"messages"`
+	MaxTokens   int                 `json:"max_tokens,omitempty"`
+	Temperature float64             `json:"temperature"`
+}
+
+type openAIChatResponse struct {
+	Choices []struct {
+		Message openAIChatMessage `json:"message"`
+	} `json:"choices"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+func NewOpenAIBackend(baseURL, apiKey string) *OpenAIBackend {
+	if baseURL == "" {
+		baseURL = ROBIN_OPENAI_DEFAULT_URL
+	}
+
+	return &OpenAIBackend{
+		BaseURL:    strings.TrimSuffix(baseURL, "/"),
+		APIKey:     apiKey,
+		HTTPClient: http.DefaultClient,
+	}
+}
+
+func (b *OpenAIBackend) Complete(ctx context.Context, req *LLMRequest) (string, error) {
+	messages := make([]openAIChatMessage, 0, 2)
+	if req.SystemPrompt != "" {
+		messages = append(messages, openAIChatMessage{Role: "system", Content: req.SystemPrompt})
+	}
+	messages = append(messages, openAIChatMessage{Role: "user", Content: req.UserPrompt})
+
+	body, err := json.Marshal(openAIChatRequest{
+		Model:     req.Model,
+		Messages:  messages,
+		MaxTokens: req.MaxTokens,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, b.BaseURL+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	if b.APIKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+b.APIKey)
+	}
+
+	httpResp, err := b.HTTPClient.Do(httpReq)
+	if err != nil {
+		return "", err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	resp := &openAIChatResponse{}
+	if err := json.Unmarshal(respBody, resp); err != nil {
+		return "", fmt.Errorf("invalid chat completion response (status %d): %w", httpResp.StatusCode, err)
+	}
+
+	if resp.Error != nil {
+		return "", fmt.Errorf("chat completion failed: %s", resp.Error.Message)
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("chat completion failed with status %d", httpResp.StatusCode)
+	}
+
+	if len(resp.Choices) == 0 {
+		return "", fmt.Errorf("chat completion returned no choices")
+	}
+
+	return strings.TrimSpace(resp.Choices[0].Message.Content), nil
+}
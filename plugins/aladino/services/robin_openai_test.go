@@ -0,0 +1,94 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_services_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	plugins_aladino_services "github.com/reviewpad/reviewpad/v4/plugins/aladino/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenAIBackend_Complete(t *testing.T) {
+	var gotRequest map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&gotRequest))
+
+		w.Write([]byte(`{"choices": [{"message": {"role": "assistant", "content": " Looks good to me. "}}]}`))
+	}))
+	defer server.Close()
+
+	backend := plugins_aladino_services.NewOpenAIBackend(server.URL+"/v1/", "secret")
+
+	reply, err := backend.Complete(context.Background(), &plugins_aladino_services.LLMRequest{
+		Model:        "gpt-4",
+		SystemPrompt: "system",
+		UserPrompt:   "user",
+		MaxTokens:    50,
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Looks good to me.", reply)
+	assert.Equal(t, map[string]interface{}{
+		"model": "gpt-4",
+		"messages": []interface{}{
+			map[string]interface{}{"role": "system", "content": "system"},
+			map[string]interface{}{"role": "user", "content": "user"},
+		},
+		"max_tokens":  float64(50),
+		"temperature": float64(0),
+	}, gotRequest)
+}
+
+func TestOpenAIBackend_Complete_WhenRequestFails(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		body    string
+		wantErr string
+	}{
+		"api error": {
+			status:  http.StatusUnauthorized,
+			body:    `{"error": {"message": "invalid api key"}}`,
+			wantErr: "chat completion failed: invalid api key",
+		},
+		"unexpected status": {
+			status:  http.StatusBadGateway,
+			body:    `{}`,
+			wantErr: "chat completion failed with status 502",
+		},
+		"invalid response": {
+			status:  http.StatusOK,
+			body:    `not json`,
+			wantErr: "invalid chat completion response (status 200): invalid character 'o' in literal null (expecting 'u')",
+		},
+		"no choices": {
+			status:  http.StatusOK,
+			body:    `{"choices": []}`,
+			wantErr: "chat completion returned no choices",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.status)
+				w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			backend := plugins_aladino_services.NewOpenAIBackend(server.URL, "")
+
+			_, err := backend.Complete(context.Background(), &plugins_aladino_services.LLMRequest{Model: "gpt-4", UserPrompt: "user"})
+
+			assert.EqualError(t, err, test.wantErr)
+		})
+	}
+}
@@ -5,6 +5,7 @@
 package plugins_aladino_services
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/reviewpad/api/go/clients"
@@ -15,14 +16,43 @@ import (
 const (
 	SEMANTIC_SERVICE_KEY      = "semantic"
 	SEMANTIC_SERVICE_ENDPOINT = "INPUT_SEMANTIC_SERVICE"
+	SEMANTIC_SERVICE_BACKEND  = "INPUT_SEMANTIC_BACKEND"
+)
+
+// Semantic backends that can be selected with the SEMANTIC_SERVICE_BACKEND environment variable.
+const (
+	// SEMANTIC_BACKEND_REMOTE uses the semantic service available at SEMANTIC_SERVICE_ENDPOINT.
+	SEMANTIC_BACKEND_REMOTE = "remote"
+	// SEMANTIC_BACKEND_LOCAL analyses the files in process and does not require the semantic service.
+	SEMANTIC_BACKEND_LOCAL = "local"
 )
 
 func NewSemanticServiceWithConfig(endpoint string) (services.SemanticClient, *grpc.ClientConn, error) {
 	return clients.NewSemanticClient(endpoint)
 }
 
+// NewSemanticServiceWithBackend creates the client of the given semantic backend.
+// The returned connection is nil when the backend does not rely on a remote service.
+func NewSemanticServiceWithBackend(backend string, endpoint string) (services.SemanticClient, *grpc.ClientConn, error) {
+	switch backend {
+	case "", SEMANTIC_BACKEND_REMOTE:
+		return NewSemanticServiceWithConfig(endpoint)
+	case SEMANTIC_BACKEND_LOCAL:
+		return NewGoSemanticClient(), nil, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown semantic backend %q", backend)
+	}
+}
+
 func NewSemanticService() (services.SemanticClient, *grpc.ClientConn, error) {
+	semanticBackend := os.Getenv(SEMANTIC_SERVICE_BACKEND)
 	semanticEndpoint := os.Getenv(SEMANTIC_SERVICE_ENDPOINT)
 
-	return NewSemanticServiceWithConfig(semanticEndpoint)
+	return NewSemanticServiceWithBackend(semanticBackend, semanticEndpoint)
+}
+
+// IsRemoteSemanticBackend reports whether the configured semantic backend requires the semantic service.
+func IsRemoteSemanticBackend() bool {
+	backend := os.Getenv(SEMANTIC_SERVICE_BACKEND)
+	return backend == "" || backend == SEMANTIC_BACKEND_REMOTE
 }
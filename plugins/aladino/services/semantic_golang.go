@@ -0,0 +1,402 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"path/filepath"
+	"strings"
+
+	"github.com/reviewpad/api/go/entities"
+	"github.com/reviewpad/api/go/services"
+	"google.golang.org/grpc"
+)
+
+// GoSemanticClient is a semantic backend that extracts the symbols of Go files
+// with the Go parser instead of relying on the semantic service.
+// Files written in other languages are represented by a single symbol
+// that changes whenever the contents of the file change.
+type GoSemanticClient struct{}
+
+func NewGoSemanticClient() services.SemanticClient {
+	return &GoSemanticClient{}
+}
+
+func (c *GoSemanticClient) GetSymbols(_ context.Context, in *services.GetSymbolsRequest, _ ...grpc.CallOption) (*services.GetSymbolsReply, error) {
+	extractor := &goSymbolExtractor{
+		path: in.GetFilepath(),
+		src:  in.GetBlob(),
+		fset: token.NewFileSet(),
+		symbols: &entities.Symbols{
+			Files:   make(map[string]*entities.File),
+			Symbols: make(map[string]*entities.Symbol),
+		},
+		file: &entities.File{
+			Path:     in.GetFilepath(),
+			BlobId:   in.GetBlobId(),
+			NumLines: int32(bytes.Count(in.GetBlob(), []byte("\n")) + 1),
+			Symbols:  make(map[string]string),
+		},
+		localIDs: make(map[string]int),
+	}
+
+	extractor.symbols.Files[extractor.path] = extractor.file
+
+	if filepath.Ext(extractor.path) != ".go" {
+		extractor.addFileSymbol()
+		return &services.GetSymbolsReply{Symbols: extractor.symbols}, nil
+	}
+
+	astFile, err := parser.ParseFile(extractor.fset, extractor.path, extractor.src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", extractor.path, err)
+	}
+
+	for _, decl := range astFile.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			extractor.addFuncDecl(d)
+		case *ast.GenDecl:
+			extractor.addGenDecl(d)
+		}
+	}
+
+	extractor.linkMethods()
+
+	return &services.GetSymbolsReply{Symbols: extractor.symbols}, nil
+}
+
+type goSymbolExtractor struct {
+	path     string
+	src      []byte
+	fset     *token.FileSet
+	symbols  *entities.Symbols
+	file     *entities.File
+	localIDs map[string]int
+	methods  map[string][]*entities.Symbol
+}
+
+func (x *goSymbolExtractor) addFileSymbol() {
+	name := filepath.Base(x.path)
+	x.addSymbol(&entities.Symbol{
+		Name:         name,
+		Type:         "file",
+		AgnosticType: entities.AgnosticType_OTHER,
+		Definition: &entities.Definition{
+			FilePath: x.path,
+		},
+	}, x.path, []string{string(x.src)})
+}
+
+func (x *goSymbolExtractor) addFuncDecl(d *ast.FuncDecl) {
+	if d.Name.Name == "_" {
+		return
+	}
+
+	symbol := &entities.Symbol{
+		Name:         d.Name.Name,
+		Type:         "function",
+		AgnosticType: entities.AgnosticType_FUNCTION,
+		CodeComments: x.comments(d.Doc),
+	}
+
+	localID := d.Name.Name
+	receiver := ""
+	if d.Recv != nil && len(d.Recv.List) > 0 {
+		receiver = receiverTypeName(d.Recv.List[0].Type)
+		localID = fmt.Sprintf("%s.%s", receiver, d.Name.Name)
+		symbol.Type = "method"
+		symbol.AgnosticType = entities.AgnosticType_METHOD
+	}
+
+	signature := x.format(&ast.FuncDecl{Recv: d.Recv, Name: d.Name, Type: d.Type})
+	symbol.Signature = &entities.SymbolDocumentation{
+		Code: signature,
+		Span: x.span(d.Pos(), d.Type.End()),
+	}
+	symbol.Definition = &entities.Definition{
+		FilePath: x.path,
+		MainDefinition: &entities.ReferenceBlock{
+			Reference: x.reference(d.Name),
+			Signature: x.spanAST(d.Pos(), d.Type.End(), signature),
+		},
+	}
+	if d.Body != nil {
+		symbol.Definition.MainDefinition.Body = x.spanAST(d.Body.Pos(), d.Body.End(), x.source(d.Body.Pos(), d.Body.End()))
+	}
+
+	x.addSymbol(symbol, localID, x.tokens(d.Pos(), d.End()))
+
+	if receiver != "" {
+		if x.methods == nil {
+			x.methods = make(map[string][]*entities.Symbol)
+		}
+		x.methods[receiver] = append(x.methods[receiver], symbol)
+	}
+}
+
+func (x *goSymbolExtractor) addGenDecl(d *ast.GenDecl) {
+	for _, spec := range d.Specs {
+		doc := d.Doc
+		if d.Lparen.IsValid() {
+			doc = nil
+		}
+
+		switch s := spec.(type) {
+		case *ast.TypeSpec:
+			if s.Doc != nil {
+				doc = s.Doc
+			}
+			x.addTypeSpec(s, doc)
+		case *ast.ValueSpec:
+			if s.Doc != nil {
+				doc = s.Doc
+			}
+			x.addValueSpec(d.Tok, s, doc)
+		}
+	}
+}
+
+func (x *goSymbolExtractor) addTypeSpec(s *ast.TypeSpec, doc *ast.CommentGroup) {
+	if s.Name.Name == "_" {
+		return
+	}
+
+	symbol := &entities.Symbol{
+		Name:         s.Name.Name,
+		Type:         "type",
+		AgnosticType: entities.AgnosticType_TYPE,
+		CodeComments: x.comments(doc),
+	}
+
+	switch s.Type.(type) {
+	case *ast.StructType:
+		symbol.Type = "struct"
+		symbol.AgnosticType = entities.AgnosticType_CLASS
+	case *ast.InterfaceType:
+		symbol.Type = "interface"
+		symbol.AgnosticType = entities.AgnosticType_INTERFACE
+	}
+
+	// the fields of structs and the methods of interfaces are part of the signature of a type
+	signature := "type " + x.format(&ast.TypeSpec{Name: s.Name, TypeParams: s.TypeParams, Assign: s.Assign, Type: s.Type})
+	symbol.Signature = &entities.SymbolDocumentation{
+		Code: signature,
+		Span: x.span(s.Pos(), s.End()),
+	}
+	symbol.Definition = &entities.Definition{
+		FilePath: x.path,
+		MainDefinition: &entities.ReferenceBlock{
+			Reference: x.reference(s.Name),
+			Signature: x.spanAST(s.Pos(), s.End(), signature),
+		},
+	}
+
+	x.addSymbol(symbol, s.Name.Name, x.tokens(s.Pos(), s.End()))
+}
+
+func (x *goSymbolExtractor) addValueSpec(tok token.Token, s *ast.ValueSpec, doc *ast.CommentGroup) {
+	symbolType, agnosticType := "variable", entities.AgnosticType_VARIABLE
+	if tok == token.CONST {
+		symbolType, agnosticType = "constant", entities.AgnosticType_CONSTANT
+	}
+
+	for i, name := range s.Names {
+		if name.Name == "_" {
+			continue
+		}
+
+		signature := fmt.Sprintf("%s %s", tok, name.Name)
+		if s.Type != nil {
+			signature = fmt.Sprintf("%s %s", signature, x.format(s.Type))
+		}
+
+		symbol := &entities.Symbol{
+			Name:         name.Name,
+			Type:         symbolType,
+			AgnosticType: agnosticType,
+			CodeComments: x.comments(doc),
+			Signature: &entities.SymbolDocumentation{
+				Code: signature,
+				Span: x.span(name.Pos(), name.End()),
+			},
+			Definition: &entities.Definition{
+				FilePath: x.path,
+				MainDefinition: &entities.ReferenceBlock{
+					Reference: x.reference(name),
+					Signature: x.spanAST(name.Pos(), name.End(), signature),
+				},
+			},
+		}
+
+		tokens := []string{signature}
+		if i < len(s.Values) {
+			value := s.Values[i]
+			symbol.Definition.MainDefinition.Body = x.spanAST(value.Pos(), value.End(), x.source(value.Pos(), value.End()))
+			tokens = append(tokens, x.tokens(value.Pos(), value.End())...)
+		}
+
+		x.addSymbol(symbol, name.Name, tokens)
+	}
+}
+
+// addSymbol assigns the local id and the id to the symbol and registers it in the file.
+// The id is derived from the tokens of the symbol so that it only changes
+// when the code changes, ignoring comments and formatting.
+func (x *goSymbolExtractor) addSymbol(symbol *entities.Symbol, localID string, tokens []string) {
+	x.localIDs[localID]++
+	if count := x.localIDs[localID]; count > 1 {
+		// e.g. multiple init functions
+		localID = fmt.Sprintf("%s#%d", localID, count)
+	}
+
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\x00%s\x00%s", x.path, localID, strings.Join(tokens, " "))
+
+	symbol.LocalId = localID
+	symbol.Id = fmt.Sprintf("%x", hash.Sum(nil))
+
+	x.symbols.Symbols[symbol.Id] = symbol
+	x.file.Symbols[localID] = symbol.Id
+}
+
+// linkMethods sets the receiver type of each method as its parent.
+func (x *goSymbolExtractor) linkMethods() {
+	for receiver, methods := range x.methods {
+		parentID, ok := x.file.Symbols[receiver]
+		if !ok {
+			// the receiver type is declared in another file
+			continue
+		}
+
+		parent := x.symbols.Symbols[parentID]
+		if parent.InnerSymbols == nil {
+			parent.InnerSymbols = make(map[string]string)
+		}
+
+		for _, method := range methods {
+			method.Parent = parentID
+			parent.InnerSymbols[method.LocalId] = method.Id
+		}
+	}
+}
+
+func (x *goSymbolExtractor) comments(doc *ast.CommentGroup) []*entities.SymbolDocumentation {
+	if doc == nil {
+		return nil
+	}
+
+	return []*entities.SymbolDocumentation{
+		{
+			Code: strings.TrimSpace(doc.Text()),
+			Span: x.span(doc.Pos(), doc.End()),
+		},
+	}
+}
+
+func (x *goSymbolExtractor) reference(name *ast.Ident) *entities.WordReference {
+	start := x.fset.Position(name.Pos())
+	end := x.fset.Position(name.End())
+
+	return &entities.WordReference{
+		Line: int32(start.Line),
+		Span: &entities.WordSpan{
+			StartColumn: int32(start.Column),
+			EndColumn:   int32(end.Column),
+		},
+	}
+}
+
+func (x *goSymbolExtractor) span(from, to token.Pos) *entities.Span {
+	start := x.fset.Position(from)
+	end := x.fset.Position(to)
+
+	return &entities.Span{
+		Start: &entities.Location{Line: int32(start.Line), Offset: int32(start.Column)},
+		End:   &entities.Location{Line: int32(end.Line), Offset: int32(end.Column)},
+	}
+}
+
+func (x *goSymbolExtractor) spanAST(from, to token.Pos, code string) *entities.SpanAST {
+	return &entities.SpanAST{
+		AstTokens: x.tokens(from, to),
+		Block:     x.span(from, to),
+		Code:      code,
+	}
+}
+
+func (x *goSymbolExtractor) source(from, to token.Pos) string {
+	return string(x.src[x.fset.Position(from).Offset:x.fset.Position(to).Offset])
+}
+
+// format prints the node in its canonical form, leaving out the comments of fields.
+func (x *goSymbolExtractor) format(node ast.Node) string {
+	ast.Inspect(node, func(n ast.Node) bool {
+		if field, ok := n.(*ast.Field); ok {
+			field.Doc = nil
+			field.Comment = nil
+		}
+		return true
+	})
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, x.fset, node); err != nil {
+		return ""
+	}
+
+	return buf.String()
+}
+
+// tokens returns the tokens of the source between the given positions without comments.
+func (x *goSymbolExtractor) tokens(from, to token.Pos) []string {
+	src := []byte(x.source(from, to))
+
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(src))
+
+	var s scanner.Scanner
+	s.Init(file, src, nil, 0)
+
+	tokens := make([]string, 0)
+	for {
+		_, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+
+		if lit == "" || tok == token.SEMICOLON {
+			tokens = append(tokens, tok.String())
+		} else {
+			tokens = append(tokens, lit)
+		}
+	}
+
+	return tokens
+}
+
+func receiverTypeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		return receiverTypeName(t.X)
+	case *ast.IndexExpr:
+		return receiverTypeName(t.X)
+	case *ast.IndexListExpr:
+		return receiverTypeName(t.X)
+	case *ast.ParenExpr:
+		return receiverTypeName(t.X)
+	}
+
+	return ""
+}
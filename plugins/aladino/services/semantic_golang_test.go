@@ -0,0 +1,179 @@
+// Copyright (C) 2023 Explore.dev, Unipessoal Lda - All Rights Reserved
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/reviewpad/api/go/entities"
+	"github.com/reviewpad/api/go/services"
+	plugins_aladino_services "github.com/reviewpad/reviewpad/v4/plugins/aladino/services"
+	"github.com/stretchr/testify/assert"
+)
+
+const mockedGoSource = `package crawler
+
+import "fmt"
+
+// reviewpad-an: critical
+// Crawl fetches the url.
+func Crawl(url string, depth int) error {
+	fmt.Println(url)
+	return nil
+}
+
+const MaxDepth = 10
+
+var (
+	visited = map[string]bool{}
+)
+
+// Fetcher fetches pages.
+type Fetcher struct {
+	// Client is the http client.
+	Client string
+}
+
+func (f *Fetcher) Fetch(url string) (string, error) {
+	return url, nil
+}
+
+func init() {}
+
+func init() {}
+`
+
+func getMockedSymbols(t *testing.T, path string, src string) *entities.Symbols {
+	client := plugins_aladino_services.NewGoSemanticClient()
+
+	reply, err := client.GetSymbols(context.Background(), &services.GetSymbolsRequest{
+		Filepath: path,
+		Blob:     []byte(src),
+		BlobId:   "1234",
+	})
+	assert.Nil(t, err)
+
+	return reply.Symbols
+}
+
+func getSymbolByLocalId(symbols *entities.Symbols, localID string) *entities.Symbol {
+	for _, symbol := range symbols.Symbols {
+		if symbol.LocalId == localID {
+			return symbol
+		}
+	}
+	return nil
+}
+
+func TestGoSemanticClient_GetSymbols(t *testing.T) {
+	symbols := getMockedSymbols(t, "crawler/crawler.go", mockedGoSource)
+
+	wantSymbols := map[string]struct {
+		name         string
+		symbolType   string
+		agnosticType entities.AgnosticType
+		signature    string
+	}{
+		"Crawl":         {"Crawl", "function", entities.AgnosticType_FUNCTION, "func Crawl(url string, depth int) error"},
+		"MaxDepth":      {"MaxDepth", "constant", entities.AgnosticType_CONSTANT, "const MaxDepth"},
+		"visited":       {"visited", "variable", entities.AgnosticType_VARIABLE, "var visited"},
+		"Fetcher":       {"Fetcher", "struct", entities.AgnosticType_CLASS, "type Fetcher struct {\n\tClient string\n}"},
+		"Fetcher.Fetch": {"Fetch", "method", entities.AgnosticType_METHOD, "func (f *Fetcher) Fetch(url string) (string, error)"},
+		"init":          {"init", "function", entities.AgnosticType_FUNCTION, "func init()"},
+		"init#2":        {"init", "function", entities.AgnosticType_FUNCTION, "func init()"},
+	}
+
+	assert.Len(t, symbols.Symbols, len(wantSymbols))
+	assert.Len(t, symbols.Files["crawler/crawler.go"].Symbols, len(wantSymbols))
+
+	for localID, want := range wantSymbols {
+		symbol := getSymbolByLocalId(symbols, localID)
+		if !assert.NotNil(t, symbol, localID) {
+			continue
+		}
+
+		assert.Equal(t, want.name, symbol.Name)
+		assert.Equal(t, want.symbolType, symbol.Type)
+		assert.Equal(t, want.agnosticType, symbol.AgnosticType)
+		assert.Equal(t, want.signature, symbol.Signature.Code)
+		assert.Equal(t, "crawler/crawler.go", symbol.Definition.FilePath)
+		assert.Equal(t, symbol.Id, symbols.Files["crawler/crawler.go"].Symbols[localID])
+	}
+
+	crawl := getSymbolByLocalId(symbols, "Crawl")
+	assert.Equal(t, "reviewpad-an: critical\nCrawl fetches the url.", crawl.CodeComments[0].Code)
+	assert.Equal(t, int32(7), crawl.Definition.MainDefinition.Reference.Line)
+
+	fetcher := getSymbolByLocalId(symbols, "Fetcher")
+	fetch := getSymbolByLocalId(symbols, "Fetcher.Fetch")
+	assert.Equal(t, fetcher.Id, fetch.Parent)
+	assert.Equal(t, map[string]string{"Fetcher.Fetch": fetch.Id}, fetcher.InnerSymbols)
+}
+
+func TestGoSemanticClient_GetSymbols_IdsIgnoreCommentsAndFormatting(t *testing.T) {
+	base := getMockedSymbols(t, "crawler.go", `package crawler
+
+func Crawl(url string) error {
+	return nil
+}
+`)
+	reformatted := getMockedSymbols(t, "crawler.go", `package crawler
+
+// Crawl fetches the url.
+func Crawl(url string)   error {
+	// nothing to do
+	return   nil
+}
+`)
+	modified := getMockedSymbols(t, "crawler.go", `package crawler
+
+func Crawl(url string) error {
+	return fmt.Errorf("not implemented")
+}
+`)
+
+	assert.Equal(t, getSymbolByLocalId(base, "Crawl").Id, getSymbolByLocalId(reformatted, "Crawl").Id)
+	assert.NotEqual(t, getSymbolByLocalId(base, "Crawl").Id, getSymbolByLocalId(modified, "Crawl").Id)
+	assert.Equal(t, getSymbolByLocalId(base, "Crawl").Signature.Code, getSymbolByLocalId(modified, "Crawl").Signature.Code)
+}
+
+func TestGoSemanticClient_GetSymbols_WhenFileIsNotGo(t *testing.T) {
+	symbols := getMockedSymbols(t, "docs/README.md", "# Reviewpad")
+	changed := getMockedSymbols(t, "docs/README.md", "# Reviewpad\n")
+
+	symbol := getSymbolByLocalId(symbols, "docs/README.md")
+
+	assert.Len(t, symbols.Symbols, 1)
+	assert.Equal(t, "README.md", symbol.Name)
+	assert.Equal(t, entities.AgnosticType_OTHER, symbol.AgnosticType)
+	assert.NotEqual(t, symbol.Id, getSymbolByLocalId(changed, "docs/README.md").Id)
+}
+
+func TestGoSemanticClient_GetSymbols_WhenParseFails(t *testing.T) {
+	client := plugins_aladino_services.NewGoSemanticClient()
+
+	reply, err := client.GetSymbols(context.Background(), &services.GetSymbolsRequest{
+		Filepath: "crawler.go",
+		Blob:     []byte("package crawler\n\nfunc Crawl( {"),
+	})
+
+	assert.Nil(t, reply)
+	assert.ErrorContains(t, err, "failed to parse crawler.go")
+}
+
+func TestNewSemanticServiceWithBackend(t *testing.T) {
+	client, connection, err := plugins_aladino_services.NewSemanticServiceWithBackend(plugins_aladino_services.SEMANTIC_BACKEND_LOCAL, "")
+
+	assert.Nil(t, err)
+	assert.Nil(t, connection)
+	assert.IsType(t, &plugins_aladino_services.GoSemanticClient{}, client)
+
+	client, connection, err = plugins_aladino_services.NewSemanticServiceWithBackend("unknown", "")
+
+	assert.Nil(t, client)
+	assert.Nil(t, connection)
+	assert.EqualError(t, err, `unknown semantic backend "unknown"`)
+}
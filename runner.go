@@ -246,6 +246,14 @@ func runReviewpadFile(env *engine.Env, reviewpadFile *engine.ReviewpadFile, safe
 			logErrorAndCollect(env.Logger, env.Collector, "error reporting results", err)
 			return engine.ExitStatusFailure, nil, env.Interpreter.GetCheckRunConclusion(), err
 		}
+
+		if reviewpadFile.CheckRun != nil {
+			err = env.Interpreter.ReportCheckRun(reviewpadFile.Mode, safeMode, reviewpadFile.CheckRun)
+			if err != nil {
+				logErrorAndCollect(env.Logger, env.Collector, "error reporting check run", err)
+				return engine.ExitStatusFailure, nil, env.Interpreter.GetCheckRunConclusion(), err
+			}
+		}
 	}
 
 	if utils.IsPullRequestReadyForReportMetrics(env.EventDetails) {
@@ -279,7 +287,36 @@ func Run(
 	dryRun bool,
 	safeMode bool,
 ) (engine.ExitStatus, *engine.Program, string, error) {
-	config, err := plugins_aladino.DefaultPluginConfig()
+	return RunBatch(ctx, log, gitHubClient, codeHostClient, collector, targetEntity, []*entities.EventDetails{eventDetails}, reviewpadFile, checkRunId, dryRun, safeMode)
+}
+
+// RunBatch runs the reviewpad file once for the events of the target, e.g. the events of a burst merged by the webhook server.
+// The target is evaluated with the last event and the workflows triggered by any of the events are run.
+func RunBatch(
+	ctx context.Context,
+	log *logrus.Entry,
+	gitHubClient *gh.GithubClient,
+	codeHostClient *codehost.CodeHostClient,
+	collector collector.Collector,
+	targetEntity *entities.TargetEntity,
+	allEventDetails []*entities.EventDetails,
+	reviewpadFile *engine.ReviewpadFile,
+	checkRunId *int64,
+	dryRun bool,
+	safeMode bool,
+) (engine.ExitStatus, *engine.Program, string, error) {
+	if len(allEventDetails) == 0 {
+		return engine.ExitStatusFailure, nil, "", fmt.Errorf("no event to run")
+	}
+
+	eventDetails := allEventDetails[len(allEventDetails)-1]
+
+	githubAPIURL := ""
+	if gitHubClient != nil {
+		githubAPIURL = gitHubClient.GetBaseURL()
+	}
+
+	config, err := plugins_aladino.NewPluginConfig(githubAPIURL)
 	if err != nil {
 		return engine.ExitStatusFailure, nil, "", err
 	}
@@ -296,6 +333,8 @@ func Run(
 		return engine.ExitStatusFailure, nil, "", err
 	}
 
+	env.BatchedEventDetails = allEventDetails[:len(allEventDetails)-1]
+
 	if utils.IsReviewpadCommand(env.EventDetails) {
 		// reviewpad-an: fixme: this is specific to github and only works for issues
 		command := eventDetails.Payload.(*github.IssueCommentEvent).GetComment().GetBody()
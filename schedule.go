@@ -0,0 +1,38 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package reviewpad
+
+import (
+	"time"
+
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/engine"
+	"github.com/reviewpad/reviewpad/v4/handler"
+)
+
+// ScheduleScope returns the scope of the targets of schedule events set by the `schedule` property of the reviewpad file.
+func ScheduleScope(reviewpadFile *engine.ReviewpadFile) (*handler.ScheduleScope, error) {
+	schedule := reviewpadFile.Schedule
+	if schedule == nil {
+		return nil, nil
+	}
+
+	updatedBefore, err := schedule.UpdatedBeforeDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	scope := &handler.ScheduleScope{
+		Kind:       entities.TargetEntityKind(schedule.Kind),
+		Labels:     schedule.Labels,
+		MaxTargets: schedule.MaxTargets,
+	}
+
+	if updatedBefore > 0 {
+		scope.UpdatedBefore = time.Now().Add(-updatedBefore)
+	}
+
+	return scope, nil
+}
@@ -0,0 +1,190 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	pbc "github.com/reviewpad/api/go/codehost"
+	pbe "github.com/reviewpad/api/go/entities"
+	api "github.com/reviewpad/api/go/services"
+	"github.com/reviewpad/go-lib/uri"
+	"github.com/reviewpad/reviewpad/v4"
+	"github.com/reviewpad/reviewpad/v4/coalescer"
+	"github.com/reviewpad/reviewpad/v4/codehost"
+	gh "github.com/reviewpad/reviewpad/v4/codehost/github"
+	"github.com/reviewpad/reviewpad/v4/codehost/gitlab"
+	"github.com/reviewpad/reviewpad/v4/collector"
+	"github.com/reviewpad/reviewpad/v4/engine"
+	"github.com/reviewpad/reviewpad/v4/handler"
+	"github.com/reviewpad/reviewpad/v4/utils"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+)
+
+type EvaluatorConfig struct {
+	// ReviewpadFilePath is the path of the reviewpad file in the repositories.
+	ReviewpadFilePath string
+	CodehostClient    api.HostClient
+	MixpanelToken     string
+	DryRun            bool
+	SafeMode          bool
+}
+
+// NewReviewpadEvaluator returns an Evaluator that runs the reviewpad file in the default branch of the repository
+// on the target of the batch with the latest event of the batch.
+// The batches of GitLab events are evaluated on GitLab with the GitLab API URL and token of the event.
+func NewReviewpadEvaluator(config *EvaluatorConfig) Evaluator {
+	return func(ctx context.Context, log *logrus.Entry, batch *coalescer.Batch) error {
+		if handler.IsGitlabEvent(*batch.Event.EventName) {
+			return evaluateOnGitlab(ctx, log, config, batch)
+		}
+
+		return evaluateOnGithub(ctx, log, config, batch)
+	}
+}
+
+func evaluateOnGithub(ctx context.Context, log *logrus.Entry, config *EvaluatorConfig, batch *coalescer.Batch) error {
+	event := batch.Event
+
+	apiURL := ""
+	if event.ApiUrl != nil {
+		apiURL = *event.ApiUrl
+	}
+
+	githubClient, err := gh.NewGithubClientFromTokenWithBaseURL(ctx, *event.Token, apiURL)
+	if err != nil {
+		return err
+	}
+
+	repository, err := repositoryFromPayload(*event.EventPayload)
+	if err != nil {
+		return fmt.Errorf("error parsing payload: %v", err)
+	}
+
+	rawReviewpadFile, err := utils.DownloadReviewpadFileFromGitHub(ctx, log, githubClient, config.ReviewpadFilePath, &pbc.Branch{
+		Repo: &pbc.Repository{
+			Owner: repository.GetOwner().GetLogin(),
+			Name:  repository.GetName(),
+		},
+		Name: repository.GetDefaultBranch(),
+	}, &gh.DownloadContentsOptions{
+		Method: gh.DownloadMethodBranchName,
+	})
+	if err != nil {
+		if utils.IsFileNotFound(err) {
+			log.Infof("repository has no reviewpad file at %s", config.ReviewpadFilePath)
+			return nil
+		}
+		return fmt.Errorf("error downloading reviewpad file: %v", err)
+	}
+
+	reviewpadFile, err := reviewpad.Load(ctx, log, githubClient, rawReviewpadFile)
+	if err != nil {
+		return fmt.Errorf("error loading reviewpad file: %v", err)
+	}
+
+	hostInfo, err := getHostInfo(repository.GetHTMLURL(), pbe.Host_GITHUB)
+	if err != nil {
+		return fmt.Errorf("error getting host info: %v", err)
+	}
+
+	codeHostClient := &codehost.CodeHostClient{
+		Token:          *event.Token,
+		HostInfo:       hostInfo,
+		CodehostClient: config.CodehostClient,
+	}
+
+	return run(ctx, log, config, githubClient, codeHostClient, batch, reviewpadFile)
+}
+
+// evaluateOnGitlab evaluates the batch without a GitHub client, so the builtins that need one are not supported.
+func evaluateOnGitlab(ctx context.Context, log *logrus.Entry, config *EvaluatorConfig, batch *coalescer.Batch) error {
+	event := batch.Event
+	targetEntity := batch.Target
+
+	apiURL := ""
+	if event.ApiUrl != nil {
+		apiURL = *event.ApiUrl
+	}
+
+	gitlabClient, err := gitlab.NewGitlabClient(apiURL, *event.Token, nil)
+	if err != nil {
+		return err
+	}
+
+	project, err := gitlabClient.GetProject(ctx, targetEntity.Owner, targetEntity.Repo)
+	if err != nil {
+		return fmt.Errorf("error getting project: %v", err)
+	}
+
+	rawReviewpadFile, err := gitlabClient.GetRawFile(ctx, targetEntity.Owner, targetEntity.Repo, project.DefaultBranch, config.ReviewpadFilePath)
+	if err != nil {
+		if utils.IsFileNotFound(err) {
+			log.Infof("project has no reviewpad file at %s", config.ReviewpadFilePath)
+			return nil
+		}
+		return fmt.Errorf("error downloading reviewpad file: %v", err)
+	}
+
+	reviewpadFile, err := reviewpad.Load(ctx, log, nil, bytes.NewBuffer(rawReviewpadFile))
+	if err != nil {
+		return fmt.Errorf("error loading reviewpad file: %v", err)
+	}
+
+	hostInfo, err := getHostInfo(project.WebURL, pbe.Host_GITLAB)
+	if err != nil {
+		return fmt.Errorf("error getting host info: %v", err)
+	}
+
+	codeHostClient := &codehost.CodeHostClient{
+		Token:          *event.Token,
+		HostInfo:       hostInfo,
+		CodehostClient: config.CodehostClient,
+		GitlabClient:   gitlabClient,
+	}
+
+	return run(ctx, log, config, nil, codeHostClient, batch, reviewpadFile)
+}
+
+// run runs the reviewpad file on the target of the batch.
+func run(ctx context.Context, log *logrus.Entry, config *EvaluatorConfig, githubClient *gh.GithubClient, codeHostClient *codehost.CodeHostClient, batch *coalescer.Batch, reviewpadFile *engine.ReviewpadFile) error {
+	targetEntity := batch.Target
+
+	requestID := uuid.New().String()
+	log = log.WithField("request_id", requestID)
+
+	collectorClient, err := collector.NewCollector(config.MixpanelToken, targetEntity.Owner, string(targetEntity.Kind), "webhook-server", nil)
+	if err != nil {
+		log.Errorf("error creating new collector: %v", err)
+	}
+
+	ctxReq := metadata.NewOutgoingContext(ctx, metadata.Pairs(codehost.RequestIDKey, requestID))
+
+	_, _, _, err = reviewpad.RunBatch(ctxReq, log, githubClient, codeHostClient, collectorClient, targetEntity, batch.AllEventDetails, reviewpadFile, nil, config.DryRun, config.SafeMode)
+	if err != nil {
+		return fmt.Errorf("error running reviewpad: %v", err)
+	}
+
+	return nil
+}
+
+// getHostInfo returns the host of the repository URL.
+// Unlike codehost.GetHostInfo, the host name is not used to find the code host
+// since GitHub Enterprise Server and self-managed GitLab instances are served from any domain.
+func getHostInfo(repositoryURL string, host pbe.Host) (*codehost.HostInfo, error) {
+	uriData, err := uri.DataFrom(repositoryURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &codehost.HostInfo{
+		Host:    host,
+		HostUri: uriData.Prefix + uriData.Host,
+	}, nil
+}
@@ -0,0 +1,262 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v4/coalescer"
+	"github.com/reviewpad/reviewpad/v4/handler"
+	plugins_aladino_services "github.com/reviewpad/reviewpad/v4/plugins/aladino/services"
+	"github.com/reviewpad/reviewpad/v4/server"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+const gitlabMergeRequestPayload = `{
+	"object_kind": "merge_request",
+	"project": {"path_with_namespace": "foobar/default-mock-repo", "visibility_level": 20},
+	"object_attributes": {"iid": 6, "action": "open"}
+}`
+
+func newGitlabWebhookRequest(payload, token string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(server.HEADER_GITLAB_EVENT, handler.GITLAB_MERGE_REQUEST_HOOK)
+	req.Header.Set(server.HEADER_GITLAB_DELIVERY, "gitlab-delivery")
+	req.Header.Set(server.HEADER_GITLAB_TOKEN, token)
+	return req
+}
+
+func TestServeHTTP_WithGitlabWebhook(t *testing.T) {
+	tests := map[string]struct {
+		gitlabToken    string
+		request        *http.Request
+		wantStatusCode int
+		wantEvent      bool
+	}{
+		"when gitlab webhooks are not enabled": {
+			request:        newGitlabWebhookRequest(gitlabMergeRequestPayload, webhookSecret),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		"when token is invalid": {
+			gitlabToken:    "gitlab-token",
+			request:        newGitlabWebhookRequest(gitlabMergeRequestPayload, "other-secret"),
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		"when event has no project": {
+			gitlabToken:    "gitlab-token",
+			request:        newGitlabWebhookRequest(`{"object_kind": "merge_request"}`, webhookSecret),
+			wantStatusCode: http.StatusOK,
+		},
+		"when event is accepted": {
+			gitlabToken:    "gitlab-token",
+			request:        newGitlabWebhookRequest(gitlabMergeRequestPayload, webhookSecret),
+			wantStatusCode: http.StatusAccepted,
+			wantEvent:      true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			events := make(chan *handler.ActionEvent, 1)
+			evaluate := func(ctx context.Context, log *logrus.Entry, batch *coalescer.Batch) error {
+				events <- batch.Event
+				return nil
+			}
+
+			webhookServer := newServer(t, &server.Config{
+				Token:        "token",
+				GitLabToken:  test.gitlabToken,
+				GitLabAPIURL: "https://gitlab.example.com/api/v4",
+			}, &coalescer.Config{}, evaluate)
+
+			rec := httptest.NewRecorder()
+			webhookServer.ServeHTTP(rec, test.request)
+
+			assert.Nil(t, webhookServer.Shutdown(context.Background()))
+			assert.Equal(t, test.wantStatusCode, rec.Code)
+
+			if !test.wantEvent {
+				assert.Empty(t, events)
+				return
+			}
+
+			event := <-events
+			assert.Equal(t, handler.GITLAB_MERGE_REQUEST_HOOK, *event.EventName)
+			assert.Equal(t, "gitlab-token", *event.Token)
+			assert.Equal(t, "https://gitlab.example.com/api/v4", *event.ApiUrl)
+			assert.Equal(t, "foobar/default-mock-repo", *event.Repository)
+		})
+	}
+}
+
+// gitlabStandIn is a GitLab API with a merge request of the project foobar/default-mock-repo.
+type gitlabStandIn struct {
+	mu            sync.Mutex
+	reviewpadFile string
+	labels        []string
+	notes         []map[string]interface{}
+	approvals     int
+}
+
+func (g *gitlabStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	project := "/api/v4/projects/foobar%2Fdefault-mock-repo"
+	mergeRequest := project + "/merge_requests/6"
+
+	writeMergeRequest := func() {
+		labels, _ := json.Marshal(g.labels)
+		w.Write([]byte(`{"id": 16, "iid": 6, "title": "Add feature", "state": "opened", "source_branch": "feature", "target_branch": "main", "sha": "def", "author": {"id": 1, "username": "john"}, "labels": ` + string(labels) + `}`))
+	}
+
+	switch path := r.URL.EscapedPath(); {
+	case path == "/api/v4/user":
+		w.Write([]byte(`{"id": 9, "username": "reviewpad-bot"}`))
+	case path == project:
+		w.Write([]byte(`{"id": 1, "path_with_namespace": "foobar/default-mock-repo", "default_branch": "main", "web_url": "https://gitlab.example.com/foobar/default-mock-repo"}`))
+	case path == project+"/repository/files/reviewpad.yml/raw" && r.URL.Query().Get("ref") == "main":
+		w.Write([]byte(g.reviewpadFile))
+	case path == mergeRequest && r.Method == http.MethodGet:
+		writeMergeRequest()
+	case path == mergeRequest && r.Method == http.MethodPut:
+		var body map[string]interface{}
+		rawBody, _ := io.ReadAll(r.Body)
+		json.Unmarshal(rawBody, &body)
+
+		if addLabels, ok := body["add_labels"].(string); ok {
+			g.labels = append(g.labels, strings.Split(addLabels, ",")...)
+		}
+
+		writeMergeRequest()
+	case path == mergeRequest+"/diffs":
+		w.Write([]byte(`[{"old_path": "main.go", "new_path": "main.go", "diff": "@@ -1,1 +1,2 @@\n-a\n+b\n+c"}]`))
+	case path == mergeRequest+"/versions":
+		w.Write([]byte(`[{"id": 1, "head_commit_sha": "def", "created_at": "2023-03-01T10:00:00Z"}]`))
+	case path == mergeRequest+"/approve" && r.Method == http.MethodPost:
+		g.approvals++
+		w.Write([]byte(`{}`))
+	case path == mergeRequest+"/commits":
+		w.Write([]byte(`[{"id": "def", "message": "Add feature", "parent_ids": ["abc"]}]`))
+	case path == mergeRequest+"/notes" && r.Method == http.MethodGet:
+		notes, _ := json.Marshal(g.notes)
+		w.Write(notes)
+	case path == mergeRequest+"/notes" && r.Method == http.MethodPost:
+		var note map[string]interface{}
+		rawBody, _ := io.ReadAll(r.Body)
+		json.Unmarshal(rawBody, &note)
+
+		note["id"] = len(g.notes) + 1
+		g.notes = append(g.notes, note)
+
+		response, _ := json.Marshal(note)
+		w.Write(response)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "404 Not found"}`))
+	}
+}
+
+func TestServeHTTP_EvaluatesGitlabMergeRequest(t *testing.T) {
+	t.Setenv(plugins_aladino_services.SEMANTIC_SERVICE_BACKEND, plugins_aladino_services.SEMANTIC_BACKEND_LOCAL)
+	t.Setenv(plugins_aladino_services.ROBIN_SERVICE_BACKEND, plugins_aladino_services.ROBIN_BACKEND_FAKE)
+
+	gitlab := &gitlabStandIn{
+		reviewpadFile: `
+mode: verbose
+
+workflows:
+  - name: label-small-merge-requests
+    run:
+      - if: $size() < 10
+        then:
+          - $addLabel("small")
+          - $comment($sprintf("Thanks @%s", [$author()]))
+`,
+	}
+	gitlabServer := httptest.NewServer(gitlab)
+	t.Cleanup(gitlabServer.Close)
+
+	var evaluateErrs []error
+	reviewpadEvaluator := server.NewReviewpadEvaluator(&server.EvaluatorConfig{ReviewpadFilePath: "reviewpad.yml"})
+	evaluate := func(ctx context.Context, log *logrus.Entry, batch *coalescer.Batch) error {
+		err := reviewpadEvaluator(ctx, log, batch)
+		evaluateErrs = append(evaluateErrs, err)
+		return err
+	}
+
+	eventCoalescer := coalescer.NewCoalescer(coalescer.NewMemoryStore(), &coalescer.Config{}, handler.ProcessWebhookEvent)
+	webhookServer, err := server.NewServer(logrus.NewEntry(logrus.New()), &server.Config{
+		WebhookSecret: webhookSecret,
+		GitLabToken:   "gitlab-token",
+		GitLabAPIURL:  gitlabServer.URL + "/api/v4",
+	}, eventCoalescer, evaluate)
+	assert.Nil(t, err)
+
+	rec := httptest.NewRecorder()
+	webhookServer.ServeHTTP(rec, newGitlabWebhookRequest(gitlabMergeRequestPayload, webhookSecret))
+
+	assert.Nil(t, webhookServer.Shutdown(context.Background()))
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+	assert.Equal(t, []error{nil}, evaluateErrs)
+	assert.Equal(t, []string{"small"}, gitlab.labels)
+	assert.Len(t, gitlab.notes, 2)
+	assert.Equal(t, "Thanks @john", gitlab.notes[0]["body"])
+	assert.True(t, strings.HasPrefix(gitlab.notes[1]["body"].(string), "<!--@annotation-reviewpad-report-->"))
+}
+
+func TestServeHTTP_ApprovesAndCommentsOnGitlabMergeRequest(t *testing.T) {
+	t.Setenv(plugins_aladino_services.SEMANTIC_SERVICE_BACKEND, plugins_aladino_services.SEMANTIC_BACKEND_LOCAL)
+	t.Setenv(plugins_aladino_services.ROBIN_SERVICE_BACKEND, plugins_aladino_services.ROBIN_BACKEND_FAKE)
+
+	gitlab := &gitlabStandIn{
+		reviewpadFile: `
+workflows:
+  - name: approve-small-merge-requests
+    run:
+      - if: $size() < 10
+        then:
+          - $approve()
+          - $commentUpsert("size", "Small merge request")
+`,
+	}
+	gitlabServer := httptest.NewServer(gitlab)
+	t.Cleanup(gitlabServer.Close)
+
+	var evaluateErrs []error
+	reviewpadEvaluator := server.NewReviewpadEvaluator(&server.EvaluatorConfig{ReviewpadFilePath: "reviewpad.yml"})
+	evaluate := func(ctx context.Context, log *logrus.Entry, batch *coalescer.Batch) error {
+		err := reviewpadEvaluator(ctx, log, batch)
+		evaluateErrs = append(evaluateErrs, err)
+		return err
+	}
+
+	eventCoalescer := coalescer.NewCoalescer(coalescer.NewMemoryStore(), &coalescer.Config{}, handler.ProcessWebhookEvent)
+	webhookServer, err := server.NewServer(logrus.NewEntry(logrus.New()), &server.Config{
+		WebhookSecret: webhookSecret,
+		GitLabToken:   "gitlab-token",
+		GitLabAPIURL:  gitlabServer.URL + "/api/v4",
+	}, eventCoalescer, evaluate)
+	assert.Nil(t, err)
+
+	rec := httptest.NewRecorder()
+	webhookServer.ServeHTTP(rec, newGitlabWebhookRequest(gitlabMergeRequestPayload, webhookSecret))
+
+	assert.Nil(t, webhookServer.Shutdown(context.Background()))
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+	assert.Equal(t, []error{nil}, evaluateErrs)
+	assert.Equal(t, 1, gitlab.approvals)
+	assert.Len(t, gitlab.notes, 1)
+	assert.Equal(t, "<!--@annotation-reviewpad-comment-size-->\nSmall merge request", gitlab.notes[0]["body"])
+}
@@ -0,0 +1,441 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/reviewpad/v4/coalescer"
+	"github.com/reviewpad/reviewpad/v4/handler"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	HEADER_DELIVERY  = "X-GitHub-Delivery"
+	HEADER_EVENT     = "X-GitHub-Event"
+	HEADER_SIGNATURE = "X-Hub-Signature-256"
+
+	HEADER_GITLAB_DELIVERY = "X-Gitlab-Event-UUID"
+	HEADER_GITLAB_EVENT    = "X-Gitlab-Event"
+	HEADER_GITLAB_TOKEN    = "X-Gitlab-Token"
+
+	// GitHub caps webhook payloads at 25 MB.
+	MAX_PAYLOAD_SIZE = 25 << 20
+
+	DEFAULT_WORKERS    = 4
+	DEFAULT_QUEUE_SIZE = 100
+)
+
+// Evaluator runs reviewpad on the target of a batch of events.
+type Evaluator func(ctx context.Context, log *logrus.Entry, batch *coalescer.Batch) error
+
+type Config struct {
+	// WebhookSecret is the secret used to sign the GitHub webhook payloads and the secret token of the GitLab webhooks.
+	WebhookSecret string
+	// Token is the GitHub token used to process the events.
+	Token string
+	// GitHubAPIURL is the URL of the GitHub API, e.g. https://github.example.com/api/v3 for GitHub Enterprise Server.
+	GitHubAPIURL string
+	// GitLabToken is the GitLab token used to process the GitLab events, which are rejected when it is empty.
+	GitLabToken string
+	// GitLabAPIURL is the URL of the GitLab API, e.g. https://gitlab.example.com/api/v4 for self-managed instances.
+	GitLabAPIURL string
+	// Workers is the number of jobs processed concurrently.
+	Workers int
+	// QueueSize is the number of jobs waiting to be processed before new deliveries are rejected.
+	QueueSize int
+}
+
+// job is either the processing of an event or the evaluation of a target.
+type job struct {
+	repository string
+	log        *logrus.Entry
+	run        func(ctx context.Context, log *logrus.Entry) error
+}
+
+// Server is an HTTP handler that receives GitHub and GitLab webhooks and runs reviewpad on them.
+// Events are handed to a coalescer, which finds their targets, and the targets are evaluated once their batch is released.
+// Jobs are processed by a bounded pool of workers and the jobs of a repository are processed one at a time,
+// in the order they were queued, without keeping workers waiting on busy repositories.
+type Server struct {
+	config    *Config
+	log       *logrus.Entry
+	coalescer *coalescer.Coalescer
+	evaluate  Evaluator
+	queueSize int
+
+	workers    sync.WaitGroup
+	forwarding sync.WaitGroup
+	shutdown   sync.Once
+	done       chan struct{}
+
+	mu sync.Mutex
+	// cond is signaled when jobs are queued or finished.
+	cond *sync.Cond
+	// closed is set when the server stops accepting deliveries.
+	closed bool
+	// stopped is set when the workers must exit once there are no ready jobs.
+	stopped bool
+	// queued is the number of jobs waiting or in progress.
+	queued int
+	// pending holds the jobs of each repository, the first being the one waiting or in progress.
+	pending map[string][]*job
+	// ready holds the repositories whose first job is waiting for a worker.
+	ready []string
+}
+
+func NewServer(log *logrus.Entry, config *Config, eventCoalescer *coalescer.Coalescer, evaluate Evaluator) (*Server, error) {
+	if config.WebhookSecret == "" {
+		return nil, errors.New("webhook secret is required")
+	}
+
+	if eventCoalescer == nil {
+		return nil, errors.New("coalescer is required")
+	}
+
+	if evaluate == nil {
+		return nil, errors.New("evaluator is required")
+	}
+
+	workers := config.Workers
+	if workers <= 0 {
+		workers = DEFAULT_WORKERS
+	}
+
+	queueSize := config.QueueSize
+	if queueSize <= 0 {
+		queueSize = DEFAULT_QUEUE_SIZE
+	}
+
+	server := &Server{
+		config:    config,
+		log:       log,
+		coalescer: eventCoalescer,
+		evaluate:  evaluate,
+		queueSize: queueSize,
+		done:      make(chan struct{}),
+		pending:   make(map[string][]*job),
+	}
+	server.cond = sync.NewCond(&server.mu)
+
+	server.workers.Add(workers)
+	for i := 0; i < workers; i++ {
+		go server.work()
+	}
+
+	server.forwarding.Add(1)
+	go server.forward()
+
+	return server, nil
+}
+
+// Shutdown stops accepting deliveries and waits for the queued jobs and the pending batches to be processed
+// or for the context to be done.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.shutdown.Do(func() {
+		s.mu.Lock()
+		s.closed = true
+		s.mu.Unlock()
+
+		go func() {
+			s.waitIdle()
+
+			// Release the pending batches and wait for their evaluations.
+			s.coalescer.Close()
+			s.forwarding.Wait()
+			s.waitIdle()
+
+			s.mu.Lock()
+			s.stopped = true
+			s.cond.Broadcast()
+			s.mu.Unlock()
+
+			s.workers.Wait()
+			close(s.done)
+		}()
+	})
+
+	select {
+	case <-s.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	deliveryID, eventName := r.Header.Get(HEADER_DELIVERY), r.Header.Get(HEADER_EVENT)
+	if r.Header.Get(HEADER_GITLAB_EVENT) != "" {
+		deliveryID, eventName = r.Header.Get(HEADER_GITLAB_DELIVERY), r.Header.Get(HEADER_GITLAB_EVENT)
+	}
+
+	log := s.log.WithFields(logrus.Fields{
+		"delivery": deliveryID,
+		"event":    eventName,
+	})
+
+	status, message, repository := s.handle(r, log)
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(status)
+	fmt.Fprintln(w, message)
+
+	log.WithFields(logrus.Fields{
+		"repository": repository,
+		"status":     status,
+		"duration":   time.Since(start).String(),
+	}).Info(message)
+}
+
+// handle validates and enqueues a webhook delivery.
+// It returns the status code and message of the response and the full name of the repository of the event.
+func (s *Server) handle(r *http.Request, log *logrus.Entry) (int, string, string) {
+	if r.Method != http.MethodPost {
+		return http.StatusMethodNotAllowed, "method not allowed", ""
+	}
+
+	eventName := r.Header.Get(HEADER_EVENT)
+	gitlabEventName := r.Header.Get(HEADER_GITLAB_EVENT)
+	if eventName == "" && gitlabEventName == "" {
+		return http.StatusBadRequest, fmt.Sprintf("missing %s header", HEADER_EVENT), ""
+	}
+
+	if mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err != nil || mediaType != "application/json" {
+		return http.StatusUnsupportedMediaType, "webhook content type must be application/json", ""
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, MAX_PAYLOAD_SIZE+1))
+	if err != nil {
+		return http.StatusBadRequest, fmt.Sprintf("error reading payload: %v", err), ""
+	}
+
+	if len(body) > MAX_PAYLOAD_SIZE {
+		return http.StatusRequestEntityTooLarge, "payload too large", ""
+	}
+
+	if gitlabEventName != "" {
+		return s.handleGitlab(r, gitlabEventName, body, log)
+	}
+
+	if err := github.ValidateSignature(r.Header.Get(HEADER_SIGNATURE), body, []byte(s.config.WebhookSecret)); err != nil {
+		return http.StatusUnauthorized, "invalid signature", ""
+	}
+
+	if eventName == "ping" {
+		return http.StatusOK, "pong", ""
+	}
+
+	repository, err := repositoryFromPayload(body)
+	if err != nil {
+		return http.StatusBadRequest, fmt.Sprintf("error parsing payload: %v", err), ""
+	}
+
+	if repository.GetFullName() == "" {
+		return http.StatusOK, "ignored event without repository", ""
+	}
+
+	payload := json.RawMessage(body)
+	event := &handler.ActionEvent{
+		EventName:    github.String(eventName),
+		EventPayload: &payload,
+		Token:        github.String(s.config.Token),
+		Repository:   github.String(repository.GetFullName()),
+	}
+
+	if s.config.GitHubAPIURL != "" {
+		event.ApiUrl = github.String(s.config.GitHubAPIURL)
+	}
+
+	return s.accept(r.Header.Get(HEADER_DELIVERY), event, log)
+}
+
+// handleGitlab validates and enqueues a GitLab webhook delivery.
+// GitLab does not sign the payloads but sends the secret token of the webhook.
+func (s *Server) handleGitlab(r *http.Request, eventName string, body []byte, log *logrus.Entry) (int, string, string) {
+	if s.config.GitLabToken == "" {
+		return http.StatusBadRequest, "gitlab webhooks are not enabled", ""
+	}
+
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get(HEADER_GITLAB_TOKEN)), []byte(s.config.WebhookSecret)) != 1 {
+		return http.StatusUnauthorized, "invalid token", ""
+	}
+
+	projectEvent := struct {
+		Project struct {
+			PathWithNamespace string `json:"path_with_namespace"`
+		} `json:"project"`
+	}{}
+
+	if err := json.Unmarshal(body, &projectEvent); err != nil {
+		return http.StatusBadRequest, fmt.Sprintf("error parsing payload: %v", err), ""
+	}
+
+	project := projectEvent.Project.PathWithNamespace
+	if project == "" {
+		return http.StatusOK, "ignored event without project", ""
+	}
+
+	payload := json.RawMessage(body)
+	event := &handler.ActionEvent{
+		EventName:    github.String(eventName),
+		EventPayload: &payload,
+		Token:        github.String(s.config.GitLabToken),
+		Repository:   github.String(project),
+	}
+
+	if s.config.GitLabAPIURL != "" {
+		event.ApiUrl = github.String(s.config.GitLabAPIURL)
+	}
+
+	return s.accept(r.Header.Get(HEADER_GITLAB_DELIVERY), event, log)
+}
+
+// accept queues the handling of the event by the coalescer.
+func (s *Server) accept(deliveryID string, event *handler.ActionEvent, log *logrus.Entry) (int, string, string) {
+	repository := *event.Repository
+
+	ok := s.enqueue(&job{
+		repository: repository,
+		log:        log.WithField("repository", repository),
+		run: func(ctx context.Context, log *logrus.Entry) error {
+			return s.coalescer.Handle(log, deliveryID, event)
+		},
+	}, false)
+	if !ok {
+		return http.StatusServiceUnavailable, "queue is full", repository
+	}
+
+	return http.StatusAccepted, "accepted", repository
+}
+
+// forward queues the evaluation of the batches released by the coalescer.
+func (s *Server) forward() {
+	defer s.forwarding.Done()
+
+	for batch := range s.coalescer.Batches() {
+		batch := batch
+		repository := fmt.Sprintf("%s/%s", batch.Target.Owner, batch.Target.Repo)
+
+		s.enqueue(&job{
+			repository: repository,
+			log: s.log.WithFields(logrus.Fields{
+				"deliveries": batch.DeliveryIDs,
+				"event":      *batch.Event.EventName,
+				"repository": repository,
+				"target":     fmt.Sprintf("%s#%d", repository, batch.Target.Number),
+			}),
+			run: func(ctx context.Context, log *logrus.Entry) error {
+				return s.evaluate(ctx, log, batch)
+			},
+		}, true)
+	}
+}
+
+// enqueue queues the job and reports whether it was queued.
+// Deliveries are rejected when the queue is full or the server is closed while
+// the evaluations of the events that were accepted are always queued.
+func (s *Server) enqueue(j *job, evaluation bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !evaluation && (s.closed || s.queued >= s.queueSize) {
+		return false
+	}
+
+	s.queued++
+	s.pending[j.repository] = append(s.pending[j.repository], j)
+	if len(s.pending[j.repository]) == 1 {
+		s.ready = append(s.ready, j.repository)
+		s.cond.Broadcast()
+	}
+
+	return true
+}
+
+func (s *Server) work() {
+	defer s.workers.Done()
+
+	for {
+		s.mu.Lock()
+		for len(s.ready) == 0 && !s.stopped {
+			s.cond.Wait()
+		}
+
+		if len(s.ready) == 0 {
+			s.mu.Unlock()
+			return
+		}
+
+		repository := s.ready[0]
+		s.ready = s.ready[1:]
+		j := s.pending[repository][0]
+		s.mu.Unlock()
+
+		s.process(j)
+
+		s.mu.Lock()
+		s.queued--
+		if jobs := s.pending[repository][1:]; len(jobs) > 0 {
+			s.pending[repository] = jobs
+			// Requeue the repository instead of processing its next job to be fair with the other repositories.
+			s.ready = append(s.ready, repository)
+		} else {
+			delete(s.pending, repository)
+		}
+		s.cond.Broadcast()
+		s.mu.Unlock()
+	}
+}
+
+func (s *Server) process(j *job) {
+	start := time.Now()
+
+	defer func() {
+		if r := recover(); r != nil {
+			j.log.WithField("duration", time.Since(start).String()).Errorf("panic while running job: %v", r)
+		}
+	}()
+
+	if err := j.run(context.Background(), j.log); err != nil {
+		j.log.WithField("duration", time.Since(start).String()).Errorf("error running job: %v", err)
+		return
+	}
+
+	j.log.WithField("duration", time.Since(start).String()).Info("job ran successfully")
+}
+
+// waitIdle waits until there are no jobs waiting or in progress.
+func (s *Server) waitIdle() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for s.queued > 0 {
+		s.cond.Wait()
+	}
+}
+
+func repositoryFromPayload(payload []byte) (*github.Repository, error) {
+	event := struct {
+		Repository *github.Repository `json:"repository"`
+	}{}
+
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+
+	return event.Repository, nil
+}
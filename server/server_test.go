@@ -0,0 +1,315 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package server_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/coalescer"
+	"github.com/reviewpad/reviewpad/v4/handler"
+	"github.com/reviewpad/reviewpad/v4/server"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+const webhookSecret = "secret"
+
+func sign(payload string) string {
+	mac := hmac.New(sha256.New, []byte(webhookSecret))
+	mac.Write([]byte(payload))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func repositoryPayload(fullName string) string {
+	return fmt.Sprintf(`{"action": "opened", "repository": {"full_name": %q}}`, fullName)
+}
+
+var deliveries int64
+
+func newWebhookRequest(method, eventName, payload, signature string) *http.Request {
+	req := httptest.NewRequest(method, "/", strings.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(server.HEADER_DELIVERY, fmt.Sprintf("delivery-%d", atomic.AddInt64(&deliveries, 1)))
+	req.Header.Set(server.HEADER_SIGNATURE, signature)
+	if eventName != "" {
+		req.Header.Set(server.HEADER_EVENT, eventName)
+	}
+	return req
+}
+
+// processEvent returns the pull request 1 of the repository of the event.
+func processEvent(log *logrus.Entry, event *handler.ActionEvent) ([]*entities.TargetEntity, *entities.EventDetails, error) {
+	owner, repo, _ := strings.Cut(*event.Repository, "/")
+	targetEntity := &entities.TargetEntity{
+		Kind:   entities.PullRequest,
+		Owner:  owner,
+		Repo:   repo,
+		Number: 1,
+	}
+
+	return []*entities.TargetEntity{targetEntity}, &entities.EventDetails{EventName: *event.EventName}, nil
+}
+
+func newServer(t *testing.T, config *server.Config, coalescerConfig *coalescer.Config, evaluate server.Evaluator) *server.Server {
+	config.WebhookSecret = webhookSecret
+	eventCoalescer := coalescer.NewCoalescer(coalescer.NewMemoryStore(), coalescerConfig, processEvent)
+	webhookServer, err := server.NewServer(logrus.NewEntry(logrus.New()), config, eventCoalescer, evaluate)
+	assert.Nil(t, err)
+	return webhookServer
+}
+
+func TestNewServer_WhenWebhookSecretIsMissing(t *testing.T) {
+	evaluate := func(ctx context.Context, log *logrus.Entry, batch *coalescer.Batch) error {
+		return nil
+	}
+
+	eventCoalescer := coalescer.NewCoalescer(coalescer.NewMemoryStore(), &coalescer.Config{}, processEvent)
+	webhookServer, err := server.NewServer(logrus.NewEntry(logrus.New()), &server.Config{}, eventCoalescer, evaluate)
+
+	assert.Nil(t, webhookServer)
+	assert.EqualError(t, err, "webhook secret is required")
+}
+
+func TestServeHTTP(t *testing.T) {
+	payload := repositoryPayload("reviewpad/reviewpad")
+
+	tests := map[string]struct {
+		request        *http.Request
+		wantStatusCode int
+		wantEvent      bool
+	}{
+		"when method is not post": {
+			request:        newWebhookRequest(http.MethodGet, "pull_request", payload, sign(payload)),
+			wantStatusCode: http.StatusMethodNotAllowed,
+		},
+		"when event header is missing": {
+			request:        newWebhookRequest(http.MethodPost, "", payload, sign(payload)),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		"when content type is not json": {
+			request: func() *http.Request {
+				req := newWebhookRequest(http.MethodPost, "pull_request", payload, sign(payload))
+				req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+				return req
+			}(),
+			wantStatusCode: http.StatusUnsupportedMediaType,
+		},
+		"when signature is missing": {
+			request:        newWebhookRequest(http.MethodPost, "pull_request", payload, ""),
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		"when signature is invalid": {
+			request:        newWebhookRequest(http.MethodPost, "pull_request", payload, sign("{}")),
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		"when payload is invalid": {
+			request:        newWebhookRequest(http.MethodPost, "pull_request", "{", sign("{")),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		"when event is ping": {
+			request:        newWebhookRequest(http.MethodPost, "ping", `{"zen": "Keep it logically awesome."}`, sign(`{"zen": "Keep it logically awesome."}`)),
+			wantStatusCode: http.StatusOK,
+		},
+		"when event has no repository": {
+			request:        newWebhookRequest(http.MethodPost, "installation", `{"action": "created"}`, sign(`{"action": "created"}`)),
+			wantStatusCode: http.StatusOK,
+		},
+		"when event is accepted": {
+			request:        newWebhookRequest(http.MethodPost, "pull_request", payload, sign(payload)),
+			wantStatusCode: http.StatusAccepted,
+			wantEvent:      true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			events := make(chan *handler.ActionEvent, 1)
+			evaluate := func(ctx context.Context, log *logrus.Entry, batch *coalescer.Batch) error {
+				events <- batch.Event
+				return nil
+			}
+
+			webhookServer := newServer(t, &server.Config{
+				Token:        "token",
+				GitHubAPIURL: "https://github.example.com/api/v3",
+			}, &coalescer.Config{}, evaluate)
+
+			rec := httptest.NewRecorder()
+			webhookServer.ServeHTTP(rec, test.request)
+
+			assert.Nil(t, webhookServer.Shutdown(context.Background()))
+			assert.Equal(t, test.wantStatusCode, rec.Code)
+
+			if !test.wantEvent {
+				assert.Empty(t, events)
+				return
+			}
+
+			event := <-events
+			assert.Equal(t, "pull_request", *event.EventName)
+			assert.Equal(t, "token", *event.Token)
+			assert.Equal(t, "https://github.example.com/api/v3", *event.ApiUrl)
+			assert.Equal(t, "reviewpad/reviewpad", *event.Repository)
+			assert.JSONEq(t, payload, string(*event.EventPayload))
+		})
+	}
+}
+
+func TestServeHTTP_WhenQueueIsFull(t *testing.T) {
+	release := make(chan struct{})
+	evaluate := func(ctx context.Context, log *logrus.Entry, batch *coalescer.Batch) error {
+		<-release
+		return nil
+	}
+
+	webhookServer := newServer(t, &server.Config{Workers: 1, QueueSize: 1}, &coalescer.Config{}, evaluate)
+
+	payload := repositoryPayload("reviewpad/reviewpad")
+
+	rec := httptest.NewRecorder()
+	webhookServer.ServeHTTP(rec, newWebhookRequest(http.MethodPost, "pull_request", payload, sign(payload)))
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+
+	rec = httptest.NewRecorder()
+	webhookServer.ServeHTTP(rec, newWebhookRequest(http.MethodPost, "pull_request", payload, sign(payload)))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	close(release)
+	assert.Nil(t, webhookServer.Shutdown(context.Background()))
+}
+
+func TestServeHTTP_SerializesEventsOfRepository(t *testing.T) {
+	var mu sync.Mutex
+	running := map[string]int{}
+	maxRunning := map[string]int{}
+	started := make(chan string, 3)
+	release := make(chan struct{})
+
+	evaluate := func(ctx context.Context, log *logrus.Entry, batch *coalescer.Batch) error {
+		repository := *batch.Event.Repository
+
+		mu.Lock()
+		running[repository]++
+		if running[repository] > maxRunning[repository] {
+			maxRunning[repository] = running[repository]
+		}
+		mu.Unlock()
+
+		started <- repository
+		<-release
+
+		mu.Lock()
+		running[repository]--
+		mu.Unlock()
+
+		return nil
+	}
+
+	webhookServer := newServer(t, &server.Config{Workers: 2, QueueSize: 10}, &coalescer.Config{}, evaluate)
+
+	for _, repository := range []string{"reviewpad/reviewpad", "reviewpad/reviewpad", "reviewpad/action"} {
+		payload := repositoryPayload(repository)
+		rec := httptest.NewRecorder()
+		webhookServer.ServeHTTP(rec, newWebhookRequest(http.MethodPost, "pull_request", payload, sign(payload)))
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+	}
+
+	// The second worker runs the event of the other repository instead of waiting for the first event to finish.
+	startedRepositories := []string{waitForStart(t, started), waitForStart(t, started)}
+	assert.ElementsMatch(t, []string{"reviewpad/reviewpad", "reviewpad/action"}, startedRepositories)
+
+	close(release)
+	assert.Equal(t, "reviewpad/reviewpad", waitForStart(t, started))
+	assert.Nil(t, webhookServer.Shutdown(context.Background()))
+
+	assert.Equal(t, map[string]int{"reviewpad/reviewpad": 1, "reviewpad/action": 1}, maxRunning)
+}
+
+func TestServeHTTP_CoalescesEventsOfTarget(t *testing.T) {
+	batches := make(chan *coalescer.Batch, 3)
+	evaluate := func(ctx context.Context, log *logrus.Entry, batch *coalescer.Batch) error {
+		batches <- batch
+		return nil
+	}
+
+	webhookServer := newServer(t, &server.Config{}, &coalescer.Config{Window: time.Hour}, evaluate)
+
+	payload := repositoryPayload("reviewpad/reviewpad")
+	requests := []*http.Request{
+		newWebhookRequest(http.MethodPost, "check_run", payload, sign(payload)),
+		newWebhookRequest(http.MethodPost, "pull_request", payload, sign(payload)),
+		newWebhookRequest(http.MethodPost, "check_run", payload, sign(payload)),
+	}
+
+	// A redelivery of the last event.
+	redelivery := newWebhookRequest(http.MethodPost, "check_run", payload, sign(payload))
+	redelivery.Header.Set(server.HEADER_DELIVERY, requests[2].Header.Get(server.HEADER_DELIVERY))
+	requests = append(requests, redelivery)
+
+	for _, req := range requests {
+		rec := httptest.NewRecorder()
+		webhookServer.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+	}
+
+	// Shutting down releases the pending batches without waiting for the window to be over.
+	assert.Nil(t, webhookServer.Shutdown(context.Background()))
+	close(batches)
+
+	gotBatches := make([]*coalescer.Batch, 0)
+	for batch := range batches {
+		gotBatches = append(gotBatches, batch)
+	}
+
+	// The events of the burst are evaluated once with the latest event.
+	if !assert.Len(t, gotBatches, 1) {
+		return
+	}
+
+	assert.Equal(t, "check_run", *gotBatches[0].Event.EventName)
+	assert.Equal(t, []string{
+		requests[0].Header.Get(server.HEADER_DELIVERY),
+		requests[1].Header.Get(server.HEADER_DELIVERY),
+		requests[2].Header.Get(server.HEADER_DELIVERY),
+	}, gotBatches[0].DeliveryIDs)
+	assert.Len(t, gotBatches[0].AllEventDetails, 3)
+}
+
+func TestShutdown_RejectsNewEvents(t *testing.T) {
+	evaluate := func(ctx context.Context, log *logrus.Entry, batch *coalescer.Batch) error {
+		return nil
+	}
+
+	webhookServer := newServer(t, &server.Config{}, &coalescer.Config{}, evaluate)
+	assert.Nil(t, webhookServer.Shutdown(context.Background()))
+
+	payload := repositoryPayload("reviewpad/reviewpad")
+	rec := httptest.NewRecorder()
+	webhookServer.ServeHTTP(rec, newWebhookRequest(http.MethodPost, "pull_request", payload, sign(payload)))
+
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
+
+func waitForStart(t *testing.T, started chan string) string {
+	select {
+	case repository := <-started:
+		return repository
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the event to run")
+		return ""
+	}
+}
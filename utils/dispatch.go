@@ -0,0 +1,86 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/go-lib/entities"
+)
+
+const (
+	// DISPATCH_INPUT_NUMBER is the input with the number of the pull request or issue to run on.
+	DISPATCH_INPUT_NUMBER = "number"
+	// DISPATCH_INPUT_QUERY is the input with the search query of the pull requests and issues to run on.
+	DISPATCH_INPUT_QUERY = "query"
+	// DISPATCH_INPUT_WORKFLOW is the input with the name of the workflow to run.
+	DISPATCH_INPUT_WORKFLOW = "workflow"
+)
+
+// GetDispatchInputs returns the inputs of a workflow_dispatch event or the client payload of a repository_dispatch event.
+// Other events have no inputs.
+func GetDispatchInputs(eventPayload interface{}) (map[string]interface{}, error) {
+	var rawInputs json.RawMessage
+
+	switch payload := eventPayload.(type) {
+	case *github.WorkflowDispatchEvent:
+		rawInputs = payload.Inputs
+	case *github.RepositoryDispatchEvent:
+		rawInputs = payload.ClientPayload
+	}
+
+	inputs := make(map[string]interface{})
+	if len(rawInputs) == 0 || string(rawInputs) == "null" {
+		return inputs, nil
+	}
+
+	if err := json.Unmarshal(rawInputs, &inputs); err != nil {
+		return nil, fmt.Errorf("invalid dispatch inputs: %w", err)
+	}
+
+	return inputs, nil
+}
+
+// GetDispatchNumber returns the number of the pull request or issue given in the dispatch inputs.
+// The inputs of workflow_dispatch events are always strings while the client payload of repository_dispatch events can have numbers.
+func GetDispatchNumber(inputs map[string]interface{}) (int, bool, error) {
+	rawNumber, ok := inputs[DISPATCH_INPUT_NUMBER]
+	if !ok {
+		return 0, false, nil
+	}
+
+	switch number := rawNumber.(type) {
+	case float64:
+		return int(number), true, nil
+	case string:
+		n, err := strconv.Atoi(number)
+		if err != nil {
+			return 0, false, fmt.Errorf("invalid dispatch input %s: %s", DISPATCH_INPUT_NUMBER, number)
+		}
+		return n, true, nil
+	default:
+		return 0, false, fmt.Errorf("invalid dispatch input %s: %v", DISPATCH_INPUT_NUMBER, rawNumber)
+	}
+}
+
+// GetDispatchedWorkflow returns the name of the workflow requested by a dispatch event.
+// An empty name means that every workflow is evaluated as usual.
+func GetDispatchedWorkflow(eventDetails *entities.EventDetails) string {
+	if eventDetails == nil {
+		return ""
+	}
+
+	inputs, err := GetDispatchInputs(eventDetails.Payload)
+	if err != nil {
+		return ""
+	}
+
+	workflow, _ := inputs[DISPATCH_INPUT_WORKFLOW].(string)
+
+	return workflow
+}
@@ -0,0 +1,143 @@
+// Copyright 2023 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package utils_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/go-lib/entities"
+	"github.com/reviewpad/reviewpad/v4/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDispatchInputs(t *testing.T) {
+	tests := map[string]struct {
+		eventPayload interface{}
+		wantInputs   map[string]interface{}
+		wantErr      bool
+	}{
+		"when event is not a dispatch": {
+			eventPayload: &github.PullRequestEvent{},
+			wantInputs:   map[string]interface{}{},
+		},
+		"when workflow dispatch has no inputs": {
+			eventPayload: &github.WorkflowDispatchEvent{},
+			wantInputs:   map[string]interface{}{},
+		},
+		"when workflow dispatch has inputs": {
+			eventPayload: &github.WorkflowDispatchEvent{
+				Inputs: json.RawMessage(`{"number": "12", "workflow": "release"}`),
+			},
+			wantInputs: map[string]interface{}{
+				"number":   "12",
+				"workflow": "release",
+			},
+		},
+		"when repository dispatch has client payload": {
+			eventPayload: &github.RepositoryDispatchEvent{
+				ClientPayload: json.RawMessage(`{"number": 12, "force": true}`),
+			},
+			wantInputs: map[string]interface{}{
+				"number": float64(12),
+				"force":  true,
+			},
+		},
+		"when client payload is not an object": {
+			eventPayload: &github.RepositoryDispatchEvent{
+				ClientPayload: json.RawMessage(`[1, 2]`),
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotInputs, gotErr := utils.GetDispatchInputs(test.eventPayload)
+
+			assert.Equal(t, test.wantErr, gotErr != nil)
+			assert.Equal(t, test.wantInputs, gotInputs)
+		})
+	}
+}
+
+func TestGetDispatchNumber(t *testing.T) {
+	tests := map[string]struct {
+		inputs     map[string]interface{}
+		wantNumber int
+		wantOk     bool
+		wantErr    error
+	}{
+		"when number is missing": {
+			inputs: map[string]interface{}{},
+		},
+		"when number is a string": {
+			inputs:     map[string]interface{}{"number": "12"},
+			wantNumber: 12,
+			wantOk:     true,
+		},
+		"when number is a number": {
+			inputs:     map[string]interface{}{"number": float64(12)},
+			wantNumber: 12,
+			wantOk:     true,
+		},
+		"when number is invalid": {
+			inputs:  map[string]interface{}{"number": "twelve"},
+			wantErr: errors.New("invalid dispatch input number: twelve"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotNumber, gotOk, gotErr := utils.GetDispatchNumber(test.inputs)
+
+			assert.Equal(t, test.wantErr, gotErr)
+			assert.Equal(t, test.wantOk, gotOk)
+			assert.Equal(t, test.wantNumber, gotNumber)
+		})
+	}
+}
+
+func TestGetDispatchedWorkflow(t *testing.T) {
+	tests := map[string]struct {
+		eventDetails *entities.EventDetails
+		wantWorkflow string
+	}{
+		"when there are no event details": {},
+		"when event is not a dispatch": {
+			eventDetails: &entities.EventDetails{
+				EventName: "pull_request",
+				Payload:   &github.PullRequestEvent{},
+			},
+		},
+		"when dispatch has no workflow": {
+			eventDetails: &entities.EventDetails{
+				EventName: "workflow_dispatch",
+				Payload: &github.WorkflowDispatchEvent{
+					Inputs: json.RawMessage(`{"number": "12"}`),
+				},
+			},
+		},
+		"when dispatch has a workflow": {
+			eventDetails: &entities.EventDetails{
+				EventName: "repository_dispatch",
+				Payload: &github.RepositoryDispatchEvent{
+					ClientPayload: json.RawMessage(`{"number": 12, "workflow": "release"}`),
+				},
+			},
+			wantWorkflow: "release",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotWorkflow := utils.GetDispatchedWorkflow(test.eventDetails)
+
+			assert.Equal(t, test.wantWorkflow, gotWorkflow)
+		})
+	}
+}
@@ -7,11 +7,14 @@ package utils
 import (
 	"bytes"
 	"context"
+	"errors"
+	"net/http"
 	"strings"
 
+	"github.com/google/go-github/v52/github"
 	pbc "github.com/reviewpad/api/go/codehost"
-	"github.com/reviewpad/reviewpad/v4/codehost/github"
 	gh "github.com/reviewpad/reviewpad/v4/codehost/github"
+	"github.com/reviewpad/reviewpad/v4/codehost/gitlab"
 	"github.com/sirupsen/logrus"
 )
 
@@ -28,6 +31,24 @@ func FileExt(fp string) string {
 	return str
 }
 
+// IsFileNotFound reports whether the error of a file download is due to a missing file.
+// The GitHub contents API answers with a 404 when the directory of the file does not exist,
+// while go-github returns a plain error when the directory exists without the file.
+// GitLab answers with a 404 in both cases.
+func IsFileNotFound(err error) bool {
+	var githubErr *github.ErrorResponse
+	if errors.As(err, &githubErr) {
+		return githubErr.Response != nil && githubErr.Response.StatusCode == http.StatusNotFound
+	}
+
+	var gitlabErr *gitlab.ErrorResponse
+	if errors.As(err, &gitlabErr) {
+		return gitlabErr.StatusCode == http.StatusNotFound
+	}
+
+	return strings.HasPrefix(err.Error(), "no file named")
+}
+
 // reviewpad-an: experimental
 // ReviewpadFileChanges checks if a file path was changed in a pull request.
 // The way this is done depends on the number of files changed in the pull request.
@@ -37,21 +58,21 @@ func FileExt(fp string) string {
 // Otherwise, we download the pull request files and check the filePath exists in them.
 func ReviewpadFileChanged(ctx context.Context, githubClient *gh.GithubClient, filePath string, pullRequest *pbc.PullRequest) (bool, error) {
 	if pullRequest.ChangedFilesCount > pullRequestFileLimit {
-		rawHeadFile, err := githubClient.DownloadContents(ctx, filePath, pullRequest.Head, &github.DownloadContentsOptions{
-			Method: github.DownloadMethodSHA,
+		rawHeadFile, err := githubClient.DownloadContents(ctx, filePath, pullRequest.Head, &gh.DownloadContentsOptions{
+			Method: gh.DownloadMethodSHA,
 		})
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "no file named") {
+			if IsFileNotFound(err) {
 				return true, nil
 			}
 			return false, err
 		}
 
-		rawBaseFile, err := githubClient.DownloadContents(ctx, filePath, pullRequest.Base, &github.DownloadContentsOptions{
-			Method: github.DownloadMethodSHA,
+		rawBaseFile, err := githubClient.DownloadContents(ctx, filePath, pullRequest.Base, &gh.DownloadContentsOptions{
+			Method: gh.DownloadMethodSHA,
 		})
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "no file named") {
+			if IsFileNotFound(err) {
 				return true, nil
 			}
 			return false, err
@@ -84,7 +105,7 @@ func DownloadReviewpadFileFromGitHub(
 	githubClient *gh.GithubClient,
 	filePath string,
 	branch *pbc.Branch,
-	options *github.DownloadContentsOptions,
+	options *gh.DownloadContentsOptions,
 ) (*bytes.Buffer, error) {
 	reviewpadFileContent, err := githubClient.DownloadContents(ctx, filePath, branch, options)
 	if err != nil {
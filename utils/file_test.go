@@ -5,8 +5,13 @@
 package utils_test
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
 	"testing"
 
+	"github.com/google/go-github/v52/github"
+	"github.com/reviewpad/reviewpad/v4/codehost/gitlab"
 	"github.com/reviewpad/reviewpad/v4/utils"
 	"github.com/stretchr/testify/assert"
 )
@@ -28,3 +33,45 @@ func TestFileExt_WhenFilePathHasExtension(t *testing.T) {
 
 	assert.Equal(t, wantFileExt, gotFileExt)
 }
+
+func TestIsFileNotFound(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want bool
+	}{
+		"when file is missing from directory": {
+			err:  fmt.Errorf("no file named %s found in %s", "reviewpad.yml", "."),
+			want: true,
+		},
+		"when directory is missing": {
+			err:  &github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}},
+			want: true,
+		},
+		"when response is forbidden": {
+			err:  &github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusForbidden}},
+			want: false,
+		},
+		"when response is missing": {
+			err:  &github.ErrorResponse{},
+			want: false,
+		},
+		"when file is missing from gitlab": {
+			err:  &gitlab.ErrorResponse{StatusCode: http.StatusNotFound},
+			want: true,
+		},
+		"when gitlab response is forbidden": {
+			err:  &gitlab.ErrorResponse{StatusCode: http.StatusForbidden},
+			want: false,
+		},
+		"when error is unrelated": {
+			err:  errors.New("connection refused"),
+			want: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.want, utils.IsFileNotFound(test.err))
+		})
+	}
+}
@@ -15,11 +15,16 @@ func IsPullRequestReadyForReportMetrics(eventDetails *entities.EventDetails) boo
 	return eventDetails != nil && eventDetails.EventName == "pull_request" && eventDetails.EventAction == "closed"
 }
 
+// IsReviewpadCommand reports whether the event is a comment with a reviewpad command.
+// Commands are only supported on GitHub, so the notes of GitLab, which are also issue_comment events, are not commands.
 func IsReviewpadCommand(eventDetails *entities.EventDetails) bool {
-	return eventDetails != nil &&
-		eventDetails.EventName == "issue_comment" &&
-		eventDetails.EventAction == "created" &&
-		strings.HasPrefix(eventDetails.Payload.(*github.IssueCommentEvent).GetComment().GetBody(), "/reviewpad")
+	if eventDetails == nil || eventDetails.EventName != "issue_comment" || eventDetails.EventAction != "created" {
+		return false
+	}
+
+	issueCommentEvent, ok := eventDetails.Payload.(*github.IssueCommentEvent)
+
+	return ok && strings.HasPrefix(issueCommentEvent.GetComment().GetBody(), "/reviewpad")
 }
 
 func IsReviewpadCommandDryRun(command string) bool {
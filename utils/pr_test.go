@@ -99,6 +99,16 @@ func TestIsReviewPadCommand(t *testing.T) {
 				},
 			},
 		},
+		"when event is a gitlab note": {
+			wantVal: false,
+			eventDetails: &entities.EventDetails{
+				EventName:   "issue_comment",
+				EventAction: "created",
+				Payload: map[string]interface{}{
+					"object_attributes": map[string]interface{}{"note": "/reviewpad"},
+				},
+			},
+		},
 		"when event name is issue comment and body has /reviewpad prefix": {
 			wantVal: true,
 			eventDetails: &entities.EventDetails{